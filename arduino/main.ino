@@ -1,5 +1,6 @@
 #include <ESP8266WiFi.h>
 #include <ESP8266WebServer.h>
+#include <ESP8266HTTPClient.h>
 #include <ArduinoJson.h>
 
 
@@ -8,9 +9,48 @@ const char* password = "WIFI PASSWORD";
 
 ESP8266WebServer server(80);
 
-uint8_t LED1pin = D8;  // Пин для цвета 3
-uint8_t LED2pin = D6;  // Пин для цвета 2
-uint8_t LED3pin = D5; // Пин для цвета 1
+uint8_t LED1pin = D8;  // Пин для цвета 3 (синий канал)
+uint8_t LED2pin = D6;  // Пин для цвета 2 (зеленый канал)
+uint8_t LED3pin = D5; // Пин для цвета 1 (красный канал)
+
+// Режимы старого API проигрываются как эффекты
+const unsigned long STATIC_DURATION = 10000; // 10 секунд
+const unsigned long BLINK_PERIOD = 1000;     // 0.5 секунд горит, 0.5 секунд нет
+const int BLINK_COUNT = 10;
+
+const int EFFECT_NONE = 0;
+const int EFFECT_STATIC = 1;
+const int EFFECT_BLINKING = 2;
+const int EFFECT_BREATHING = 3;
+const int EFFECT_PULSE = 4;
+const int EFFECT_RAINBOW = 5;
+const int EFFECT_STROBE = 6;
+const int EFFECT_SUNRISE = 7;
+const int EFFECT_KEYFRAMES = 8;
+
+const int MAX_KEYFRAMES = 16;
+
+struct Keyframe
+{
+  int red;
+  int green;
+  int blue;
+  unsigned long duration; // переход от предыдущего кадра
+};
+
+// Текущий эффект, обновляется в loop, чтобы его можно было остановить запросом /stop
+bool active = false;
+int activeEffect = EFFECT_NONE;
+int activeRed = 0;
+int activeGreen = 0;
+int activeBlue = 0;
+int activeBrightness = 100;
+unsigned long activePeriod = 0;
+unsigned long activeDuration = 0;
+int activeRepeat = 1;
+Keyframe keyframes[MAX_KEYFRAMES];
+int keyframeCount = 0;
+unsigned long startedAt = 0;
 
 void setup() 
 {
@@ -18,6 +58,7 @@ void setup()
   pinMode(LED1pin, OUTPUT);
   pinMode(LED2pin, OUTPUT);
   pinMode(LED3pin, OUTPUT);
+  analogWriteRange(255);
 
   WiFi.begin(ssid, password);
 
@@ -30,6 +71,9 @@ void setup()
   Serial.println(WiFi.localIP());
   
   server.on("/glow_reminder", HTTP_POST, handle_glow_reminder);
+  server.on("/glow_reminder/v2", HTTP_POST, handle_glow_reminder_v2);
+  server.on("/stop", HTTP_POST, handle_stop);
+  server.on("/health", HTTP_GET, handle_health);
   server.onNotFound(handle_NotFound);
   
   server.begin();
@@ -39,6 +83,7 @@ void setup()
 void loop() 
 {
   server.handleClient();
+  updateLED();
 }
 
 void handle_glow_reminder() 
@@ -54,16 +99,17 @@ void handle_glow_reminder()
 
     server.send(200, "text/plain", "OK");
 
+    // Старые цвета: 1 - красный, 2 - зеленый, 3 - синий
     if (colour == 1) {
-      controlLED(LED3pin, mode);
+      controlLED(255, 0, 0, 100, mode);
     }
     else if (colour == 2) 
     {
-      controlLED(LED2pin, mode);
+      controlLED(0, 255, 0, 100, mode);
     } 
     else if (colour == 3) 
     {
-      controlLED(LED1pin, mode);
+      controlLED(0, 0, 255, 100, mode);
     }
   } 
   else 
@@ -72,24 +118,298 @@ void handle_glow_reminder()
   }
 }
 
-void controlLED(uint8_t pin, int mode) 
+void handle_glow_reminder_v2() 
 {
-  if (mode == 1) 
+  if (!server.hasArg("plain"))
+  {
+    server.send(400, "text/plain", "Bad Request");
+    return;
+  }
+
+  String body = server.arg("plain");
+  DynamicJsonDocument doc(1024);
+  deserializeJson(doc, body);
+
+  const char* rgb = doc["rgb"] | "";
+  int brightness = doc["brightness"] | 100;
+  int mode = doc["mode"];
+
+  if (strlen(rgb) != 7 || rgb[0] != '#' || brightness < 1 || brightness > 100)
   {
-    digitalWrite(pin, HIGH);
-    delay(10000);  // 10 секунд
-    digitalWrite(pin, LOW);
+    server.send(400, "text/plain", "Bad Request");
+    return;
+  }
+
+  long value = strtol(rgb + 1, NULL, 16);
+  String ackUrl = doc["ack_url"] | "";
+
+  server.send(200, "text/plain", "OK");
+
+  JsonObject effect = doc["effect"];
+  if (effect.isNull())
+  {
+    controlLED((value >> 16) & 0xFF, (value >> 8) & 0xFF, value & 0xFF, brightness, mode);
+    sendAck(ackUrl);
+    return;
+  }
+
+  stopLED();
+
+  keyframeCount = 0;
+  for (JsonObject keyframe : effect["keyframes"].as<JsonArray>())
+  {
+    if (keyframeCount == MAX_KEYFRAMES)
+    {
+      break;
+    }
+
+    long keyframeValue = strtol((keyframe["rgb"] | "#000000") + 1, NULL, 16);
+    int keyframeBrightness = keyframe["brightness"] | 100;
+
+    keyframes[keyframeCount].red = ((keyframeValue >> 16) & 0xFF) * keyframeBrightness / 100;
+    keyframes[keyframeCount].green = ((keyframeValue >> 8) & 0xFF) * keyframeBrightness / 100;
+    keyframes[keyframeCount].blue = (keyframeValue & 0xFF) * keyframeBrightness / 100;
+    keyframes[keyframeCount].duration = keyframe["duration_ms"] | 0;
+    keyframeCount++;
+  }
+
+  startEffect(
+    (value >> 16) & 0xFF, (value >> 8) & 0xFF, value & 0xFF, brightness,
+    parseEffectKind(effect["kind"] | ""),
+    effect["period_ms"] | 0,
+    effect["duration_ms"] | 0,
+    effect["repeat"] | 1
+  );
+  sendAck(ackUrl);
+}
+
+// Подтверждает серверу, что лампа загорелась
+void sendAck(const String& ackUrl)
+{
+  if (ackUrl.length() == 0)
+  {
+    return;
+  }
+
+  WiFiClient client;
+  HTTPClient http;
+  http.begin(client, ackUrl);
+  http.addHeader("Content-Type", "application/json");
+  int code = http.POST("{\"ok\":true}");
+  http.end();
+
+  if (code != 204)
+  {
+    Serial.printf("Failed to ack delivery: %d\n", code);
+  }
+}
+
+int parseEffectKind(const char* kind)
+{
+  if (strcmp(kind, "static") == 0) return EFFECT_STATIC;
+  if (strcmp(kind, "blinking") == 0) return EFFECT_BLINKING;
+  if (strcmp(kind, "breathing") == 0) return EFFECT_BREATHING;
+  if (strcmp(kind, "pulse") == 0) return EFFECT_PULSE;
+  if (strcmp(kind, "rainbow") == 0) return EFFECT_RAINBOW;
+  if (strcmp(kind, "strobe") == 0) return EFFECT_STROBE;
+  if (strcmp(kind, "sunrise") == 0) return EFFECT_SUNRISE;
+  if (strcmp(kind, "keyframes") == 0) return EFFECT_KEYFRAMES;
+  return EFFECT_NONE;
+}
+
+void handle_stop() 
+{
+  stopLED();
+  server.send(200, "text/plain", "OK");
+}
+
+void handle_health()
+{
+  server.send(200, "text/plain", "OK");
+}
+
+void controlLED(int red, int green, int blue, int brightness, int mode) 
+{
+  stopLED();
+
+  if (mode == 2) 
+  {
+    startEffect(red, green, blue, brightness, EFFECT_BLINKING, BLINK_PERIOD, 0, BLINK_COUNT);
   } 
-  else if (mode == 2) 
+  else 
+  {
+    startEffect(red, green, blue, brightness, EFFECT_STATIC, 0, STATIC_DURATION, 1);
+  }
+}
+
+void startEffect(int red, int green, int blue, int brightness, int effect, unsigned long period, unsigned long duration, int repeat) 
+{
+  active = true;
+  activeEffect = effect;
+  activeRed = red;
+  activeGreen = green;
+  activeBlue = blue;
+  activeBrightness = brightness;
+  activePeriod = period;
+  activeDuration = duration;
+  activeRepeat = repeat;
+  startedAt = millis();
+
+  updateLED();
+}
+
+// writeLevel зажигает цвет эффекта с яркостью level от 0 до 1
+void writeLevel(float level) 
+{
+  writeRGB(activeRed * level, activeGreen * level, activeBlue * level);
+}
+
+void writeRGB(int red, int green, int blue) 
+{
+  analogWrite(LED3pin, red * activeBrightness / 100);
+  analogWrite(LED2pin, green * activeBrightness / 100);
+  analogWrite(LED1pin, blue * activeBrightness / 100);
+}
+
+void writeHue(unsigned long hue) 
+{
+  int sector = hue / 60;
+  int rising = (hue % 60) * 255 / 60;
+  int falling = 255 - rising;
+
+  switch (sector) 
   {
-    for (int i = 0; i < 10; i++) 
+    case 0: writeRGB(255, rising, 0); break;
+    case 1: writeRGB(falling, 255, 0); break;
+    case 2: writeRGB(0, 255, rising); break;
+    case 3: writeRGB(0, falling, 255); break;
+    case 4: writeRGB(rising, 0, 255); break;
+    default: writeRGB(255, 0, falling); break;
+  }
+}
+
+unsigned long keyframesCycle() 
+{
+  unsigned long cycle = 0;
+  for (int i = 0; i < keyframeCount; i++) 
+  {
+    cycle += keyframes[i].duration;
+  }
+  return cycle;
+}
+
+unsigned long effectDuration() 
+{
+  switch (activeEffect) 
+  {
+    case EFFECT_STATIC:
+    case EFFECT_SUNRISE:
+      return activeDuration;
+    case EFFECT_KEYFRAMES:
+      return keyframesCycle() * activeRepeat;
+    default:
+      return activePeriod * activeRepeat;
+  }
+}
+
+// writeKeyframes плавно переходит от предыдущего кадра к текущему, первый кадр загорается из темноты
+void writeKeyframes(unsigned long elapsed) 
+{
+  unsigned long cycle = keyframesCycle();
+  unsigned long position = elapsed % cycle;
+  bool firstCycle = elapsed < cycle;
+
+  for (int i = 0; i < keyframeCount; i++) 
+  {
+    if (position >= keyframes[i].duration) 
     {
-      digitalWrite(pin, HIGH);
-      delay(500);  // 0.5 секунд
-      digitalWrite(pin, LOW);
-      delay(500);  // 0.5 секунд
+      position -= keyframes[i].duration;
+      continue;
     }
+
+    Keyframe from = {0, 0, 0, 0};
+    if (i > 0) 
+    {
+      from = keyframes[i - 1];
+    } 
+    else if (!firstCycle) 
+    {
+      from = keyframes[keyframeCount - 1];
+    }
+
+    float t = (float)position / keyframes[i].duration;
+    writeRGB(
+      from.red + (keyframes[i].red - from.red) * t,
+      from.green + (keyframes[i].green - from.green) * t,
+      from.blue + (keyframes[i].blue - from.blue) * t
+    );
+    return;
+  }
+}
+
+void updateLED() 
+{
+  if (!active) 
+  {
+    return;
   }
+
+  unsigned long elapsed = millis() - startedAt;
+
+  if (elapsed >= effectDuration()) 
+  {
+    stopLED();
+    return;
+  }
+
+  unsigned long phase = activePeriod > 0 ? elapsed % activePeriod : 0;
+
+  switch (activeEffect) 
+  {
+    case EFFECT_STATIC:
+      writeLevel(1);
+      break;
+    case EFFECT_BLINKING:
+      writeLevel(phase < activePeriod / 2 ? 1 : 0);
+      break;
+    case EFFECT_BREATHING:
+      writeLevel((1 - cos(2 * PI * phase / activePeriod)) / 2);
+      break;
+    case EFFECT_PULSE:
+      // Быстро загорается за первую пятую часть периода и плавно гаснет
+      if (phase < activePeriod / 5) 
+      {
+        writeLevel((float)phase / (activePeriod / 5));
+      } 
+      else 
+      {
+        writeLevel(1 - (float)(phase - activePeriod / 5) / (activePeriod - activePeriod / 5));
+      }
+      break;
+    case EFFECT_RAINBOW:
+      writeHue(phase * 360 / activePeriod);
+      break;
+    case EFFECT_STROBE:
+      writeLevel(phase < activePeriod / 10 ? 1 : 0);
+      break;
+    case EFFECT_SUNRISE:
+      writeLevel((float)elapsed / activeDuration);
+      break;
+    case EFFECT_KEYFRAMES:
+      writeKeyframes(elapsed);
+      break;
+    default:
+      stopLED();
+  }
+}
+
+void stopLED() 
+{
+  active = false;
+  activeEffect = EFFECT_NONE;
+  analogWrite(LED3pin, 0);
+  analogWrite(LED2pin, 0);
+  analogWrite(LED1pin, 0);
 }
 
 void handle_NotFound()
@@ -21,9 +21,17 @@ type (
 		ConnTimeout  time.Duration `env-required:"true" yaml:"conn_timeout" env:"PG_CONN_TIMEOUT"`
 	}
 
+	// Bot receives the updates with long polling, or through the webhook if the webhook URL is set.
+	// The conversation states must be kept in Redis to be shared by the replicas serving the webhook.
 	Bot struct {
 		Token         string        `yaml:"token" env:"TOKEN"`
 		PoolerTimeout time.Duration `env-required:"true" yaml:"pooler_timeout" env:"POOLER_TIMEOUT"`
+		WebhookURL    string        `yaml:"webhook_url" env:"BOT_WEBHOOK_URL"`
+		WebhookListen string        `yaml:"webhook_listen" env:"BOT_WEBHOOK_LISTEN"`
+		WebhookSecret string        `yaml:"webhook_secret" env:"BOT_WEBHOOK_SECRET"`
+		// StateStore is where the conversation states are kept: "memory" or "redis".
+		StateStore string        `env-required:"true" yaml:"state_store" env:"BOT_STATE_STORE"`
+		StateTTL   time.Duration `env-required:"true" yaml:"state_ttl" env:"BOT_STATE_TTL"`
 	}
 
 	Log struct {
@@ -35,16 +43,66 @@ type (
 		Host string `env-required:"true" yaml:"host" env:"HOST"`
 	}
 
+	GRPC struct {
+		Port uint32 `env-required:"true" yaml:"port" env:"GRPC_PORT"`
+		Host string `env-required:"true" yaml:"host" env:"GRPC_HOST"`
+	}
+
 	Redis struct {
 		Url string `env-required:"true" yaml:"redis_url" env:"REDIS_URL"`
 	}
 
 	Scheduler struct {
 		CycleDuration time.Duration `env-required:"true" yaml:"cycle_duration" env:"CYCLE_DURATION"`
+		LeaseDuration time.Duration `env-required:"true" yaml:"lease_duration" env:"LEASE_DURATION"`
+
+		RetryMaxAttempts    int64         `env-required:"true" yaml:"retry_max_attempts" env:"RETRY_MAX_ATTEMPTS"`
+		RetryInitialBackoff time.Duration `env-required:"true" yaml:"retry_initial_backoff" env:"RETRY_INITIAL_BACKOFF"`
+		RetryMaxBackoff     time.Duration `env-required:"true" yaml:"retry_max_backoff" env:"RETRY_MAX_BACKOFF"`
+		RetryMultiplier     float64       `env-required:"true" yaml:"retry_multiplier" env:"RETRY_MULTIPLIER"`
+		RetryJitter         float64       `yaml:"retry_jitter" env:"RETRY_JITTER"`
+
+		SnoozeWindow time.Duration `env-required:"true" yaml:"snooze_window" env:"SNOOZE_WINDOW"`
+	}
+
+	Outbox struct {
+		CycleDuration time.Duration `env-required:"true" yaml:"cycle_duration" env:"OUTBOX_CYCLE_DURATION"`
+		BatchSize     uint64        `env-required:"true" yaml:"batch_size" env:"OUTBOX_BATCH_SIZE"`
 	}
 
+	// GlowReminderClient is the default lamp, which lights the reminders of the users without registered lamps.
 	GlowReminderClient struct {
 		Host string `env-required:"true" yaml:"host" env:"GLOW_REMINDER_CLIENT_HOST"`
+		// LegacyColours is set for devices that only support red, green and blue.
+		LegacyColours bool `yaml:"legacy_colours" env:"GLOW_REMINDER_CLIENT_LEGACY_COLOURS"`
+		// CallbackURL is the REST API base URL the HTTP lamps confirm the deliveries at, e.g. http://192.168.1.10:8080.
+		// The lamps do not confirm the deliveries if it is empty.
+		CallbackURL string `yaml:"callback_url" env:"GLOW_REMINDER_CLIENT_CALLBACK_URL"`
+	}
+
+	// MQTT is the broker the lamps behind NAT connect to. The MQTT transport is disabled if the broker URL is empty.
+	MQTT struct {
+		BrokerURL   string        `yaml:"broker_url" env:"MQTT_BROKER_URL"`
+		ClientID    string        `yaml:"client_id" env:"MQTT_CLIENT_ID"`
+		Username    string        `yaml:"username" env:"MQTT_USERNAME"`
+		Password    string        `yaml:"password" env:"MQTT_PASSWORD"`
+		TopicPrefix string        `env-required:"true" yaml:"topic_prefix" env:"MQTT_TOPIC_PREFIX"`
+		AckTimeout  time.Duration `env-required:"true" yaml:"ack_timeout" env:"MQTT_ACK_TIMEOUT"`
+	}
+
+	// HealthCheck probes the registered lamps and alerts their owners when a lamp goes offline or comes back.
+	HealthCheck struct {
+		CycleDuration    time.Duration `env-required:"true" yaml:"cycle_duration" env:"HEALTH_CHECK_CYCLE_DURATION"`
+		ProbeTimeout     time.Duration `env-required:"true" yaml:"probe_timeout" env:"HEALTH_CHECK_PROBE_TIMEOUT"`
+		FailureThreshold int64         `env-required:"true" yaml:"failure_threshold" env:"HEALTH_CHECK_FAILURE_THRESHOLD"`
+	}
+
+	// Leader elects the instance running the scheduler, the outbox relay, the health checks and the long poller.
+	// The instance id defaults to the host name.
+	Leader struct {
+		InstanceID    string        `yaml:"instance_id" env:"LEADER_INSTANCE_ID"`
+		LockTTL       time.Duration `env-required:"true" yaml:"lock_ttl" env:"LEADER_LOCK_TTL"`
+		RetryInterval time.Duration `env-required:"true" yaml:"retry_interval" env:"LEADER_RETRY_INTERVAL"`
 	}
 
 	AppConfig struct {
@@ -53,9 +111,14 @@ type (
 		Redis              Redis              `yaml:"redis"`
 		PG                 PG                 `yaml:"postgres"`
 		HTTP               HTTP               `yaml:"http"`
+		GRPC               GRPC               `yaml:"grpc"`
 		Log                Log                `yaml:"logger"`
 		Scheduler          Scheduler          `yaml:"scheduler"`
+		Outbox             Outbox             `yaml:"outbox"`
 		GlowReminderClient GlowReminderClient `yaml:"glow_reminder_client"`
+		MQTT               MQTT               `yaml:"mqtt"`
+		HealthCheck        HealthCheck        `yaml:"health_check"`
+		Leader             Leader             `yaml:"leader"`
 	}
 )
 
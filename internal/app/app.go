@@ -5,16 +5,24 @@ import (
 
 	"github.com/almostinf/glow-reminder/config"
 	"github.com/almostinf/glow-reminder/internal/bot"
+	"github.com/almostinf/glow-reminder/internal/device"
+	"github.com/almostinf/glow-reminder/internal/grpcapi"
+	"github.com/almostinf/glow-reminder/internal/healthcheck"
+	"github.com/almostinf/glow-reminder/internal/httpapi"
+	"github.com/almostinf/glow-reminder/internal/leader"
+	"github.com/almostinf/glow-reminder/internal/notifier"
+	"github.com/almostinf/glow-reminder/internal/relay"
 	"github.com/almostinf/glow-reminder/internal/repository/pg"
 	"github.com/almostinf/glow-reminder/internal/repository/redis"
 	"github.com/almostinf/glow-reminder/internal/scheduler"
+	"github.com/almostinf/glow-reminder/internal/timeparse"
 	"github.com/almostinf/glow-reminder/internal/usecase"
 	"github.com/almostinf/glow-reminder/pkg/clock"
-	"github.com/almostinf/glow-reminder/pkg/glow_reminder/client"
-	"github.com/almostinf/glow-reminder/pkg/glow_reminder/client/operations"
 	"github.com/almostinf/glow-reminder/pkg/logger"
 	"github.com/almostinf/glow-reminder/pkg/postgres"
 	rediswrapper "github.com/almostinf/glow-reminder/pkg/redis"
+	"github.com/almostinf/glow-reminder/pkg/tzfinder"
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
 	"github.com/go-openapi/strfmt"
 	"go.uber.org/fx"
 )
@@ -28,33 +36,107 @@ func CreateApp() fx.Option {
 			logger.NewLogrusLogger,
 			bot.FromAppConfig,
 			clock.New,
+			tzfinder.New,
+			timeparse.New,
 			bot.New,
 			usecase.NewReminder,
 			fx.Annotate(usecase.NewReminder, fx.As(new(usecase.ReminderUsecase))),
+			fx.Annotate(usecase.NewUser, fx.As(new(usecase.UserUsecase))),
+			fx.Annotate(usecase.NewFiredReminder, fx.As(new(usecase.FiredReminderUsecase))),
+			fx.Annotate(usecase.NewDevice, fx.As(new(usecase.DeviceUsecase))),
+			fx.Annotate(usecase.NewDelivery, fx.As(new(usecase.DeliveryUsecase))),
+			fx.Annotate(usecase.NewToken, fx.As(new(usecase.TokenUsecase))),
 			pg.NewReminderRepo,
 			fx.Annotate(pg.NewReminderRepo, fx.As(new(pg.ReminderRepo))),
+			fx.Annotate(pg.NewUserRepo, fx.As(new(pg.UserRepo))),
+			fx.Annotate(pg.NewUserSettingsRepo, fx.As(new(pg.UserSettingsRepo))),
+			fx.Annotate(pg.NewOutboxRepo, fx.As(new(pg.OutboxRepo))),
+			fx.Annotate(pg.NewDeadLetterRepo, fx.As(new(pg.DeadLetterRepo))),
+			fx.Annotate(pg.NewReminderAckRepo, fx.As(new(pg.ReminderAckRepo))),
+			fx.Annotate(pg.NewDeviceRepo, fx.As(new(pg.DeviceRepo))),
+			fx.Annotate(pg.NewDeviceStatusRepo, fx.As(new(pg.DeviceStatusRepo))),
+			fx.Annotate(pg.NewDeliveryRepo, fx.As(new(pg.DeliveryRepo))),
+			fx.Annotate(pg.NewTokenRepo, fx.As(new(pg.TokenRepo))),
 			postgres.FromAppConfig,
 			postgres.New,
+			transactionManager,
 			fx.Annotate(bot.New, fx.As(new(bot.Bot))),
+			bot.NewTelebot,
+			bot.NewStateStore,
+			fx.Annotate(notifier.NewTelegram, fx.As(new(notifier.Notifier))),
 			rediswrapper.FromAppConfig,
 			rediswrapper.New,
 			redis.NewReminderTaskRepo,
 			fx.Annotate(redis.NewReminderTaskRepo, fx.As(new(redis.ReminderTaskRepo))),
+			fx.Annotate(redis.NewFiredReminderRepo, fx.As(new(redis.FiredReminderRepo))),
+			fx.Annotate(redis.NewLeaderLockRepo, fx.As(new(redis.LeaderLockRepo))),
+			fx.Annotate(redis.NewFireEventRepo, fx.As(new(redis.FireEventRepo))),
+			leader.FromAppConfig,
+			fx.Annotate(leader.New, fx.As(new(leader.Elector))),
 			defaultStrfmtRegistry,
-			glowReminderTransportCfg,
-			client.NewHTTPClientWithConfig,
-			glowReminderOperations,
+			device.FromAppConfig,
+			fx.Annotate(device.New, fx.As(new(device.Registry))),
+			fx.Annotate(device.NewMQTT, fx.As(new(device.MQTTTransport))),
 			scheduler.FromAppConfig,
 			scheduler.New,
 			fx.Annotate(scheduler.New, fx.As(new(scheduler.ReminderScheduler))),
+			fx.Annotate(scheduler.NewFireEvents, fx.As(new(scheduler.FireEvents))),
+			healthcheck.FromAppConfig,
+			fx.Annotate(healthcheck.New, fx.As(new(healthcheck.Checker))),
+			relay.FromAppConfig,
+			fx.Annotate(relay.New, fx.As(new(relay.OutboxRelay))),
+			httpapi.FromAppConfig,
+			fx.Annotate(httpapi.New, fx.As(new(httpapi.Server))),
+			grpcapi.FromAppConfig,
+			fx.Annotate(grpcapi.New, fx.As(new(grpcapi.Server))),
 		),
 		fx.Invoke(
+			startLeader,
+			startFireEvents,
+			startMQTT,
 			startBot,
+			startRelay,
 			startScheduler,
+			startHealthCheck,
+			startHTTPAPI,
+			startGRPCAPI,
 		),
 	)
 }
 
+func startLeader(elector leader.Elector, lc fx.Lifecycle) error {
+	lc.Append(
+		fx.Hook{
+			OnStart: elector.Start,
+			OnStop:  elector.Stop,
+		},
+	)
+
+	return nil
+}
+
+func startFireEvents(fireEvents scheduler.FireEvents, lc fx.Lifecycle) error {
+	lc.Append(
+		fx.Hook{
+			OnStart: fireEvents.Start,
+			OnStop:  fireEvents.Stop,
+		},
+	)
+
+	return nil
+}
+
+func startMQTT(transport device.MQTTTransport, lc fx.Lifecycle) error {
+	lc.Append(
+		fx.Hook{
+			OnStart: transport.Start,
+			OnStop:  transport.Stop,
+		},
+	)
+
+	return nil
+}
+
 func startBot(b bot.Bot, lc fx.Lifecycle) error {
 	lc.Append(
 		fx.Hook{
@@ -77,21 +159,58 @@ func startScheduler(scheduler scheduler.ReminderScheduler, lc fx.Lifecycle) erro
 	return nil
 }
 
-func appCtx() context.Context {
-	return context.Background()
+func startRelay(relay relay.OutboxRelay, lc fx.Lifecycle) error {
+	lc.Append(
+		fx.Hook{
+			OnStart: relay.Start,
+			OnStop:  relay.Stop,
+		},
+	)
+
+	return nil
 }
 
-func defaultStrfmtRegistry() strfmt.Registry {
-	return strfmt.Default
+func startHealthCheck(checker healthcheck.Checker, lc fx.Lifecycle) error {
+	lc.Append(
+		fx.Hook{
+			OnStart: checker.Start,
+			OnStop:  checker.Stop,
+		},
+	)
+
+	return nil
 }
 
-func glowReminderTransportCfg(cfg *config.AppConfig) *client.TransportConfig {
-	return &client.TransportConfig{
-		Host:    cfg.GlowReminderClient.Host,
-		Schemes: []string{"http"},
-	}
+func startHTTPAPI(server httpapi.Server, lc fx.Lifecycle) error {
+	lc.Append(
+		fx.Hook{
+			OnStart: server.Start,
+			OnStop:  server.Stop,
+		},
+	)
+
+	return nil
 }
 
-func glowReminderOperations(client *client.GlowReminder) operations.ClientService {
-	return client.Operations
+func startGRPCAPI(server grpcapi.Server, lc fx.Lifecycle) error {
+	lc.Append(
+		fx.Hook{
+			OnStart: server.Start,
+			OnStop:  server.Stop,
+		},
+	)
+
+	return nil
+}
+
+func appCtx() context.Context {
+	return context.Background()
+}
+
+func transactionManager(pg *postgres.Postgres) trm.Manager {
+	return pg.TrManager
+}
+
+func defaultStrfmtRegistry() strfmt.Registry {
+	return strfmt.Default
 }
@@ -2,16 +2,21 @@ package bot
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	_ "time/tzdata"
 
 	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/leader"
+	"github.com/almostinf/glow-reminder/internal/notifier"
+	"github.com/almostinf/glow-reminder/internal/timeparse"
 	"github.com/almostinf/glow-reminder/internal/usecase"
 	"github.com/almostinf/glow-reminder/pkg/clock"
+	"github.com/almostinf/glow-reminder/pkg/fsm"
 	"github.com/almostinf/glow-reminder/pkg/logger"
 	"github.com/google/uuid"
 	telebot "gopkg.in/telebot.v4"
@@ -19,41 +24,181 @@ import (
 
 var (
 	// Universal markup builders.
-	menu           = &telebot.ReplyMarkup{ResizeKeyboard: true}
-	paginationMenu = &telebot.ReplyMarkup{}
-	colourMenu     = &telebot.ReplyMarkup{}
-	effectMenu     = &telebot.ReplyMarkup{}
+	menu               = &telebot.ReplyMarkup{ResizeKeyboard: true}
+	paginationMenu     = &telebot.ReplyMarkup{}
+	colourMenu         = &telebot.ReplyMarkup{}
+	effectsMenu        = &telebot.ReplyMarkup{}
+	repeatMenu         = &telebot.ReplyMarkup{}
+	confirmTimeMenu    = &telebot.ReplyMarkup{}
+	keepMenu           = &telebot.ReplyMarkup{}
+	editColourMenu     = &telebot.ReplyMarkup{}
+	editEffectsMenu    = &telebot.ReplyMarkup{}
+	editRepeatMenu     = &telebot.ReplyMarkup{}
+	deliveryMenu       = &telebot.ReplyMarkup{}
+	brightnessMenu     = &telebot.ReplyMarkup{}
+	editBrightnessMenu = &telebot.ReplyMarkup{}
+	editDeliveryMenu   = &telebot.ReplyMarkup{}
+	navigationMenu     = &telebot.ReplyMarkup{}
+	cancelMenu         = &telebot.ReplyMarkup{}
+	editTimeMenu       = &telebot.ReplyMarkup{}
+	confirmSaveMenu    = &telebot.ReplyMarkup{}
+	settingsMenu       = &telebot.ReplyMarkup{}
+	defaultColourMenu  = &telebot.ReplyMarkup{}
+	defaultEffectsMenu = &telebot.ReplyMarkup{}
+	timeLocaleMenu     = &telebot.ReplyMarkup{}
+	pageSizeMenu       = &telebot.ReplyMarkup{}
+	timeZoneMenu       = &telebot.ReplyMarkup{ResizeKeyboard: true, OneTimeKeyboard: true}
 
 	// Reply buttons.
 	btnHelp          = menu.Text("ℹ️ Help")
 	btnAddReminder   = menu.Text("➕ New Reminder")
 	btnListReminders = menu.Text("📂 Reminders List")
+	btnSettings      = menu.Text("⚙️ Settings")
+	btnShareLocation = timeZoneMenu.Location("📍 Share location")
 
 	// Pagination buttons.
 	btnPrev = paginationMenu.Data("⬅️", "pagination_prev")
 	btnNext = paginationMenu.Data("➡️", "pagination_next")
 
 	// Inline buttons.
-	btnColourRed      = colourMenu.Data("🔴 Red", "colour_red")
-	btnColourBlue     = colourMenu.Data("🔵 Blue", "colour_blue")
-	btnColourGreen    = colourMenu.Data("🟢 Green", "colour_green")
-	btnEffectStatic   = effectMenu.Data("🗿 Static", "effect_static")
-	btnEffectBlinking = effectMenu.Data("✨ Blinking", "effect_blinking")
-
-	startMsg               = "👋 Hello! It's a reminder bot"
-	choosingTimeMsg        = "🚀 Please enter the time in format 'YYYY-MM-DD HH:MM'"
-	tryAgainAddReminderMsg = "⚠️ Please start by clicking ➕ button"
-	tryAgainMsg            = "⚠️ Please try again"
-	helpMsg                = "Help:\n" +
+	btnColourRed       = colourMenu.Data("🔴 Red", "colour_red")
+	btnColourOrange    = colourMenu.Data("🟠 Orange", "colour_orange")
+	btnColourYellow    = colourMenu.Data("🟡 Yellow", "colour_yellow")
+	btnColourGreen     = colourMenu.Data("🟢 Green", "colour_green")
+	btnColourCyan      = colourMenu.Data("🩵 Cyan", "colour_cyan")
+	btnColourBlue      = colourMenu.Data("🔵 Blue", "colour_blue")
+	btnColourPurple    = colourMenu.Data("🟣 Purple", "colour_purple")
+	btnColourPink      = colourMenu.Data("🩷 Pink", "colour_pink")
+	btnColourWhite     = colourMenu.Data("⚪️ White", "colour_white")
+	btnColourCustom    = colourMenu.Data("🎨 #RRGGBB", "colour_custom")
+	btnBrightness25    = brightnessMenu.Data("25%", "brightness_25")
+	btnBrightness50    = brightnessMenu.Data("50%", "brightness_50")
+	btnBrightness75    = brightnessMenu.Data("75%", "brightness_75")
+	btnBrightness100   = brightnessMenu.Data("100%", "brightness_100")
+	btnEffectStatic    = effectsMenu.Data("🗿 Static", "effect_static")
+	btnEffectBlinking  = effectsMenu.Data("✨ Blinking", "effect_blinking")
+	btnEffectBreathing = effectsMenu.Data("🌬 Breathing", "effect_breathing")
+	btnEffectPulse     = effectsMenu.Data("💓 Pulse", "effect_pulse")
+	btnEffectRainbow   = effectsMenu.Data("🌈 Rainbow", "effect_rainbow")
+	btnEffectStrobe    = effectsMenu.Data("⚡️ Strobe", "effect_strobe")
+	btnEffectSunrise   = effectsMenu.Data("🌅 Sunrise", "effect_sunrise")
+	btnEffectKeyframes = effectsMenu.Data("🎞 Custom", "effect_keyframes")
+	btnKeep            = keepMenu.Data("⏭ Keep current", "keep_current")
+	btnTimeConfirm     = confirmTimeMenu.Data("✅ Yes", "time_confirm")
+	btnTimeChange      = confirmTimeMenu.Data("✏️ Change", "time_change")
+	btnRepeatOnce      = repeatMenu.Data("🚫 Once", "repeat_once")
+	btnRepeatDaily     = repeatMenu.Data("📅 Daily", "repeat_daily")
+	btnRepeatWeekly    = repeatMenu.Data("🗓 Weekly", "repeat_weekly")
+	btnRepeatMonthly   = repeatMenu.Data("📆 Monthly", "repeat_monthly")
+	btnRepeatHourly    = repeatMenu.Data("⏱ Every N hours", "repeat_hourly")
+	btnRepeatRule      = repeatMenu.Data("✍️ RRULE", "repeat_rrule")
+	btnDeliveryLight   = deliveryMenu.Data("💡 Light only", "delivery_light")
+	btnDeliveryMessage = deliveryMenu.Data("💬 Message only", "delivery_message")
+	btnDeliveryBoth    = deliveryMenu.Data("💡💬 Both", "delivery_both")
+	btnSave            = confirmSaveMenu.Data("✅ Save", "reminder_save")
+
+	// Settings buttons.
+	btnSettingsColour     = settingsMenu.Data("🎨 Colour", "settings_colour")
+	btnSettingsEffect     = settingsMenu.Data("✨ Effect", "settings_effect")
+	btnSettingsDevice     = settingsMenu.Data("💡 Lamp", "settings_device")
+	btnSettingsTimeZone   = settingsMenu.Data("🌍 Time zone", "settings_time_zone")
+	btnSettingsTimeLocale = settingsMenu.Data("🕐 Time format", "settings_time_locale")
+	btnSettingsPageSize   = settingsMenu.Data("📄 Page size", "settings_page_size")
+	btnSettingsDone       = settingsMenu.Data("✅ Done", "settings_done")
+	btnNoDefault          = defaultColourMenu.Data("🚫 No default", "settings_no_default")
+	btnTimeLocaleEnglish  = timeLocaleMenu.Data("🇬🇧 English", "time_locale_en")
+	btnTimeLocaleRussian  = timeLocaleMenu.Data("🇷🇺 Русский", "time_locale_ru")
+	btnPageSize5          = pageSizeMenu.Data("5", "page_size_5")
+	btnPageSize10         = pageSizeMenu.Data("10", "page_size_10")
+	btnPageSize20         = pageSizeMenu.Data("20", "page_size_20")
+
+	// Wizard navigation buttons shown under every step.
+	btnBack   = navigationMenu.Data("⬅️ Back", "wizard_back")
+	btnCancel = navigationMenu.Data("✖️ Cancel", "wizard_cancel")
+
+	startMsg          = "👋 Hello! It's a reminder bot"
+	choosingTimeMsg   = "🚀 When should I remind you? E.g. %s (time zone: %s)"
+	confirmingTimeMsg = "🕒 I understood it as %s (%s). Is that right?"
+	timeZoneMsg       = "🌍 Your time zone is %s, local time %s\n" +
+		"Send /timezone <IANA zone>, e.g. '/timezone Europe/Berlin', or share your location to change it"
+	tryAgainAddReminderMsg  = "⚠️ Please start by clicking ➕ button"
+	cancelledMsg            = "✖️ The reminder is discarded"
+	cancelledEditingMsg     = "✖️ The changes are discarded, the reminder is kept as it was"
+	nothingToCancelMsg      = "🤷 There is nothing to cancel"
+	timePassedMsg           = "⌛ The time of the reminder has passed while it was drafted. Please choose another time"
+	noPreviousStepMsg       = "⚠️ The previous steps are taken from your message, use ✖️ Cancel to start over"
+	tryAgainMsg             = "⚠️ Please try again"
+	reminderNotFoundMsg     = "❌ Reminder is not found, it may have already fired or been deleted"
+	failedToLoadTimeZoneMsg = "❌ Failed to load your time zone. Please set it with /timezone"
+	firedReminderExpiredMsg = "❌ This reminder can no longer be snoozed or acknowledged"
+	helpMsg                 = "Help:\n" +
 		"- Use the 📂 button to view scheduled reminders\n" +
 		"- Use the ➕ button to create a new reminder\n" +
+		"- Choose whether a reminder lights the lamp, sends you its text or both\n" +
+		"- Choose how the lamp glows: static, blinking, breathing, pulse, rainbow, strobe, sunrise or your own keyframes\n" +
+		"- Choose a repeat option to make a reminder recurring\n" +
+		"- Press ✅ under a fired reminder to turn the lamp off or 😴 to be reminded again later\n" +
 		"- Use the 🗑 button to delete existing reminder\n" +
-		"- Use the ⬅️ and ➡️ buttons to scroll through the list of reminders"
-
-	timeFormat = "2006-01-02 15:04"
-	limit      = int64(5)
+		"- Use the ✏️ button to edit existing reminder, ⏭ keeps the current value of a step\n" +
+		"- Use ⬅️ Back to return to the previous step, ✖️ Cancel or /cancel to discard the reminder\n" +
+		"- Use /remind tomorrow 9:00 blue blink Call mum or @<bot> in 30m red Stretch in any chat to add a reminder at once\n" +
+		"- Use ⚙️ Settings or /settings to set the default colour, effect and lamp of new reminders, " +
+		"your time zone, the format of the times and the number of reminders on a page of the list\n" +
+		"- Use the ⬅️ and ➡️ buttons to scroll through the list of reminders\n" +
+		"- Use the /timezone command or share your location to set your time zone\n" +
+		"- Use /quiet to keep the lamps quiet at night and /dnd 2h to keep them quiet for a while\n" +
+		"- Use /devices to list your lamps, /device_add, /device_rename and /device_remove to manage them\n" +
+		"- Use /token to issue a token of the REST API and /token_revoke to revoke it"
+
+	choosingBrightnessMsg = "🔆 Choose the brightness or enter it in percent"
+	choosingEffectMsg     = "🚀 Choose an effect"
+	enteringKeyframesMsg  = "🎞 Please enter the keyframes as '<#RRGGBB> [<brightness>%] <duration>' separated by commas " +
+		"and optionally the number of repeats, e.g. '#FF0000 1s, #0000FF 50% 500ms x3'"
+
+	timeFormat               = "2006-01-02 15:04"
+	confirmTimeFormat        = "Mon, 02 Jan 2006 15:04"
+	russianConfirmTimeFormat = "02.01.2006 15:04"
 )
 
+// timeExamples are the examples of the reminder times in the time locales of the bot.
+var timeExamples = map[domain.TimeLocale]string{
+	domain.EnglishTimeLocale: "'in 20 minutes', 'tomorrow 9:00', 'next friday at 18' or '2025-03-10 15:04'",
+	domain.RussianTimeLocale: "'через 20 минут', 'завтра в 9:00', 'в пятницу в 18' or '2025-03-10 15:04'",
+}
+
+var russianWeekdays = [...]string{"Вс", "Пн", "Вт", "Ср", "Чт", "Пт", "Сб"}
+
+// effectPresets are the effects of the effects menu buttons played with the default parameters.
+var effectPresets = []struct {
+	btn  telebot.Btn
+	kind domain.EffectKind
+}{
+	{btn: btnEffectStatic, kind: domain.StaticEffect},
+	{btn: btnEffectBlinking, kind: domain.BlinkingEffect},
+	{btn: btnEffectBreathing, kind: domain.BreathingEffect},
+	{btn: btnEffectPulse, kind: domain.PulseEffect},
+	{btn: btnEffectRainbow, kind: domain.RainbowEffect},
+	{btn: btnEffectStrobe, kind: domain.StrobeEffect},
+	{btn: btnEffectSunrise, kind: domain.SunriseEffect},
+	{btn: btnEffectKeyframes, kind: domain.KeyframesEffect},
+}
+
+// colourPresets are the colours of the palette buttons.
+var colourPresets = []struct {
+	btn telebot.Btn
+	rgb domain.RGB
+}{
+	{btn: btnColourRed, rgb: domain.RedRGB},
+	{btn: btnColourOrange, rgb: 0xFF8000},
+	{btn: btnColourYellow, rgb: 0xFFFF00},
+	{btn: btnColourGreen, rgb: domain.GreenRGB},
+	{btn: btnColourCyan, rgb: 0x00FFFF},
+	{btn: btnColourBlue, rgb: domain.BlueRGB},
+	{btn: btnColourPurple, rgb: 0x8000FF},
+	{btn: btnColourPink, rgb: 0xFF40A0},
+	{btn: btnColourWhite, rgb: 0xFFFFFF},
+}
+
 var _ Bot = (*bot)(nil)
 
 type Bot interface {
@@ -64,62 +209,247 @@ type Bot interface {
 type bot struct {
 	*telebot.Bot
 
-	userStates      map[int64]*userState
-	m               *sync.RWMutex
-	logger          logger.Logger
-	reminderUsecase usecase.ReminderUsecase
-	clock           clock.Clock
+	stateStore           StateStore
+	flow                 *fsm.Machine[state, *update]
+	logger               logger.Logger
+	reminderUsecase      usecase.ReminderUsecase
+	firedReminderUsecase usecase.FiredReminderUsecase
+	userUsecase          usecase.UserUsecase
+	deviceUsecase        usecase.DeviceUsecase
+	tokenUsecase         usecase.TokenUsecase
+	deliveryUsecase      usecase.DeliveryUsecase
+	clock                clock.Clock
+	timeParser           timeparse.Parser
 }
 
-func New(cfg Config, logger logger.Logger, reminderUsecase usecase.ReminderUsecase, clock clock.Clock) (*bot, error) {
+// NewTelebot creates the Telegram client shared by the bot and the notifier.
+// NewTelebot receives the updates through the webhook if its URL is set, so that every instance serves them.
+// Otherwise the updates are long polled by the leader alone.
+func NewTelebot(cfg Config, elector leader.Elector) (*telebot.Bot, error) {
+	var poller telebot.Poller = &leaderPoller{
+		poller:  &telebot.LongPoller{Timeout: cfg.PollerTimeout},
+		elector: elector,
+	}
+	if cfg.WebhookURL != "" {
+		poller = &telebot.Webhook{
+			Listen:      cfg.WebhookListen,
+			SecretToken: cfg.WebhookSecret,
+			Endpoint:    &telebot.WebhookEndpoint{PublicURL: cfg.WebhookURL},
+		}
+	}
+
 	tbot, err := telebot.NewBot(telebot.Settings{
 		Token:  cfg.Token,
-		Poller: &telebot.LongPoller{Timeout: cfg.PollerTimeout},
+		Poller: poller,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create new telebot: %w", err)
 	}
 
+	return tbot, nil
+}
+
+func New(
+	tbot *telebot.Bot,
+	stateStore StateStore,
+	logger logger.Logger,
+	reminderUsecase usecase.ReminderUsecase,
+	firedReminderUsecase usecase.FiredReminderUsecase,
+	userUsecase usecase.UserUsecase,
+	deviceUsecase usecase.DeviceUsecase,
+	tokenUsecase usecase.TokenUsecase,
+	deliveryUsecase usecase.DeliveryUsecase,
+	clock clock.Clock,
+	timeParser timeparse.Parser,
+) *bot {
 	menu.Reply(
-		menu.Row(btnListReminders, btnAddReminder, btnHelp),
+		menu.Row(btnListReminders, btnAddReminder, btnSettings, btnHelp),
 	)
 
 	paginationMenu.Inline(
 		paginationMenu.Row(btnPrev, btnNext),
 	)
 
+	navigationRow := navigationMenu.Row(btnBack, btnCancel)
+
+	navigationMenu.Inline(
+		navigationRow,
+	)
+
+	cancelMenu.Inline(
+		cancelMenu.Row(btnCancel),
+	)
+
+	editTimeMenu.Inline(
+		editTimeMenu.Row(btnKeep),
+		editTimeMenu.Row(btnCancel),
+	)
+
+	confirmSaveMenu.Inline(
+		confirmSaveMenu.Row(btnSave),
+		navigationRow,
+	)
+
 	colourMenu.Inline(
-		colourMenu.Row(btnColourRed, btnColourGreen, btnColourBlue),
+		colourMenu.Row(btnColourRed, btnColourOrange, btnColourYellow),
+		colourMenu.Row(btnColourGreen, btnColourCyan, btnColourBlue),
+		colourMenu.Row(btnColourPurple, btnColourPink, btnColourWhite),
+		colourMenu.Row(btnColourCustom),
+		navigationRow,
+	)
+
+	brightnessMenu.Inline(
+		brightnessMenu.Row(btnBrightness25, btnBrightness50, btnBrightness75, btnBrightness100),
+		navigationRow,
+	)
+
+	editBrightnessMenu.Inline(
+		editBrightnessMenu.Row(btnBrightness25, btnBrightness50, btnBrightness75, btnBrightness100),
+		editBrightnessMenu.Row(btnKeep),
+		navigationRow,
+	)
+
+	effectsMenu.Inline(
+		effectsMenu.Row(btnEffectStatic, btnEffectBlinking),
+		effectsMenu.Row(btnEffectBreathing, btnEffectPulse),
+		effectsMenu.Row(btnEffectRainbow, btnEffectStrobe),
+		effectsMenu.Row(btnEffectSunrise, btnEffectKeyframes),
+		navigationRow,
+	)
+
+	keepMenu.Inline(
+		keepMenu.Row(btnKeep),
+		navigationRow,
+	)
+
+	editColourMenu.Inline(
+		editColourMenu.Row(btnColourRed, btnColourOrange, btnColourYellow),
+		editColourMenu.Row(btnColourGreen, btnColourCyan, btnColourBlue),
+		editColourMenu.Row(btnColourPurple, btnColourPink, btnColourWhite),
+		editColourMenu.Row(btnColourCustom),
+		editColourMenu.Row(btnKeep),
+		navigationRow,
 	)
 
-	effectMenu.Inline(
-		effectMenu.Row(btnEffectStatic, btnEffectBlinking),
+	editEffectsMenu.Inline(
+		editEffectsMenu.Row(btnEffectStatic, btnEffectBlinking),
+		editEffectsMenu.Row(btnEffectBreathing, btnEffectPulse),
+		editEffectsMenu.Row(btnEffectRainbow, btnEffectStrobe),
+		editEffectsMenu.Row(btnEffectSunrise, btnEffectKeyframes),
+		editEffectsMenu.Row(btnKeep),
+		navigationRow,
 	)
 
-	return &bot{
+	editRepeatMenu.Inline(
+		editRepeatMenu.Row(btnRepeatOnce, btnRepeatDaily, btnRepeatWeekly),
+		editRepeatMenu.Row(btnRepeatMonthly, btnRepeatHourly, btnRepeatRule),
+		editRepeatMenu.Row(btnKeep),
+		navigationRow,
+	)
+
+	settingsMenu.Inline(
+		settingsMenu.Row(btnSettingsColour, btnSettingsEffect),
+		settingsMenu.Row(btnSettingsDevice, btnSettingsTimeZone),
+		settingsMenu.Row(btnSettingsTimeLocale, btnSettingsPageSize),
+		settingsMenu.Row(btnSettingsDone),
+	)
+
+	defaultColourMenu.Inline(
+		defaultColourMenu.Row(btnColourRed, btnColourOrange, btnColourYellow),
+		defaultColourMenu.Row(btnColourGreen, btnColourCyan, btnColourBlue),
+		defaultColourMenu.Row(btnColourPurple, btnColourPink, btnColourWhite),
+		defaultColourMenu.Row(btnNoDefault),
+		navigationRow,
+	)
+
+	defaultEffectsMenu.Inline(
+		defaultEffectsMenu.Row(btnEffectStatic, btnEffectBlinking),
+		defaultEffectsMenu.Row(btnEffectBreathing, btnEffectPulse),
+		defaultEffectsMenu.Row(btnEffectRainbow, btnEffectStrobe),
+		defaultEffectsMenu.Row(btnEffectSunrise),
+		defaultEffectsMenu.Row(btnNoDefault),
+		navigationRow,
+	)
+
+	timeLocaleMenu.Inline(
+		timeLocaleMenu.Row(btnTimeLocaleEnglish, btnTimeLocaleRussian),
+		navigationRow,
+	)
+
+	pageSizeMenu.Inline(
+		pageSizeMenu.Row(btnPageSize5, btnPageSize10, btnPageSize20),
+		navigationRow,
+	)
+
+	timeZoneMenu.Reply(
+		timeZoneMenu.Row(btnShareLocation),
+	)
+
+	confirmTimeMenu.Inline(
+		confirmTimeMenu.Row(btnTimeConfirm, btnTimeChange),
+		navigationRow,
+	)
+
+	repeatMenu.Inline(
+		repeatMenu.Row(btnRepeatOnce, btnRepeatDaily, btnRepeatWeekly),
+		repeatMenu.Row(btnRepeatMonthly, btnRepeatHourly, btnRepeatRule),
+		navigationRow,
+	)
+
+	deliveryMenu.Inline(
+		deliveryMenu.Row(btnDeliveryLight, btnDeliveryMessage, btnDeliveryBoth),
+		navigationRow,
+	)
+
+	editDeliveryMenu.Inline(
+		editDeliveryMenu.Row(btnDeliveryLight, btnDeliveryMessage, btnDeliveryBoth),
+		editDeliveryMenu.Row(btnKeep),
+		navigationRow,
+	)
+
+	b := &bot{
 		Bot: tbot,
 
-		userStates:      make(map[int64]*userState),
-		m:               &sync.RWMutex{},
-		logger:          logger,
-		reminderUsecase: reminderUsecase,
-		clock:           clock,
-	}, nil
+		stateStore:           stateStore,
+		logger:               logger,
+		reminderUsecase:      reminderUsecase,
+		firedReminderUsecase: firedReminderUsecase,
+		userUsecase:          userUsecase,
+		deviceUsecase:        deviceUsecase,
+		tokenUsecase:         tokenUsecase,
+		deliveryUsecase:      deliveryUsecase,
+		clock:                clock,
+		timeParser:           timeParser,
+	}
+	b.flow = newFlow(b)
+
+	return b
 }
 
 func (b *bot) setUserState(userID int64, us *userState) {
-	b.m.Lock()
-	defer b.m.Unlock()
-
-	b.userStates[userID] = us
+	if err := b.stateStore.SetState(context.TODO(), userID, us); err != nil {
+		b.logger.Error("failed to SetState", map[string]interface{}{
+			"user_id": userID,
+			"err":     err.Error(),
+		})
+	}
 }
 
-func (b *bot) getUserState(userID int64) (*userState, bool) {
-	b.m.RLock()
-	defer b.m.RUnlock()
+// getUserState returns the state of the user. A fresh menu state is returned if the state is not found,
+// has expired or cannot be loaded, so the user is asked to start over.
+func (b *bot) getUserState(userID int64) *userState {
+	us, ok, err := b.stateStore.GetState(context.TODO(), userID)
+	if err != nil {
+		b.logger.Error("failed to GetState", map[string]interface{}{
+			"user_id": userID,
+			"err":     err.Error(),
+		})
+	}
+	if err != nil || !ok {
+		return &userState{s: menuState}
+	}
 
-	us, ok := b.userStates[userID]
-	return us, ok
+	return us
 }
 
 func (b *bot) Start(_ context.Context) error {
@@ -129,6 +459,22 @@ func (b *bot) Start(_ context.Context) error {
 	b.Handle(telebot.OnText, b.handleText())
 	b.Handle(telebot.OnCallback, b.handleCallback())
 	b.Handle(&btnListReminders, b.handleListReminders())
+	b.Handle("/timezone", b.handleTimeZone())
+	b.Handle(telebot.OnLocation, b.handleLocation())
+	b.Handle("/devices", b.handleDevices())
+	b.Handle("/device_add", b.handleDeviceAdd())
+	b.Handle("/device_rename", b.handleDeviceRename())
+	b.Handle("/device_remove", b.handleDeviceRemove())
+	b.Handle("/token", b.handleToken())
+	b.Handle("/token_revoke", b.handleTokenRevoke())
+	b.Handle("/quiet", b.handleQuiet())
+	b.Handle("/dnd", b.handleDND())
+	b.Handle("/cancel", b.handleCancel())
+	b.Handle("/remind", b.handleRemind())
+	b.Handle("/settings", b.handleSettingsCommand())
+	b.Handle(&btnSettings, b.handleSettingsCommand())
+	b.Handle(telebot.OnQuery, b.handleQuery())
+	b.Handle(telebot.OnInlineResult, b.handleInlineResult())
 
 	go func() {
 		b.Bot.Start()
@@ -161,337 +507,1150 @@ func (b *bot) handleHelp() func(c telebot.Context) error {
 }
 
 func (b *bot) handleAddReminder() func(c telebot.Context) error {
+	return func(c telebot.Context) error {
+		return b.begin(c, timeChoosingState)
+	}
+}
+
+func (b *bot) handleTimeZone() func(c telebot.Context) error {
 	return func(c telebot.Context) error {
 		userID := c.Sender().ID
 
-		b.setUserState(c.Sender().ID, &userState{
-			s: timeChoosingState,
-			reminder: domain.Reminder{
-				UserID: userID,
-			},
+		b.setUserState(userID, &userState{
+			s: menuState,
 		})
 
-		return c.Send(choosingTimeMsg)
+		timeZone := strings.TrimSpace(c.Message().Payload)
+		if timeZone == "" {
+			location, err := b.userLocation(context.TODO(), userID)
+			if err != nil {
+				return c.Send(failedToLoadTimeZoneMsg)
+			}
+
+			return c.Send(fmt.Sprintf(timeZoneMsg, location, b.clock.NowUTC().In(location).Format(timeFormat)), timeZoneMenu)
+		}
+
+		if err := b.userUsecase.SetTimeZone(context.TODO(), userID, timeZone); err != nil {
+			if errors.Is(err, usecase.ErrInvalidTimeZone) {
+				return c.Send("❌ Unknown time zone. Please use an IANA name, e.g. 'Europe/Berlin'")
+			}
+			b.logger.Error("failed to SetTimeZone", map[string]interface{}{
+				"user_id":   userID,
+				"time_zone": timeZone,
+				"err":       err.Error(),
+			})
+			return c.Send(tryAgainMsg)
+		}
+
+		return c.Send("✅ Time zone is set to "+timeZone, menu)
 	}
 }
 
-func (b *bot) handleText() func(c telebot.Context) error {
+func (b *bot) handleLocation() func(c telebot.Context) error {
 	return func(c telebot.Context) error {
 		userID := c.Sender().ID
-		us, ok := b.getUserState(userID)
-		if !ok {
-			us.s = menuState
-			b.setUserState(userID, us)
-			return c.Send(tryAgainAddReminderMsg)
+
+		b.setUserState(userID, &userState{
+			s: menuState,
+		})
+
+		location := c.Message().Location
+
+		timeZone, err := b.userUsecase.SetTimeZoneByCoordinates(
+			context.TODO(),
+			userID,
+			float64(location.Lat),
+			float64(location.Lng),
+		)
+		if err != nil {
+			b.logger.Error("failed to SetTimeZoneByCoordinates", map[string]interface{}{
+				"user_id": userID,
+				"lat":     location.Lat,
+				"lng":     location.Lng,
+				"err":     err.Error(),
+			})
+			return c.Send("❌ Failed to detect your time zone. Please use /timezone <IANA zone>", menu)
 		}
 
-		switch us.s {
-		case timeChoosingState:
-			return b.handleChoosingTime(c)
-		case textEnteringState:
-			return b.handleTextEntering(c)
-		default:
-			us.s = menuState
-			b.setUserState(userID, us)
-			return c.Send(tryAgainAddReminderMsg)
+		return c.Send("✅ Time zone is set to "+timeZone, menu)
+	}
+}
+
+// userSettings returns the settings of the user. The defaults are returned if the settings cannot be loaded,
+// since they only save the user a few steps.
+func (b *bot) userSettings(ctx context.Context, userID int64) *domain.UserSettings {
+	settings, err := b.userUsecase.GetUserSettings(ctx, userID)
+	if err != nil {
+		b.logger.Error("failed to GetUserSettings", map[string]interface{}{
+			"user_id": userID,
+			"err":     err.Error(),
+		})
+		return &domain.UserSettings{
+			UserID:     userID,
+			TimeLocale: domain.DefaultTimeLocale,
+			PageSize:   domain.DefaultPageSize,
 		}
 	}
+
+	return settings
+}
+
+// pageSize returns the number of reminders on a page of the list of the user.
+func (b *bot) pageSize(ctx context.Context, userID int64) int64 {
+	pageSize := b.userSettings(ctx, userID).PageSize
+	if domain.ValidatePageSize(pageSize) != nil {
+		return domain.DefaultPageSize
+	}
+
+	return int64(pageSize)
+}
+
+func (b *bot) userLocation(ctx context.Context, userID int64) (*time.Location, error) {
+	user, err := b.userUsecase.GetUser(ctx, userID)
+	if err != nil {
+		b.logger.Error("failed to GetUser", map[string]interface{}{
+			"user_id": userID,
+			"err":     err.Error(),
+		})
+		return nil, fmt.Errorf("failed to GetUser: %w", err)
+	}
+
+	location, err := user.Location()
+	if err != nil {
+		b.logger.Error("failed to load user location", map[string]interface{}{
+			"user_id":   userID,
+			"time_zone": user.TimeZone,
+			"err":       err.Error(),
+		})
+		return nil, fmt.Errorf("failed to load user location: %w", err)
+	}
+
+	return location, nil
+}
+
+func (b *bot) handleText() func(c telebot.Context) error {
+	return func(c telebot.Context) error {
+		return b.dispatch(c, fsm.Text)
+	}
 }
 
 func (b *bot) handleCallback() func(c telebot.Context) error {
 	return func(c telebot.Context) error {
-		userID := c.Sender().ID
-		us, ok := b.getUserState(userID)
-		if !ok {
-			us.s = menuState
-			b.setUserState(userID, us)
-			return c.Send(tryAgainMsg)
+		// The buttons of a fired reminder work whatever the user is doing in the bot.
+		if isFiredReminderCallback(c.Callback().Data) {
+			return b.handleFiredReminder(c)
 		}
 
-		switch us.s {
-		case colourChoosingState:
-			return b.handleChoosingColour(c)
-		case effectChoosingState:
-			return b.handleChoosingEffect(c)
-		case listRemindersState:
-			return b.waitingListReminders(c)
-		default:
-			us.s = menuState
-			b.setUserState(userID, us)
-			return c.Send(tryAgainMsg)
+		switch c.Callback().Data {
+		case "\f" + btnBack.Unique:
+			return b.back(c)
+		case "\f" + btnCancel.Unique:
+			return b.cancel(c)
+		}
+
+		return b.dispatch(c, fsm.Callback)
+	}
+}
+
+func (b *bot) handleCancel() func(c telebot.Context) error {
+	return func(c telebot.Context) error {
+		return b.cancel(c)
+	}
+}
+
+func (b *bot) newUpdate(c telebot.Context) *update {
+	return &update{
+		Context: c,
+		userID:  c.Sender().ID,
+		us:      b.getUserState(c.Sender().ID),
+	}
+}
+
+// dispatch passes the input to the handler of the state the user is in.
+func (b *bot) dispatch(c telebot.Context, input fsm.Input) error {
+	u := b.newUpdate(c)
+
+	next, err := b.flow.Handle(u, u.us.s, input)
+
+	return b.finish(u, next, err)
+}
+
+// back returns the user to the previous step of the wizard, the values entered so far are kept.
+func (b *bot) back(c telebot.Context) error {
+	u := b.newUpdate(c)
+	u.back = true
+
+	next, err := b.flow.Back(u, u.us.s)
+	// The steps of a quick added reminder filled in from the message are skipped going back, so there may be none left.
+	if u.us.quick && errors.Is(err, fsm.ErrInvalidTransition) {
+		return c.Send(noPreviousStepMsg)
+	}
+
+	return b.finish(u, next, err)
+}
+
+// cancel discards the draft of the user and returns them to the menu.
+func (b *bot) cancel(c telebot.Context) error {
+	u := b.newUpdate(c)
+
+	msg := cancelledMsg
+	switch {
+	case u.us.s == menuState || u.us.s == listRemindersState:
+		msg = nothingToCancelMsg
+	case isSettingsState(u.us.s):
+		msg = settingsClosedMsg
+	case u.us.editing:
+		msg = cancelledEditingMsg
+	}
+
+	next, err := b.flow.Cancel(u, u.us.s)
+	if err = b.finish(u, next, err); err != nil {
+		return err
+	}
+
+	return c.Send(msg, menu)
+}
+
+// begin starts a flow from the given state whatever the user was doing before.
+func (b *bot) begin(c telebot.Context, s state) error {
+	return b.beginDraft(c, &userState{
+		s: menuState,
+		reminder: domain.Reminder{
+			UserID: c.Sender().ID,
+		},
+	}, s)
+}
+
+// beginDraft starts a flow from the given state with the draft filled in beforehand.
+func (b *bot) beginDraft(c telebot.Context, draft *userState, s state) error {
+	u := &update{
+		Context: c,
+		userID:  c.Sender().ID,
+		us:      draft,
+	}
+
+	next, err := b.flow.Transit(u, menuState, s)
+
+	return b.finish(u, next, err)
+}
+
+// finish stores the state the flow has moved to. The draft is dropped once the flow is back in the menu.
+// An input the flow does not expect resets it, so the user is asked to start over.
+func (b *bot) finish(u *update, next state, err error) error {
+	if errors.Is(err, fsm.ErrNoHandler) || errors.Is(err, fsm.ErrUnknownState) ||
+		errors.Is(err, fsm.ErrInvalidTransition) || errors.Is(err, fsm.ErrTransitionLoop) {
+		if !errors.Is(err, fsm.ErrNoHandler) {
+			b.logger.Error("invalid flow transition", map[string]interface{}{
+				"user_id": u.userID,
+				"state":   u.us.s,
+				"err":     err.Error(),
+			})
 		}
+		b.setUserState(u.userID, &userState{
+			s: menuState,
+		})
+		return u.Send(tryAgainAddReminderMsg)
+	}
+	if err != nil {
+		return err
 	}
+
+	if next == menuState {
+		u.us = &userState{}
+	}
+	u.us.s = next
+
+	b.setUserState(u.userID, u.us)
+
+	return nil
+}
+
+func isFiredReminderCallback(data string) bool {
+	return strings.HasPrefix(data, "\f"+notifier.DoneReminderUnique+":") ||
+		strings.HasPrefix(data, "\f"+notifier.SnoozeReminderUnique+":")
 }
 
-func (b *bot) handleChoosingTime(c telebot.Context) error {
+func (b *bot) handleFiredReminder(c telebot.Context) error {
 	userID := c.Sender().ID
-	us, ok := b.getUserState(userID)
-	if !ok || us.s != timeChoosingState {
-		us.s = menuState
-		b.setUserState(userID, us)
-		return c.Send(tryAgainAddReminderMsg)
+
+	callbackSplitted := strings.Split(strings.TrimPrefix(c.Callback().Data, "\f"), ":")
+
+	id, err := uuid.Parse(callbackSplitted[1])
+	if err != nil {
+		b.logger.Error("failed to parse uuid", map[string]interface{}{
+			"user_id":       userID,
+			"callback_data": c.Callback().Data,
+		})
+		return c.Send(tryAgainMsg)
+	}
+
+	if callbackSplitted[0] == notifier.DoneReminderUnique {
+		return b.acknowledgeReminder(c, userID, id)
+	}
+
+	var minutes int
+	if len(callbackSplitted) == 3 {
+		minutes, err = strconv.Atoi(callbackSplitted[2])
+	}
+	if err != nil || minutes <= 0 {
+		b.logger.Error("invalid snooze duration", map[string]interface{}{
+			"user_id":       userID,
+			"callback_data": c.Callback().Data,
+		})
+		return c.Send(tryAgainMsg)
 	}
 
-	mskLocation, err := time.LoadLocation("Europe/Moscow")
+	return b.snoozeReminder(c, userID, id, time.Duration(minutes)*time.Minute)
+}
+
+func (b *bot) acknowledgeReminder(c telebot.Context, userID int64, id uuid.UUID) error {
+	err := b.firedReminderUsecase.AcknowledgeReminder(context.TODO(), userID, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		return c.Send(firedReminderExpiredMsg)
+	}
 	if err != nil {
-		us.s = menuState
-		b.setUserState(userID, us)
-		b.logger.Error("Failed to load Moscow location", map[string]interface{}{
-			"error": err.Error(),
+		b.logger.Error("failed to AcknowledgeReminder", map[string]interface{}{
+			"user_id":     userID,
+			"reminder_id": id.String(),
+			"err":         err.Error(),
+		})
+		return c.Send(tryAgainMsg)
+	}
+
+	// Editing the message removes its buttons.
+	return c.Edit(c.Message().Text + "\n\n✅ Done")
+}
+
+func (b *bot) snoozeReminder(c telebot.Context, userID int64, id uuid.UUID, duration time.Duration) error {
+	scheduledAt, err := b.firedReminderUsecase.SnoozeReminder(context.TODO(), userID, id, duration)
+	if errors.Is(err, domain.ErrNotFound) {
+		return c.Send(firedReminderExpiredMsg)
+	}
+	if err != nil {
+		b.logger.Error("failed to SnoozeReminder", map[string]interface{}{
+			"user_id":     userID,
+			"reminder_id": id.String(),
+			"err":         err.Error(),
 		})
-		return c.Send("❌ Failed to load Moscow location")
+		return c.Send(tryAgainMsg)
 	}
 
-	timeStr := c.Text()
-	parsedTime, err := time.ParseInLocation(timeFormat, timeStr, mskLocation)
+	location, err := b.userLocation(context.TODO(), userID)
 	if err != nil {
-		us.s = menuState
-		b.setUserState(userID, us)
-		return c.Send("❌ Invalid time format. Please use 'YYYY-MM-DD HH:MM'")
+		return c.Send(failedToLoadTimeZoneMsg)
+	}
+
+	return c.Edit(c.Message().Text + "\n\n😴 Snoozed until " + scheduledAt.In(location).Format(timeFormat))
+}
+
+func (b *bot) enterChoosingTime(u *update) (state, error) {
+	if u.us.quick && !u.us.reminder.ScheduledAt.IsZero() {
+		return textEnteringState, nil
 	}
 
-	us.reminder.ScheduledAt = parsedTime
-	us.s = textEnteringState
+	location, err := b.userLocation(context.TODO(), u.userID)
+	if err != nil {
+		return menuState, u.Send(failedToLoadTimeZoneMsg)
+	}
 
-	b.setUserState(userID, us)
+	timeLocale := b.userSettings(context.TODO(), u.userID).TimeLocale
 
-	return c.Send("🚀 Please enter a reminder text")
+	return timeChoosingState, b.sendTimePrompt(u, u.us, location, timeLocale)
 }
 
-func (b *bot) handleTextEntering(c telebot.Context) error {
-	userID := c.Sender().ID
-	us, ok := b.getUserState(userID)
-	if !ok || us.s != textEnteringState {
-		us.s = menuState
-		b.setUserState(userID, us)
-		return c.Send(tryAgainAddReminderMsg)
+func (b *bot) handleChoosingTime(u *update) (state, error) {
+	location, err := b.userLocation(context.TODO(), u.userID)
+	if err != nil {
+		return menuState, u.Send(failedToLoadTimeZoneMsg)
 	}
 
-	msg := c.Text()
+	parsedTime, err := b.timeParser.Parse(u.Text(), location)
+	if err != nil {
+		timeLocale := b.userSettings(context.TODO(), u.userID).TimeLocale
+		return timeChoosingState, u.Send("❌ I couldn't understand the time. " + choosingTimePrompt(location, timeLocale))
+	}
 
-	us.reminder.Msg = msg
-	us.s = colourChoosingState
+	if !parsedTime.After(b.clock.NowUTC()) {
+		return timeChoosingState, u.Send("❌ This time is in the past. Please enter a time in the future")
+	}
 
-	b.setUserState(userID, us)
+	u.us.reminder.ScheduledAt = parsedTime.UTC()
 
-	return c.Send("🚀 Choose an effect colour", colourMenu)
+	return timeConfirmingState, nil
 }
 
-func (b *bot) handleChoosingColour(c telebot.Context) error {
-	userID := c.Sender().ID
-	us, ok := b.getUserState(userID)
-	if !ok || us.s != colourChoosingState {
-		us.s = menuState
-		b.setUserState(userID, us)
-		return c.Send(tryAgainAddReminderMsg)
-	}
-
-	var colour domain.Colour
-	switch c.Callback().Data {
-	case "\fcolour_red":
-		colour = domain.Red
-	case "\fcolour_green":
-		colour = domain.Green
-	case "\fcolour_blue":
-		colour = domain.Blue
+func (b *bot) enterConfirmingTime(u *update) (state, error) {
+	location, err := b.userLocation(context.TODO(), u.userID)
+	if err != nil {
+		return menuState, u.Send(failedToLoadTimeZoneMsg)
+	}
+
+	timeLocale := b.userSettings(context.TODO(), u.userID).TimeLocale
+	scheduledAt := formatConfirmTime(u.us.reminder.ScheduledAt.In(location), timeLocale)
+
+	return timeConfirmingState, u.Send(fmt.Sprintf(confirmingTimeMsg, scheduledAt, location), confirmTimeMenu)
+}
+
+func (b *bot) handleConfirmingTime(u *update) (state, error) {
+	switch u.Callback().Data {
+	case "\ftime_confirm":
+		return textEnteringState, nil
+	case "\ftime_change":
+		return timeChoosingState, nil
+	default:
+		b.logger.Error("invalid time confirming", map[string]interface{}{
+			"user_id":       u.userID,
+			"callback_date": u.Callback().Data,
+		})
+		return menuState, u.Send(tryAgainAddReminderMsg)
+	}
+}
+
+func (b *bot) enterTextEntering(u *update) (state, error) {
+	if u.us.quick && u.us.reminder.Msg != "" {
+		return deliveryChoosingState, nil
+	}
+
+	return textEnteringState, b.sendTextPrompt(u, u.us)
+}
+
+func (b *bot) handleTextEntering(u *update) (state, error) {
+	u.us.reminder.Msg = u.Text()
+
+	return deliveryChoosingState, nil
+}
+
+func (b *bot) handleKeepingTime(u *update) (state, error) {
+	if !u.us.editing || u.Callback().Data != "\fkeep_current" {
+		return menuState, u.Send(tryAgainAddReminderMsg)
+	}
+
+	return textEnteringState, nil
+}
+
+func (b *bot) handleKeepingText(u *update) (state, error) {
+	if !u.us.editing || u.Callback().Data != "\fkeep_current" {
+		return menuState, u.Send(tryAgainAddReminderMsg)
+	}
+
+	return deliveryChoosingState, nil
+}
+
+func (b *bot) sendTimePrompt(c telebot.Context, us *userState, location *time.Location, timeLocale domain.TimeLocale) error {
+	if !us.editing {
+		return c.Send(choosingTimePrompt(location, timeLocale), cancelMenu)
+	}
+
+	current := formatConfirmTime(us.reminder.ScheduledAt.In(location), timeLocale)
+
+	return c.Send(choosingTimePrompt(location, timeLocale)+"\nCurrent: "+current, editTimeMenu)
+}
+
+func (b *bot) sendTextPrompt(c telebot.Context, us *userState) error {
+	if !us.editing {
+		return c.Send("🚀 Please enter a reminder text", navigationMenu)
+	}
+
+	return c.Send("🚀 Please enter a reminder text\nCurrent: "+us.reminder.Msg, keepMenu)
+}
+
+func (b *bot) sendDeliveryPrompt(c telebot.Context, us *userState) error {
+	if !us.editing {
+		return c.Send("🚀 How should I remind you?", deliveryMenu)
+	}
+
+	return c.Send("🚀 How should I remind you?\nCurrent: "+describeDelivery(us.reminder.Delivery), editDeliveryMenu)
+}
+
+func (b *bot) sendColourPrompt(c telebot.Context, us *userState) error {
+	if !us.editing {
+		return c.Send("🚀 Choose an effect colour", colourMenu)
+	}
+
+	return c.Send("🚀 Choose an effect colour\nCurrent: "+describeRGB(us.reminder.RGB), editColourMenu)
+}
+
+func (b *bot) sendBrightnessPrompt(c telebot.Context, us *userState) error {
+	if !us.editing {
+		return c.Send(choosingBrightnessMsg, brightnessMenu)
+	}
+
+	return c.Send(choosingBrightnessMsg+fmt.Sprintf("\nCurrent: %d%%", us.reminder.Brightness), editBrightnessMenu)
+}
+
+func (b *bot) sendEffectPrompt(c telebot.Context, us *userState) error {
+	if !us.editing {
+		return c.Send(choosingEffectMsg, effectsMenu)
+	}
+
+	return c.Send(choosingEffectMsg+"\nCurrent: "+describeEffect(us.reminder.Effect), editEffectsMenu)
+}
+
+func (b *bot) sendRepeatPrompt(c telebot.Context, us *userState) error {
+	if !us.editing {
+		return c.Send("🚀 Choose how often to repeat the reminder", repeatMenu)
+	}
+
+	return c.Send("🚀 Choose how often to repeat the reminder\nCurrent: "+describeRecurrence(us.reminder.Recurrence), editRepeatMenu)
+}
+
+// enterChoosingDelivery asks how to remind. A quick added reminder lights the lamp and sends the message.
+func (b *bot) enterChoosingDelivery(u *update) (state, error) {
+	if u.us.quick {
+		return deviceChoosingState, nil
+	}
+
+	return deliveryChoosingState, b.sendDeliveryPrompt(u, u.us)
+}
+
+func (b *bot) handleChoosingDelivery(u *update) (state, error) {
+	var delivery domain.Delivery
+	switch u.Callback().Data {
+	case "\fdelivery_light":
+		delivery = domain.LightOnly
+	case "\fdelivery_message":
+		delivery = domain.MessageOnly
+	case "\fdelivery_both":
+		delivery = domain.LightAndMessage
+	case "\fkeep_current":
+		if !u.us.editing {
+			return menuState, u.Send(tryAgainAddReminderMsg)
+		}
+		delivery = u.us.reminder.Delivery
+	default:
+		b.logger.Error("invalid delivery choosing", map[string]interface{}{
+			"user_id":       u.userID,
+			"callback_date": u.Callback().Data,
+		})
+		return menuState, u.Send("❌ Invalid delivery. Please choose light, message or both")
+	}
+
+	u.us.reminder.Delivery = delivery
+
+	return deviceChoosingState, nil
+}
+
+// skipWithoutLight skips the lamp settings for a reminder that only sends a message.
+func skipWithoutLight(u *update) bool {
+	return !u.us.reminder.Delivery.HasLight()
+}
+
+func (b *bot) enterChoosingColour(u *update) (state, error) {
+	if skipWithoutLight(u) {
+		return repeatChoosingState, nil
+	}
+
+	if u.us.quick && u.us.reminder.RGB != domain.NoRGB {
+		return brightnessChoosingState, nil
+	}
+
+	if !u.us.editing && !u.back {
+		if settings := b.userSettings(context.TODO(), u.userID); settings.DefaultRGB != domain.NoRGB {
+			return b.setRGB(u, settings.DefaultRGB)
+		}
+	}
+
+	return colourChoosingState, b.sendColourPrompt(u, u.us)
+}
+
+func (b *bot) handleChoosingColour(u *update) (state, error) {
+	data := u.Callback().Data
+
+	for _, preset := range colourPresets {
+		if data == "\f"+preset.btn.Unique {
+			return b.setRGB(u, preset.rgb)
+		}
+	}
+
+	switch data {
+	case "\fcolour_custom":
+		return rgbEnteringState, nil
+	case "\fkeep_current":
+		// A reminder that only sent messages has no colour to keep.
+		if !u.us.editing || u.us.reminder.RGB == domain.NoRGB {
+			return menuState, u.Send(tryAgainAddReminderMsg)
+		}
+		return b.setRGB(u, u.us.reminder.RGB)
 	default:
-		us.s = menuState
-		b.setUserState(userID, us)
 		b.logger.Error("invalid colour choosing", map[string]interface{}{
-			"user_id":       userID,
-			"callback_date": c.Callback().Unique,
+			"user_id":       u.userID,
+			"callback_date": u.Callback().Unique,
 		})
-		return c.Send("❌ Invalid colour. Please choose red, green or blue")
+		return menuState, u.Send("❌ Invalid colour. Please choose a colour from the palette or send a #RRGGBB one")
 	}
+}
 
-	us.reminder.Colour = colour
-	us.s = effectChoosingState
+func (b *bot) enterRGBEntering(u *update) (state, error) {
+	return rgbEnteringState, u.Send("🎨 Please enter a colour in #RRGGBB format, e.g. '#FF8800'", navigationMenu)
+}
 
-	b.setUserState(userID, us)
+func (b *bot) handleRGBEntering(u *update) (state, error) {
+	rgb, err := domain.ParseRGB(u.Text())
+	if err != nil {
+		return rgbEnteringState, u.Send("❌ Invalid colour: " + err.Error() + ". Please try again")
+	}
 
-	return c.Send("🚀 Choose an effect mode", effectMenu)
+	return b.setRGB(u, rgb)
 }
 
-func (b *bot) handleChoosingEffect(c telebot.Context) error {
-	userID := c.Sender().ID
-	us, ok := b.getUserState(userID)
-	if !ok || us.s != effectChoosingState {
-		us.s = menuState
-		b.setUserState(userID, us)
-		return c.Send(tryAgainAddReminderMsg)
-	}
-
-	var mode domain.Mode
-	switch c.Callback().Data {
-	case "\feffect_static":
-		mode = domain.Static
-	case "\feffect_blinking":
-		mode = domain.Blinking
+// setRGB sets the colour together with the legacy colour for devices that only support three colours.
+func (b *bot) setRGB(u *update, rgb domain.RGB) (state, error) {
+	u.us.reminder.RGB = rgb
+	u.us.reminder.Colour = rgb.LegacyColour()
+
+	return brightnessChoosingState, nil
+}
+
+func (b *bot) enterChoosingBrightness(u *update) (state, error) {
+	if skipWithoutLight(u) {
+		return repeatChoosingState, nil
+	}
+
+	if u.us.quick {
+		return effectChoosingState, nil
+	}
+
+	return brightnessChoosingState, b.sendBrightnessPrompt(u, u.us)
+}
+
+func (b *bot) handleChoosingBrightness(u *update) (state, error) {
+	data := u.Callback().Data
+
+	if data == "\fkeep_current" {
+		if !u.us.editing || u.us.reminder.Brightness == 0 {
+			return menuState, u.Send(tryAgainAddReminderMsg)
+		}
+		return b.setBrightness(u, u.us.reminder.Brightness)
+	}
+
+	brightness, err := strconv.ParseInt(strings.TrimPrefix(data, "\fbrightness_"), 10, 8)
+	if !strings.HasPrefix(data, "\fbrightness_") || err != nil {
+		b.logger.Error("invalid brightness choosing", map[string]interface{}{
+			"user_id":       u.userID,
+			"callback_date": data,
+		})
+		return menuState, u.Send(tryAgainAddReminderMsg)
+	}
+
+	return b.setBrightness(u, int8(brightness))
+}
+
+func (b *bot) handleBrightnessEntering(u *update) (state, error) {
+	brightness, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimSpace(u.Text()), "%"), 10, 8)
+	if err != nil || domain.ValidateBrightness(int8(brightness)) != nil {
+		return brightnessChoosingState, u.Send(fmt.Sprintf("❌ Invalid brightness. Please enter a number from %d to %d",
+			domain.MinBrightness, domain.MaxBrightness))
+	}
+
+	return b.setBrightness(u, int8(brightness))
+}
+
+func (b *bot) setBrightness(u *update, brightness int8) (state, error) {
+	u.us.reminder.Brightness = brightness
+
+	return effectChoosingState, nil
+}
+
+func (b *bot) enterChoosingEffect(u *update) (state, error) {
+	if skipWithoutLight(u) {
+		return repeatChoosingState, nil
+	}
+
+	if u.us.quick && u.us.reminder.Effect.Kind != "" {
+		return repeatChoosingState, nil
+	}
+
+	if !u.us.editing && !u.back {
+		if settings := b.userSettings(context.TODO(), u.userID); settings.DefaultEffect != "" {
+			return b.setEffect(u, domain.DefaultEffect(settings.DefaultEffect))
+		}
+	}
+
+	return effectChoosingState, b.sendEffectPrompt(u, u.us)
+}
+
+func (b *bot) handleChoosingEffect(u *update) (state, error) {
+	data := u.Callback().Data
+
+	if data == "\fkeep_current" {
+		// A reminder that did not light the lamp before has no effect to keep.
+		if !u.us.editing || u.us.reminder.Effect.Kind == "" {
+			return menuState, u.Send(tryAgainAddReminderMsg)
+		}
+		return b.setEffect(u, u.us.reminder.Effect)
+	}
+
+	for _, preset := range effectPresets {
+		if data != "\f"+preset.btn.Unique {
+			continue
+		}
+
+		if preset.kind == domain.KeyframesEffect {
+			return keyframesEnteringState, nil
+		}
+
+		return b.setEffect(u, domain.DefaultEffect(preset.kind))
+	}
+
+	b.logger.Error("invalid effect choosing", map[string]interface{}{
+		"user_id":       u.userID,
+		"callback_date": data,
+	})
+
+	return menuState, u.Send("❌ Invalid effect. Please choose one of the effects")
+}
+
+func (b *bot) enterKeyframesEntering(u *update) (state, error) {
+	return keyframesEnteringState, u.Send(enteringKeyframesMsg, navigationMenu)
+}
+
+func (b *bot) handleKeyframesEntering(u *update) (state, error) {
+	effect, err := domain.ParseKeyframes(u.Text())
+	if err != nil {
+		return keyframesEnteringState, u.Send("❌ Invalid keyframes: " + err.Error() + "\n" + enteringKeyframesMsg)
+	}
+
+	return b.setEffect(u, effect)
+}
+
+func (b *bot) setEffect(u *update, effect domain.Effect) (state, error) {
+	u.us.reminder.Effect = effect
+	u.us.reminder.Mode = effect.LegacyMode()
+
+	return repeatChoosingState, nil
+}
+
+func (b *bot) enterChoosingRepeat(u *update) (state, error) {
+	if u.us.quick {
+		return reminderConfirmingState, nil
+	}
+
+	return repeatChoosingState, b.sendRepeatPrompt(u, u.us)
+}
+
+func (b *bot) handleChoosingRepeat(u *update) (state, error) {
+	switch u.Callback().Data {
+	case "\fkeep_current":
+		if !u.us.editing {
+			return menuState, u.Send(tryAgainAddReminderMsg)
+		}
+	case "\frepeat_once":
+		u.us.reminder.Recurrence = domain.NoRecurrence
+	case "\frepeat_daily":
+		u.us.reminder.Recurrence = "FREQ=DAILY"
+	case "\frepeat_monthly":
+		u.us.reminder.Recurrence = "FREQ=MONTHLY"
+	case "\frepeat_weekly":
+		return repeatDaysEnteringState, nil
+	case "\frepeat_hourly":
+		return repeatHoursEnteringState, nil
+	case "\frepeat_rrule":
+		return repeatRuleEnteringState, nil
 	default:
-		us.s = menuState
-		b.setUserState(userID, us)
-		b.logger.Error("invalid effect choosing", map[string]interface{}{
-			"user_id":       userID,
-			"callback_date": c.Callback().Data,
+		b.logger.Error("invalid repeat choosing", map[string]interface{}{
+			"user_id":       u.userID,
+			"callback_date": u.Callback().Data,
 		})
-		return c.Send("❌ Invalid mode. Please choose blinking or static")
+		return menuState, u.Send("❌ Invalid repeat option")
 	}
 
-	us.reminder.Mode = mode
-	us.reminder.ID = uuid.New()
-	us.reminder.CreatedAt = b.clock.NowUTC()
-	us.reminder.UpdatedAt = b.clock.NowUTC()
-	us.s = menuState
+	return reminderConfirmingState, nil
+}
 
-	b.setUserState(userID, us)
+func (b *bot) enterRepeatDaysEntering(u *update) (state, error) {
+	return repeatDaysEnteringState, u.Send("🚀 Please enter the weekdays, e.g. 'MO,WE,FR'", navigationMenu)
+}
 
-	if err := b.reminderUsecase.CreateReminder(context.TODO(), us.reminder); err != nil {
-		us.s = menuState
-		b.setUserState(userID, us)
-		b.logger.Error("failed to CreateReminder", map[string]interface{}{
-			"user_id":  userID,
-			"reminder": us.reminder,
-			"err":      err.Error(),
+func (b *bot) handleRepeatDaysEntering(u *update) (state, error) {
+	days := strings.ToUpper(strings.ReplaceAll(u.Text(), " ", ""))
+
+	return b.setRecurrence(u, domain.Recurrence("FREQ=WEEKLY;BYDAY="+days))
+}
+
+func (b *bot) enterRepeatHoursEntering(u *update) (state, error) {
+	return repeatHoursEnteringState, u.Send("🚀 Please enter the number of hours between reminders", navigationMenu)
+}
+
+func (b *bot) handleRepeatHoursEntering(u *update) (state, error) {
+	hours, err := strconv.Atoi(strings.TrimSpace(u.Text()))
+	if err != nil || hours <= 0 {
+		return repeatHoursEnteringState, u.Send("❌ Invalid number of hours. Please enter a positive number")
+	}
+
+	return b.setRecurrence(u, domain.Recurrence(fmt.Sprintf("FREQ=HOURLY;INTERVAL=%d", hours)))
+}
+
+func (b *bot) enterRepeatRuleEntering(u *update) (state, error) {
+	return repeatRuleEnteringState, u.Send("🚀 Please enter an iCalendar RRULE, e.g. 'FREQ=WEEKLY;INTERVAL=2;BYDAY=TU'", navigationMenu)
+}
+
+func (b *bot) handleRepeatRuleEntering(u *update) (state, error) {
+	return b.setRecurrence(u, domain.Recurrence(strings.TrimSpace(u.Text())))
+}
+
+// setRecurrence keeps the user in the current step until the rule is valid.
+func (b *bot) setRecurrence(u *update, recurrence domain.Recurrence) (state, error) {
+	if _, err := recurrence.Parse(); err != nil {
+		return u.us.s, u.Send("❌ Invalid repeat rule: " + err.Error() + ". Please try again")
+	}
+
+	u.us.reminder.Recurrence = recurrence
+
+	return reminderConfirmingState, nil
+}
+
+// enterConfirmingReminder sums the reminder up before it is saved.
+func (b *bot) enterConfirmingReminder(u *update) (state, error) {
+	location, err := b.userLocation(context.TODO(), u.userID)
+	if err != nil {
+		return menuState, u.Send(failedToLoadTimeZoneMsg)
+	}
+
+	devices, err := b.deviceUsecase.GetDevices(context.TODO(), u.userID)
+	if err != nil {
+		b.logger.Error("failed to GetDevices", map[string]interface{}{
+			"user_id": u.userID,
+			"err":     err.Error(),
 		})
-		return c.Send(tryAgainAddReminderMsg)
+		return menuState, u.Send(tryAgainAddReminderMsg)
 	}
 
-	return c.Send("✅ Reminder successfully created")
+	summary := describeReminder(&u.us.reminder, location, devices) + "\n\n🚀 Save the reminder?"
+
+	return reminderConfirmingState, u.Send(summary, confirmSaveMenu)
 }
 
-func (b *bot) handleListReminders() func(c telebot.Context) error {
-	return func(c telebot.Context) error {
-		userID := c.Sender().ID
+func (b *bot) handleConfirmingReminder(u *update) (state, error) {
+	if u.Callback().Data != "\f"+btnSave.Unique {
+		b.logger.Error("invalid reminder confirming", map[string]interface{}{
+			"user_id":       u.userID,
+			"callback_date": u.Callback().Data,
+		})
+		return menuState, u.Send(tryAgainAddReminderMsg)
+	}
 
-		b.setUserState(userID, &userState{
-			s: listRemindersState,
+	return b.saveReminder(u)
+}
+
+// saveReminder checks the time of the reminder again, since a draft is kept for a while
+// and a reminder saved with a time in the past would fire at once.
+func (b *bot) saveReminder(u *update) (state, error) {
+	if !u.us.reminder.ScheduledAt.After(b.clock.NowUTC()) {
+		// The time of a quick added reminder is only asked for if it is missing.
+		if u.us.quick {
+			u.us.reminder.ScheduledAt = time.Time{}
+		}
+		return timeChoosingState, u.Send(timePassedMsg)
+	}
+
+	if u.us.editing {
+		return b.updateReminder(u)
+	}
+
+	return b.createReminder(u)
+}
+
+func (b *bot) updateReminder(u *update) (state, error) {
+	u.us.reminder.UpdatedAt = b.clock.NowUTC()
+
+	if err := b.reminderUsecase.UpdateReminder(context.TODO(), u.us.reminder); err != nil {
+		b.logger.Error("failed to UpdateReminder", map[string]interface{}{
+			"user_id":  u.userID,
+			"reminder": u.us.reminder,
+			"err":      err.Error(),
 		})
+		return menuState, u.Send(tryAgainMsg)
+	}
+
+	return menuState, u.Send("✅ Reminder successfully updated")
+}
 
-		return b.listReminders(context.TODO(), c, domain.GetRemindersParams{
-			UserID: userID,
-			Limit:  uint64(limit),
+func (b *bot) createReminder(u *update) (state, error) {
+	if err := b.addReminder(context.TODO(), &u.us.reminder); err != nil {
+		return menuState, u.Send(tryAgainAddReminderMsg)
+	}
+
+	return menuState, u.Send("✅ Reminder successfully created")
+}
+
+// addReminder saves the new reminder with a fresh id.
+func (b *bot) addReminder(ctx context.Context, reminder *domain.Reminder) error {
+	reminder.ID = uuid.New()
+	reminder.CreatedAt = b.clock.NowUTC()
+	reminder.UpdatedAt = b.clock.NowUTC()
+
+	if err := b.reminderUsecase.CreateReminder(ctx, *reminder); err != nil {
+		b.logger.Error("failed to CreateReminder", map[string]interface{}{
+			"user_id":  reminder.UserID,
+			"reminder": *reminder,
+			"err":      err.Error(),
 		})
+		return fmt.Errorf("failed to CreateReminder: %w", err)
 	}
+
+	return nil
+}
+
+func (b *bot) handleListReminders() func(c telebot.Context) error {
+	return func(c telebot.Context) error {
+		return b.begin(c, listRemindersState)
+	}
+}
+
+func (b *bot) enterListReminders(u *update) (state, error) {
+	return b.listReminders(context.TODO(), u)
 }
 
-func (b *bot) listReminders(ctx context.Context, c telebot.Context, params domain.GetRemindersParams) error {
+// listReminders sends the page of the reminders of the user at their offset.
+func (b *bot) listReminders(ctx context.Context, u *update) (state, error) {
 	reminders, err := b.reminderUsecase.GetReminders(ctx, domain.GetRemindersParams{
-		UserID: params.UserID,
-		Offset: params.Offset,
-		Limit:  params.Limit,
+		UserID: u.userID,
+		Offset: uint64(u.us.offset),
+		Limit:  uint64(b.pageSize(ctx, u.userID)),
 	})
 	if err != nil {
-		b.setUserState(params.UserID, &userState{
-			s: menuState,
-		})
 		b.logger.Error("failed to GetReminders", map[string]interface{}{
-			"user_id":   params.UserID,
+			"user_id":   u.userID,
 			"reminders": reminders,
 			"err":       err.Error(),
 		})
-		return c.Send(tryAgainAddReminderMsg)
+		return menuState, u.Send(tryAgainAddReminderMsg)
+	}
+
+	location, err := b.userLocation(ctx, u.userID)
+	if err != nil {
+		return menuState, u.Send(failedToLoadTimeZoneMsg)
 	}
 
+	devices, err := b.deviceUsecase.GetDevices(ctx, u.userID)
+	if err != nil {
+		b.logger.Error("failed to GetDevices", map[string]interface{}{
+			"user_id": u.userID,
+			"err":     err.Error(),
+		})
+		return menuState, u.Send(tryAgainAddReminderMsg)
+	}
+
+	reminderIDs := make([]uuid.UUID, 0, len(reminders))
 	for _, reminder := range reminders {
-		var colour string
-		switch reminder.Colour {
-		case domain.Red:
-			colour = "🔴 Red"
-		case domain.Green:
-			colour = "🟢 Green"
-		case domain.Blue:
-			colour = "🔵 Blue"
-		}
+		reminderIDs = append(reminderIDs, reminder.ID)
+	}
 
-		var mode string
-		switch reminder.Mode {
-		case domain.Blinking:
-			mode = "✨ Blinking"
-		case domain.Static:
-			mode = "🗿 Static"
-		}
+	// The reminders are still listed if their deliveries can not be loaded.
+	deliveries, err := b.deliveryUsecase.GetLastDeliveries(ctx, reminderIDs)
+	if err != nil {
+		b.logger.Error("failed to GetLastDeliveries", map[string]interface{}{
+			"user_id": u.userID,
+			"err":     err.Error(),
+		})
+	}
 
-		reminderMsg := "🗓 Reminder\n" +
-			"Message: " + reminder.Msg + "\n" +
-			"Scheduled At: " + reminder.ScheduledAt.Format(timeFormat) + "\n" +
-			"Colour: " + colour + "\n" +
-			"Mode: " + mode
+	for _, reminder := range reminders {
+		reminderMsg := describeReminder(reminder, location, devices)
 
-		deleteMenu := &telebot.ReplyMarkup{}
-		btnDelete := deleteMenu.Data("🗑 Delete", fmt.Sprintf("delete_reminder:%s", reminder.ID))
-		deleteMenu.Inline(deleteMenu.Row(btnDelete))
+		if reminderDeliveries := deliveries[reminder.ID]; reminder.Delivery.HasLight() && len(reminderDeliveries) != 0 {
+			reminderMsg += "\n" + describeDeliveries(reminderDeliveries, location)
+		}
 
-		if err = c.Send(reminderMsg, deleteMenu); err != nil {
-			b.setUserState(params.UserID, &userState{
-				s: menuState,
-			})
+		reminderMenu := &telebot.ReplyMarkup{}
+		btnEdit := reminderMenu.Data("✏️ Edit", fmt.Sprintf("edit_reminder:%s", reminder.ID))
+		btnDelete := reminderMenu.Data("🗑 Delete", fmt.Sprintf("delete_reminder:%s", reminder.ID))
+		reminderMenu.Inline(reminderMenu.Row(btnEdit, btnDelete))
+
+		if err = u.Send(reminderMsg, reminderMenu); err != nil {
 			b.logger.Error("failed to Send reminderMsg", map[string]interface{}{
-				"user_id":      params.UserID,
+				"user_id":      u.userID,
 				"reminder_msg": reminderMsg,
 				"err":          err.Error(),
 			})
-			return c.Send(tryAgainAddReminderMsg)
+			return menuState, u.Send(tryAgainAddReminderMsg)
 		}
 	}
 
-	return c.Send("⚙️ Control menu", paginationMenu)
+	return listRemindersState, u.Send("⚙️ Control menu", paginationMenu)
 }
 
-func (b *bot) waitingListReminders(c telebot.Context) error {
-	userID := c.Sender().ID
-	us, ok := b.getUserState(userID)
-	if !ok || us.s != listRemindersState {
-		us.s = menuState
-		b.setUserState(userID, us)
-		return c.Send(tryAgainAddReminderMsg)
-	}
-
-	callbackSplitted := strings.Split(c.Callback().Data, ":")
+func (b *bot) handleListingReminders(u *update) (state, error) {
+	callbackSplitted := strings.Split(u.Callback().Data, ":")
 
 	if len(callbackSplitted) > 1 {
-		return b.deleteReminder(c, userID, us, callbackSplitted[1])
+		switch callbackSplitted[0] {
+		case "\fedit_reminder":
+			return b.editReminder(u, callbackSplitted[1])
+		default:
+			return b.deleteReminder(u, callbackSplitted[1])
+		}
 	}
 
+	pageSize := b.pageSize(context.TODO(), u.userID)
+
 	switch callbackSplitted[0] {
 	case "\fpagination_prev":
-		if us.offset-limit >= 0 {
-			us.offset -= limit
+		if u.us.offset-pageSize >= 0 {
+			u.us.offset -= pageSize
 		}
 	case "\fpagination_next":
-		us.offset += limit
+		u.us.offset += pageSize
 	}
 
-	b.setUserState(userID, us)
+	return b.listReminders(context.TODO(), u)
+}
 
-	return b.listReminders(context.TODO(), c, domain.GetRemindersParams{
-		UserID: userID,
-		Offset: uint64(us.offset),
-		Limit:  uint64(limit),
-	})
+func (b *bot) editReminder(u *update, reminderID string) (state, error) {
+	id, err := uuid.Parse(reminderID)
+	if err != nil {
+		b.logger.Error("failed to parse uuid", map[string]interface{}{
+			"user_id":     u.userID,
+			"reminder_id": reminderID,
+		})
+		return menuState, u.Send(tryAgainMsg)
+	}
+
+	reminder, err := b.reminderUsecase.GetOwnReminder(context.TODO(), u.userID, id)
+	if err != nil {
+		b.logger.Error("failed to GetOwnReminder", map[string]interface{}{
+			"user_id":     u.userID,
+			"reminder_id": id.String(),
+			"err":         fmt.Sprint(err),
+		})
+		return menuState, u.Send(reminderNotFoundMsg)
+	}
+
+	u.us.reminder = *reminder
+	u.us.editing = true
+
+	return timeChoosingState, nil
 }
 
-func (b *bot) deleteReminder(c telebot.Context, userID int64, us *userState, reminderID string) error {
+func (b *bot) deleteReminder(u *update, reminderID string) (state, error) {
 	id, err := uuid.Parse(reminderID)
 	if err != nil {
-		us.s = menuState
-		b.setUserState(userID, us)
 		b.logger.Error("failed to parse uuid", map[string]interface{}{
-			"user_id":     userID,
+			"user_id":     u.userID,
 			"reminder_id": reminderID,
 		})
-		return c.Send(tryAgainAddReminderMsg)
+		return menuState, u.Send(tryAgainAddReminderMsg)
 	}
 
-	if err = b.reminderUsecase.DeleteReminder(context.TODO(), id); err != nil {
-		us.s = menuState
-		b.setUserState(userID, us)
+	err = b.reminderUsecase.DeleteReminder(context.TODO(), u.userID, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		return listRemindersState, u.Send(reminderNotFoundMsg)
+	}
+	if err != nil {
 		b.logger.Error("failed to DeleteReminder", map[string]interface{}{
-			"user_id":     userID,
+			"user_id":     u.userID,
 			"reminder_id": id.String(),
 			"err":         err.Error(),
 		})
-		return c.Send(tryAgainAddReminderMsg)
+		return menuState, u.Send(tryAgainAddReminderMsg)
+	}
+
+	return listRemindersState, u.Send("✅ Reminder successfully deleted")
+}
+
+func choosingTimePrompt(location *time.Location, timeLocale domain.TimeLocale) string {
+	examples, ok := timeExamples[timeLocale]
+	if !ok {
+		examples = timeExamples[domain.DefaultTimeLocale]
+	}
+
+	return fmt.Sprintf(choosingTimeMsg, examples, location)
+}
+
+func formatConfirmTime(t time.Time, timeLocale domain.TimeLocale) string {
+	if timeLocale == domain.RussianTimeLocale {
+		return russianWeekdays[t.Weekday()] + ", " + t.Format(russianConfirmTimeFormat)
+	}
+
+	return t.Format(confirmTimeFormat)
+}
+
+func describeReminder(reminder *domain.Reminder, location *time.Location, devices []*domain.Device) string {
+	description := "🗓 Reminder\n" +
+		"Message: " + reminder.Msg + "\n" +
+		"Scheduled At: " + reminder.ScheduledAt.In(location).Format(timeFormat) + " (" + location.String() + ")" + "\n" +
+		"Repeat: " + describeRecurrence(reminder.Recurrence) + "\n" +
+		"Delivery: " + describeDelivery(reminder.Delivery)
+
+	if reminder.Delivery.HasLight() {
+		description += "\n" +
+			"Lamp: " + describeDeviceTarget(reminder, devices) + "\n" +
+			"Colour: " + describeRGB(reminder.RGB) + fmt.Sprintf(", brightness %d%%", reminder.Brightness) + "\n" +
+			"Effect: " + describeEffect(reminder.Effect)
+	}
+
+	return description
+}
+
+func describeRecurrence(recurrence domain.Recurrence) string {
+	if !recurrence.IsRecurring() {
+		return "🚫 Once"
+	}
+
+	rule, err := recurrence.Parse()
+	if err != nil {
+		return string(recurrence)
+	}
+
+	var description string
+	switch rule.Freq {
+	case domain.Hourly:
+		description = fmt.Sprintf("⏱ Every %d hour(s)", rule.Interval)
+	case domain.Daily:
+		description = fmt.Sprintf("📅 Every %d day(s)", rule.Interval)
+	case domain.Weekly:
+		description = fmt.Sprintf("🗓 Every %d week(s)", rule.Interval)
+		if len(rule.ByDay) != 0 {
+			days := make([]string, 0, len(rule.ByDay))
+			for _, day := range rule.ByDay {
+				days = append(days, day.String()[:3])
+			}
+			description += " on " + strings.Join(days, ", ")
+		}
+	case domain.Monthly:
+		description = fmt.Sprintf("📆 Every %d month(s)", rule.Interval)
+	}
+
+	if !rule.Until.IsZero() {
+		description += " until " + rule.Until.Format(timeFormat)
+	}
+
+	return description
+}
+
+func describeDelivery(delivery domain.Delivery) string {
+	switch {
+	case delivery.HasLight() && delivery.HasMessage():
+		return "💡💬 Light and message"
+	case delivery.HasMessage():
+		return "💬 Message only"
+	default:
+		return "💡 Light only"
+	}
+}
+
+func describeRGB(rgb domain.RGB) string {
+	for _, preset := range colourPresets {
+		if preset.rgb == rgb {
+			return preset.btn.Text
+		}
+	}
+
+	return "🎨 " + rgb.Hex()
+}
+
+func describeEffect(effect domain.Effect) string {
+	for _, preset := range effectPresets {
+		if preset.kind != effect.Kind {
+			continue
+		}
+
+		if effect.Kind == domain.KeyframesEffect {
+			return fmt.Sprintf("%s, %d keyframes, %d times", preset.btn.Text, len(effect.Keyframes), effect.Repeat)
+		}
+
+		return fmt.Sprintf("%s, %s", preset.btn.Text, effect.TotalDuration())
 	}
 
-	return c.Send("✅ Reminder successfully deleted")
+	return ""
 }
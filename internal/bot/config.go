@@ -9,11 +9,26 @@ import (
 type Config struct {
 	Token         string
 	PollerTimeout time.Duration
+	// WebhookURL is the public URL Telegram posts the updates to, the updates are long polled if it is empty.
+	WebhookURL string
+	// WebhookListen is the address the webhook server listens on.
+	WebhookListen string
+	// WebhookSecret is checked against the secret token header of the webhook requests.
+	WebhookSecret string
+	// StateStore selects the store of the conversation states, MemoryStateStore or RedisStateStore.
+	StateStore string
+	// StateTTL is how long an untouched conversation state is kept.
+	StateTTL time.Duration
 }
 
 func FromAppConfig(appCfg *config.AppConfig) Config {
 	return Config{
 		Token:         appCfg.Bot.Token,
 		PollerTimeout: appCfg.Bot.PoolerTimeout,
+		WebhookURL:    appCfg.Bot.WebhookURL,
+		WebhookListen: appCfg.Bot.WebhookListen,
+		WebhookSecret: appCfg.Bot.WebhookSecret,
+		StateStore:    appCfg.Bot.StateStore,
+		StateTTL:      appCfg.Bot.StateTTL,
 	}
 }
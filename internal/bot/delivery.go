@@ -0,0 +1,26 @@
+package bot
+
+import (
+	"strings"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+)
+
+var deliveryStatusNames = map[domain.DeliveryStatus]string{
+	domain.DeliveryQueued:       "⏳ not reached yet",
+	domain.DeliverySent:         "📨 sent, not confirmed",
+	domain.DeliveryAcknowledged: "💡 lit",
+	domain.DeliveryCompleted:    "✅ done",
+	domain.DeliveryFailed:       "❌ failed",
+}
+
+// describeDeliveries shows whether the lamps have really been lit by the last firing of the reminder.
+func describeDeliveries(deliveries []*domain.LampDelivery, location *time.Location) string {
+	statuses := make([]string, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		statuses = append(statuses, delivery.DeviceName+" "+deliveryStatusNames[delivery.Status])
+	}
+
+	return "Last fired: " + deliveries[0].FiredAt.In(location).Format(timeFormat) + ", " + strings.Join(statuses, ", ")
+}
@@ -0,0 +1,388 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/usecase"
+	"github.com/google/uuid"
+	telebot "gopkg.in/telebot.v4"
+)
+
+const (
+	deviceAddUsageMsg = "Usage: /device_add <name> <host[:port] or lamp id> [group=<group>] [mqtt] [legacy] [no_effects]\n" +
+		"- mqtt is for lamps behind NAT, connected to the MQTT broker with the given lamp id\n" +
+		"- legacy is for lamps that only glow red, green or blue\n" +
+		"- no_effects is for lamps that only glow static or blinking"
+	deviceRenameUsageMsg = "Usage: /device_rename <name> <new name>"
+	deviceRemoveUsageMsg = "Usage: /device_remove <name>"
+	noDevicesMsg         = "💡 You have no lamps, your reminders light the default one\n" + deviceAddUsageMsg
+	choosingDeviceMsg    = "💡 Which lamp should glow?"
+	allDevicesText       = "🏠 All lamps"
+
+	deviceGroupOption     = "group="
+	legacyDeviceOption    = "legacy"
+	noEffectsDeviceOption = "no_effects"
+	mqttDeviceOption      = "mqtt"
+)
+
+func (b *bot) handleDevices() func(c telebot.Context) error {
+	return func(c telebot.Context) error {
+		userID := c.Sender().ID
+
+		b.setUserState(userID, &userState{
+			s: menuState,
+		})
+
+		devices, err := b.deviceUsecase.GetDevices(context.TODO(), userID)
+		if err != nil {
+			b.logger.Error("failed to GetDevices", map[string]interface{}{
+				"user_id": userID,
+				"err":     err.Error(),
+			})
+			return c.Send(tryAgainMsg)
+		}
+
+		if len(devices) == 0 {
+			return c.Send(noDevicesMsg)
+		}
+
+		deviceIDs := make([]uuid.UUID, 0, len(devices))
+		for _, device := range devices {
+			deviceIDs = append(deviceIDs, device.ID)
+		}
+
+		statuses, err := b.deviceUsecase.GetDeviceStatuses(context.TODO(), deviceIDs)
+		if err != nil {
+			b.logger.Error("failed to GetDeviceStatuses", map[string]interface{}{
+				"user_id": userID,
+				"err":     err.Error(),
+			})
+		}
+
+		location, err := b.userLocation(context.TODO(), userID)
+		if err != nil {
+			location = time.UTC
+		}
+
+		devicesMsg := "💡 Your lamps:"
+		for _, device := range devices {
+			devicesMsg += "\n- " + describeDevice(device) + " " + describeDeviceStatus(statuses[device.ID], location)
+		}
+
+		return c.Send(devicesMsg)
+	}
+}
+
+func (b *bot) handleDeviceAdd() func(c telebot.Context) error {
+	return func(c telebot.Context) error {
+		userID := c.Sender().ID
+
+		b.setUserState(userID, &userState{
+			s: menuState,
+		})
+
+		device, ok := parseDevice(c.Message().Payload)
+		if !ok {
+			return c.Send(deviceAddUsageMsg)
+		}
+		device.UserID = userID
+
+		if err := b.deviceUsecase.CreateDevice(context.TODO(), device); err != nil {
+			return b.sendDeviceError(c, userID, device.Name, err)
+		}
+
+		return c.Send("✅ Lamp " + describeDevice(&device) + " is added")
+	}
+}
+
+func (b *bot) handleDeviceRename() func(c telebot.Context) error {
+	return func(c telebot.Context) error {
+		userID := c.Sender().ID
+
+		b.setUserState(userID, &userState{
+			s: menuState,
+		})
+
+		args := strings.Fields(c.Message().Payload)
+		if len(args) != 2 {
+			return c.Send(deviceRenameUsageMsg)
+		}
+
+		if err := b.deviceUsecase.RenameDevice(context.TODO(), userID, args[0], args[1]); err != nil {
+			name := args[0]
+			if errors.Is(err, domain.ErrAlreadyExists) {
+				name = args[1]
+			}
+			return b.sendDeviceError(c, userID, name, err)
+		}
+
+		return c.Send(fmt.Sprintf("✅ Lamp %s is renamed to %s", args[0], args[1]))
+	}
+}
+
+func (b *bot) handleDeviceRemove() func(c telebot.Context) error {
+	return func(c telebot.Context) error {
+		userID := c.Sender().ID
+
+		b.setUserState(userID, &userState{
+			s: menuState,
+		})
+
+		args := strings.Fields(c.Message().Payload)
+		if len(args) != 1 {
+			return c.Send(deviceRemoveUsageMsg)
+		}
+
+		if err := b.deviceUsecase.DeleteDevice(context.TODO(), userID, args[0]); err != nil {
+			return b.sendDeviceError(c, userID, args[0], err)
+		}
+
+		return c.Send(fmt.Sprintf("🗑 Lamp %s is removed, its reminders light all your lamps now", args[0]))
+	}
+}
+
+func (b *bot) sendDeviceError(c telebot.Context, userID int64, name string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return c.Send(fmt.Sprintf("❌ You have no lamp named %s", name))
+	case errors.Is(err, domain.ErrAlreadyExists):
+		return c.Send(fmt.Sprintf("❌ You already have a lamp named %s", name))
+	case errors.Is(err, domain.ErrInvalidDeviceName), errors.Is(err, usecase.ErrInvalidDeviceHost):
+		return c.Send("❌ " + err.Error())
+	default:
+		b.logger.Error("failed to manage device", map[string]interface{}{
+			"user_id": userID,
+			"name":    name,
+			"err":     err.Error(),
+		})
+		return c.Send(tryAgainMsg)
+	}
+}
+
+// parseDevice parses the arguments of /device_add.
+func parseDevice(payload string) (domain.Device, bool) {
+	args := strings.Fields(payload)
+	if len(args) < 2 {
+		return domain.Device{}, false
+	}
+
+	device := domain.Device{
+		Name:         args[0],
+		Host:         args[1],
+		Protocol:     domain.HTTPProtocol,
+		Capabilities: domain.AllCapabilities,
+	}
+
+	for _, option := range args[2:] {
+		switch {
+		case strings.HasPrefix(option, deviceGroupOption):
+			device.Group = strings.TrimPrefix(option, deviceGroupOption)
+		case option == legacyDeviceOption:
+			device.Capabilities = 0
+		case option == noEffectsDeviceOption:
+			device.Capabilities &^= domain.EffectsCapability
+		case option == mqttDeviceOption:
+			device.Protocol = domain.MQTTProtocol
+		default:
+			return domain.Device{}, false
+		}
+	}
+
+	return device, true
+}
+
+// enterChoosingDevice asks for the lamp of the reminder. The step is skipped for the users without lamps
+// and for a new reminder if the user has set the default lamps.
+func (b *bot) enterChoosingDevice(u *update) (state, error) {
+	if skipWithoutLight(u) {
+		return repeatChoosingState, nil
+	}
+
+	// The lamps of a quick added reminder are set with its draft.
+	if u.us.quick {
+		return b.setDeviceTarget(u, u.us.reminder.DeviceID, u.us.reminder.DeviceGroup)
+	}
+
+	devices, err := b.deviceUsecase.GetDevices(context.TODO(), u.userID)
+	if err != nil {
+		b.logger.Error("failed to GetDevices", map[string]interface{}{
+			"user_id": u.userID,
+			"err":     err.Error(),
+		})
+		return menuState, u.Send(tryAgainAddReminderMsg)
+	}
+
+	if len(devices) == 0 {
+		return b.setDeviceTarget(u, uuid.NullUUID{}, "")
+	}
+
+	if !u.us.editing && !u.back {
+		deviceID, deviceGroup, ok := defaultDeviceTarget(b.userSettings(context.TODO(), u.userID), devices)
+		if ok {
+			return b.setDeviceTarget(u, deviceID, deviceGroup)
+		}
+	}
+
+	deviceMenu := &telebot.ReplyMarkup{}
+	rows := deviceTargetRows(deviceMenu, devices)
+	navigationRow := deviceMenu.Row(btnBack, btnCancel)
+
+	if !u.us.editing {
+		deviceMenu.Inline(append(rows, navigationRow)...)
+		return deviceChoosingState, u.Send(choosingDeviceMsg, deviceMenu)
+	}
+
+	rows = append(rows, deviceMenu.Row(btnKeep), navigationRow)
+	deviceMenu.Inline(rows...)
+
+	return deviceChoosingState, u.Send(choosingDeviceMsg+"\nCurrent: "+describeDeviceTarget(&u.us.reminder, devices), deviceMenu)
+}
+
+func (b *bot) handleChoosingDevice(u *update) (state, error) {
+	if u.Callback().Data == "\fkeep_current" && u.us.editing {
+		return b.setDeviceTarget(u, u.us.reminder.DeviceID, u.us.reminder.DeviceGroup)
+	}
+
+	if deviceID, deviceGroup, ok := parseDeviceTarget(u.Callback().Data); ok {
+		return b.setDeviceTarget(u, deviceID, deviceGroup)
+	}
+
+	b.logger.Error("invalid device choosing", map[string]interface{}{
+		"user_id":       u.userID,
+		"callback_date": u.Callback().Data,
+	})
+
+	return menuState, u.Send(tryAgainAddReminderMsg)
+}
+
+func (b *bot) setDeviceTarget(u *update, deviceID uuid.NullUUID, deviceGroup string) (state, error) {
+	u.us.reminder.DeviceID = deviceID
+	u.us.reminder.DeviceGroup = deviceGroup
+
+	return colourChoosingState, nil
+}
+
+// deviceTargetRows returns the buttons of all the lamps, every lamp and every group of lamps of the user.
+func deviceTargetRows(deviceMenu *telebot.ReplyMarkup, devices []*domain.Device) []telebot.Row {
+	rows := []telebot.Row{
+		deviceMenu.Row(deviceMenu.Data(allDevicesText, "device_all")),
+	}
+
+	groups := make(map[string]bool)
+	for _, device := range devices {
+		rows = append(rows, deviceMenu.Row(deviceMenu.Data("💡 "+device.Name, fmt.Sprintf("device:%s", device.ID))))
+		if device.Group != "" {
+			groups[device.Group] = true
+		}
+	}
+
+	for _, device := range devices {
+		if groups[device.Group] {
+			rows = append(rows, deviceMenu.Row(deviceMenu.Data("👥 "+device.Group, "device_group:"+device.Group)))
+			delete(groups, device.Group)
+		}
+	}
+
+	return rows
+}
+
+// parseDeviceTarget parses the callback data of the buttons of deviceTargetRows.
+func parseDeviceTarget(data string) (uuid.NullUUID, string, bool) {
+	data = strings.TrimPrefix(data, "\f")
+
+	switch {
+	case data == "device_all":
+		return uuid.NullUUID{}, "", true
+	case strings.HasPrefix(data, "device_group:"):
+		return uuid.NullUUID{}, strings.TrimPrefix(data, "device_group:"), true
+	case strings.HasPrefix(data, "device:"):
+		id, err := uuid.Parse(strings.TrimPrefix(data, "device:"))
+		if err != nil {
+			return uuid.NullUUID{}, "", false
+		}
+		return uuid.NullUUID{UUID: id, Valid: true}, "", true
+	default:
+		return uuid.NullUUID{}, "", false
+	}
+}
+
+// defaultDeviceTarget returns the default lamps of the user if they have set them and the lamps are still there.
+func defaultDeviceTarget(settings *domain.UserSettings, devices []*domain.Device) (uuid.NullUUID, string, bool) {
+	if !settings.HasDefaultDevice {
+		return uuid.NullUUID{}, "", false
+	}
+
+	// Neither a lamp nor a group is all the lamps.
+	if !settings.DefaultDeviceID.Valid && settings.DefaultDeviceGroup == "" {
+		return uuid.NullUUID{}, "", true
+	}
+
+	for _, device := range devices {
+		found := device.ID == settings.DefaultDeviceID.UUID
+		if !settings.DefaultDeviceID.Valid {
+			found = device.Group == settings.DefaultDeviceGroup
+		}
+
+		if found {
+			return settings.DefaultDeviceID, settings.DefaultDeviceGroup, true
+		}
+	}
+
+	return uuid.NullUUID{}, "", false
+}
+
+func describeDevice(device *domain.Device) string {
+	description := device.Name + " (" + device.Host
+	if device.Protocol == domain.MQTTProtocol {
+		description += ", " + mqttDeviceOption
+	}
+	if device.Group != "" {
+		description += ", group " + device.Group
+	}
+	if !device.Capabilities.Has(domain.RGBCapability) {
+		description += ", " + legacyDeviceOption
+	} else if !device.Capabilities.Has(domain.EffectsCapability) {
+		description += ", " + noEffectsDeviceOption
+	}
+
+	return description + ")"
+}
+
+// describeDeviceStatus shows the result of the last health checks of the lamp.
+func describeDeviceStatus(status *domain.DeviceStatus, location *time.Location) string {
+	if status == nil {
+		return "⚪ not checked yet"
+	}
+
+	if status.Online {
+		return "🟢 online"
+	}
+
+	description := "🔴 offline since " + status.ChangedAt.In(location).Format(timeFormat)
+	if status.LastSeen != nil {
+		description += ", last seen " + status.LastSeen.In(location).Format(timeFormat)
+	}
+
+	return description
+}
+
+func describeDeviceTarget(reminder *domain.Reminder, devices []*domain.Device) string {
+	if reminder.DeviceID.Valid {
+		for _, device := range devices {
+			if device.ID == reminder.DeviceID.UUID {
+				return "💡 " + device.Name
+			}
+		}
+	}
+
+	if reminder.DeviceGroup != "" {
+		return "👥 " + reminder.DeviceGroup
+	}
+
+	return allDevicesText
+}
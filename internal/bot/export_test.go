@@ -0,0 +1,67 @@
+package bot
+
+import (
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/timeparse"
+	"github.com/almostinf/glow-reminder/pkg/fsm"
+)
+
+// UserState exposes the conversation state to the tests of the state stores.
+type UserState = userState
+
+// QuickAdd exposes the parsed quick added reminders to their tests.
+type QuickAdd = quickAdd
+
+// State exposes the states to the tests of the flow.
+type State = state
+
+const (
+	MenuState                = menuState
+	TimeChoosingState        = timeChoosingState
+	TextEnteringState        = textEnteringState
+	ColourChoosingState      = colourChoosingState
+	EffectChoosingState      = effectChoosingState
+	ListRemindersState       = listRemindersState
+	RepeatChoosingState      = repeatChoosingState
+	RepeatDaysEnteringState  = repeatDaysEnteringState
+	RepeatHoursEnteringState = repeatHoursEnteringState
+	RepeatRuleEnteringState  = repeatRuleEnteringState
+	TimeConfirmingState      = timeConfirmingState
+	DeliveryChoosingState    = deliveryChoosingState
+	RGBEnteringState         = rgbEnteringState
+	BrightnessChoosingState  = brightnessChoosingState
+	KeyframesEnteringState   = keyframesEnteringState
+	DeviceChoosingState      = deviceChoosingState
+	ReminderConfirmingState  = reminderConfirmingState
+	SettingsState            = settingsState
+	SettingsColourState      = settingsColourState
+	SettingsEffectState      = settingsEffectState
+	SettingsDeviceState      = settingsDeviceState
+	SettingsTimeZoneState    = settingsTimeZoneState
+	SettingsTimeLocaleState  = settingsTimeLocaleState
+	SettingsPageSizeState    = settingsPageSizeState
+)
+
+func NewUserState(reminder domain.Reminder, offset int64, editing bool) *UserState {
+	return &userState{
+		s:        timeConfirmingState,
+		reminder: reminder,
+		offset:   offset,
+		editing:  editing,
+	}
+}
+
+func NewMenuUserState() *UserState {
+	return &userState{s: menuState}
+}
+
+// NewFlow declares the flows of a bot without dependencies, so only their transitions can be checked.
+func NewFlow() *fsm.Machine[State, *update] {
+	return newFlow(&bot{})
+}
+
+func ParseQuickAdd(parser timeparse.Parser, input string, location *time.Location, now time.Time) QuickAdd {
+	return parseQuickAdd(parser, input, location, now)
+}
@@ -0,0 +1,66 @@
+package bot
+
+import (
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/leader"
+	telebot "gopkg.in/telebot.v4"
+)
+
+// leaderCheckInterval is how often the long poller checks whether the instance has taken or lost the lead.
+const leaderCheckInterval = time.Second
+
+// leaderPoller runs the long poller only while the instance is the leader, since Telegram
+// lets a single client get the updates at a time. The standby instances wait for the lead.
+type leaderPoller struct {
+	poller  telebot.Poller
+	elector leader.Elector
+}
+
+func (poller *leaderPoller) Poll(b *telebot.Bot, dest chan telebot.Update, stop chan struct{}) {
+	ticker := time.NewTicker(leaderCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if poller.elector.IsLeader() && poller.pollWhileLeader(b, dest, stop, ticker) {
+			return
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollWhileLeader polls the updates until the instance loses the lead or the bot is stopped, reporting the latter.
+func (poller *leaderPoller) pollWhileLeader(
+	b *telebot.Bot,
+	dest chan telebot.Update,
+	stop chan struct{},
+	ticker *time.Ticker,
+) bool {
+	pollerStop := make(chan struct{})
+	pollerDone := make(chan struct{})
+
+	go func() {
+		poller.poller.Poll(b, dest, pollerStop)
+		close(pollerDone)
+	}()
+
+	for {
+		select {
+		case <-stop:
+			close(pollerStop)
+			<-pollerDone
+			return true
+		case <-ticker.C:
+			if !poller.elector.IsLeader() {
+				close(pollerStop)
+				<-pollerDone
+				return false
+			}
+		}
+	}
+}
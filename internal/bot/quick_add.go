@@ -0,0 +1,312 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/timeparse"
+	telebot "gopkg.in/telebot.v4"
+)
+
+const (
+	remindUsageMsg = "Usage: /remind <time> [colour] [effect] <text>, e.g. '/remind tomorrow 9:00 blue blink Call mum'\n" +
+		"- colour is red, orange, yellow, green, cyan, blue, purple, pink, white or #RRGGBB\n" +
+		"- effect is static, blinking, breathing, pulse, rainbow, strobe or sunrise\n" +
+		"The colour and the effect default to the ones set with /settings, I will ask for anything else missing"
+
+	// maxQuickTimeTokens is the number of words the time of a quick added reminder may take,
+	// e.g. "в следующую пятницу в 6 вечера".
+	maxQuickTimeTokens = 8
+	quickAddResultID   = "quick_add"
+	// quickAddCacheTime keeps the inline results short-lived, since relative times change every minute.
+	quickAddCacheTime = 10
+)
+
+// effectAliases are the other words the effects can be named with in a quick added reminder.
+var effectAliases = map[string]domain.EffectKind{
+	"solid":   domain.StaticEffect,
+	"blink":   domain.BlinkingEffect,
+	"breathe": domain.BreathingEffect,
+}
+
+// quickAdd is a reminder written in a single message, the fields not found in it are left unset.
+type quickAdd struct {
+	ScheduledAt time.Time
+	RGB         domain.RGB
+	Effect      domain.EffectKind
+	Msg         string
+}
+
+// parseQuickAdd parses "<time> [colour] [effect] <text>". The time is the longest beginning of the input
+// the parser understands, a time in the past is treated as missing. The colour and the effect go in any order.
+func parseQuickAdd(parser timeparse.Parser, input string, location *time.Location, now time.Time) quickAdd {
+	var q quickAdd
+
+	tokens := strings.Fields(input)
+
+	for n := min(len(tokens), maxQuickTimeTokens); n > 0; n-- {
+		scheduledAt, err := parser.Parse(strings.Join(tokens[:n], " "), location)
+		if err != nil {
+			continue
+		}
+
+		if scheduledAt.After(now) {
+			q.ScheduledAt = scheduledAt.UTC()
+		}
+		tokens = tokens[n:]
+
+		break
+	}
+
+	q.RGB, q.Effect, tokens = parseLook(tokens)
+	q.Msg = strings.Join(tokens, " ")
+
+	return q
+}
+
+// parseLook takes the colour and the effect words from the beginning of the tokens and returns the rest of them.
+func parseLook(tokens []string) (domain.RGB, domain.EffectKind, []string) {
+	var (
+		rgb  domain.RGB
+		kind domain.EffectKind
+	)
+
+	for len(tokens) != 0 {
+		word := strings.ToLower(tokens[0])
+
+		if parsed, ok := colourByWord(word); ok && rgb == domain.NoRGB {
+			rgb = parsed
+		} else if parsed, ok := effectByWord(word); ok && kind == "" {
+			kind = parsed
+		} else {
+			break
+		}
+
+		tokens = tokens[1:]
+	}
+
+	return rgb, kind, tokens
+}
+
+func colourByWord(word string) (domain.RGB, bool) {
+	if strings.HasPrefix(word, "#") {
+		rgb, err := domain.ParseRGB(word)
+		return rgb, err == nil
+	}
+
+	for _, preset := range colourPresets {
+		if word == strings.TrimPrefix(preset.btn.Unique, "colour_") {
+			return preset.rgb, true
+		}
+	}
+
+	return domain.NoRGB, false
+}
+
+// effectByWord returns the effect of the word. Custom keyframes cannot be written in a single word.
+func effectByWord(word string) (domain.EffectKind, bool) {
+	if kind, ok := effectAliases[word]; ok {
+		return kind, true
+	}
+
+	for _, preset := range effectPresets {
+		if preset.kind != domain.KeyframesEffect && word == string(preset.kind) {
+			return preset.kind, true
+		}
+	}
+
+	return "", false
+}
+
+// draft returns the reminder of the quick add. The colour and the effect missing in the message are taken
+// from the defaults of the user. A quick added reminder lights the default lamps of the user or all of them
+// at full brightness and fires once.
+func (q quickAdd) draft(userID int64, settings *domain.UserSettings) domain.Reminder {
+	reminder := domain.Reminder{
+		UserID:      userID,
+		Msg:         q.Msg,
+		ScheduledAt: q.ScheduledAt,
+		Delivery:    domain.LightAndMessage,
+		Brightness:  domain.MaxBrightness,
+		Recurrence:  domain.NoRecurrence,
+	}
+
+	if settings.HasDefaultDevice {
+		reminder.DeviceID = settings.DefaultDeviceID
+		reminder.DeviceGroup = settings.DefaultDeviceGroup
+	}
+
+	rgb := q.RGB
+	if rgb == domain.NoRGB {
+		rgb = settings.DefaultRGB
+	}
+	if rgb != domain.NoRGB {
+		reminder.RGB = rgb
+		reminder.Colour = rgb.LegacyColour()
+	}
+
+	kind := q.Effect
+	if kind == "" {
+		kind = settings.DefaultEffect
+	}
+	if kind != "" {
+		reminder.Effect = domain.DefaultEffect(kind)
+		reminder.Mode = reminder.Effect.LegacyMode()
+	}
+
+	return reminder
+}
+
+// missingFields lists the fields the wizard has to ask for.
+func missingFields(reminder *domain.Reminder) []string {
+	var missing []string
+	if reminder.ScheduledAt.IsZero() {
+		missing = append(missing, "time")
+	}
+	if reminder.Msg == "" {
+		missing = append(missing, "text")
+	}
+	if reminder.RGB == domain.NoRGB {
+		missing = append(missing, "colour")
+	}
+	if reminder.Effect.Kind == "" {
+		missing = append(missing, "effect")
+	}
+
+	return missing
+}
+
+func (b *bot) handleRemind() func(c telebot.Context) error {
+	return func(c telebot.Context) error {
+		if strings.TrimSpace(c.Message().Payload) == "" {
+			b.setUserState(c.Sender().ID, &userState{
+				s: menuState,
+			})
+			return c.Send(remindUsageMsg)
+		}
+
+		return b.quickAdd(c, c.Message().Payload)
+	}
+}
+
+// handleQuery previews the reminder typed inline, e.g. "@bot in 30m red Stretch".
+func (b *bot) handleQuery() func(c telebot.Context) error {
+	return func(c telebot.Context) error {
+		response := &telebot.QueryResponse{
+			Results:    telebot.Results{},
+			CacheTime:  quickAddCacheTime,
+			IsPersonal: true,
+		}
+
+		input := strings.TrimSpace(c.Query().Text)
+		if input == "" {
+			return c.Answer(response)
+		}
+
+		reminder, location, err := b.draftQuickAdd(context.TODO(), c.Sender().ID, input)
+		if err != nil {
+			return c.Answer(response)
+		}
+
+		result := &telebot.ArticleResult{
+			Title:       describeQuickAdd(&reminder, location),
+			Description: "Add the reminder",
+			Text:        "⏰ " + describeQuickAdd(&reminder, location),
+		}
+		if missing := missingFields(&reminder); len(missing) != 0 {
+			result.Description = "I will ask for the " + strings.Join(missing, ", ") + " in our chat"
+		}
+		result.SetResultID(quickAddResultID)
+
+		response.Results = append(response.Results, result)
+
+		return c.Answer(response)
+	}
+}
+
+// handleInlineResult adds the reminder once the user has chosen the inline result.
+// The answers are sent to the private chat with the user, whatever chat the result was sent to.
+func (b *bot) handleInlineResult() func(c telebot.Context) error {
+	return func(c telebot.Context) error {
+		if c.InlineResult().ResultID != quickAddResultID {
+			return nil
+		}
+
+		return b.quickAdd(c, c.InlineResult().Query)
+	}
+}
+
+// quickAdd creates the reminder written in a single message. If some of its fields are missing,
+// the wizard is started with the draft and only asks for them before the reminder is confirmed.
+func (b *bot) quickAdd(c telebot.Context, input string) error {
+	userID := c.Sender().ID
+
+	reminder, location, err := b.draftQuickAdd(context.TODO(), userID, input)
+	if err != nil {
+		b.setUserState(userID, &userState{
+			s: menuState,
+		})
+		return c.Send(tryAgainMsg)
+	}
+
+	if len(missingFields(&reminder)) != 0 {
+		return b.beginDraft(c, &userState{
+			s:        menuState,
+			reminder: reminder,
+			quick:    true,
+		}, timeChoosingState)
+	}
+
+	b.setUserState(userID, &userState{
+		s: menuState,
+	})
+
+	if err = b.addReminder(context.TODO(), &reminder); err != nil {
+		return c.Send(tryAgainMsg)
+	}
+
+	return c.Send("✅ Reminder successfully created\n\n" + describeReminder(&reminder, location, nil))
+}
+
+func (b *bot) draftQuickAdd(ctx context.Context, userID int64, input string) (domain.Reminder, *time.Location, error) {
+	location, err := b.userLocation(ctx, userID)
+	if err != nil {
+		return domain.Reminder{}, nil, err
+	}
+
+	settings, err := b.userUsecase.GetUserSettings(ctx, userID)
+	if err != nil {
+		b.logger.Error("failed to GetUserSettings", map[string]interface{}{
+			"user_id": userID,
+			"err":     err.Error(),
+		})
+		return domain.Reminder{}, nil, fmt.Errorf("failed to GetUserSettings: %w", err)
+	}
+
+	q := parseQuickAdd(b.timeParser, input, location, b.clock.NowUTC())
+
+	return q.draft(userID, settings), location, nil
+}
+
+// describeQuickAdd sums the quick added reminder up in a line.
+func describeQuickAdd(reminder *domain.Reminder, location *time.Location) string {
+	description := reminder.Msg
+	if description == "" {
+		description = "Reminder"
+	}
+
+	if !reminder.ScheduledAt.IsZero() {
+		description += " at " + reminder.ScheduledAt.In(location).Format(timeFormat)
+	}
+	if reminder.RGB != domain.NoRGB {
+		description += ", " + describeRGB(reminder.RGB)
+	}
+	if reminder.Effect.Kind != "" {
+		description += ", " + describeEffect(reminder.Effect)
+	}
+
+	return description
+}
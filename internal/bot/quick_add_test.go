@@ -0,0 +1,127 @@
+package bot_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/bot"
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/timeparse"
+	clock_mocks "github.com/almostinf/glow-reminder/pkg/clock/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestParseQuickAdd(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	// Wednesday.
+	now := time.Date(2025, 3, 12, 14, 20, 0, 0, berlin)
+
+	testcases := []struct {
+		name     string
+		input    string
+		expected bot.QuickAdd
+	}{
+		{
+			name:  "all fields",
+			input: "tomorrow 9:00 blue blink Call mum",
+			expected: bot.QuickAdd{
+				ScheduledAt: time.Date(2025, 3, 13, 9, 0, 0, 0, berlin).UTC(),
+				RGB:         domain.BlueRGB,
+				Effect:      domain.BlinkingEffect,
+				Msg:         "Call mum",
+			},
+		},
+		{
+			name:  "relative time, effect before colour",
+			input: "in 30m Breathing #FF8800 Stretch",
+			expected: bot.QuickAdd{
+				ScheduledAt: now.Add(30 * time.Minute).UTC(),
+				RGB:         0xFF8800,
+				Effect:      domain.BreathingEffect,
+				Msg:         "Stretch",
+			},
+		},
+		{
+			name:  "without colour and effect",
+			input: "next friday at 18 Pay the rent",
+			expected: bot.QuickAdd{
+				ScheduledAt: time.Date(2025, 3, 14, 18, 0, 0, 0, berlin).UTC(),
+				Msg:         "Pay the rent",
+			},
+		},
+		{
+			name:  "colour word inside the text",
+			input: "in 1h red Buy green tea",
+			expected: bot.QuickAdd{
+				ScheduledAt: now.Add(time.Hour).UTC(),
+				RGB:         domain.RedRGB,
+				Msg:         "Buy green tea",
+			},
+		},
+		{
+			name:  "second colour starts the text",
+			input: "in 1h red green Tea",
+			expected: bot.QuickAdd{
+				ScheduledAt: now.Add(time.Hour).UTC(),
+				RGB:         domain.RedRGB,
+				Msg:         "green Tea",
+			},
+		},
+		{
+			name:  "without text",
+			input: "через 2 часа pink",
+			expected: bot.QuickAdd{
+				ScheduledAt: now.Add(2 * time.Hour).UTC(),
+				RGB:         0xFF40A0,
+			},
+		},
+		{
+			name:  "without time",
+			input: "blue Call mum",
+			expected: bot.QuickAdd{
+				RGB: domain.BlueRGB,
+				Msg: "Call mum",
+			},
+		},
+		{
+			name:  "time in the past",
+			input: "2025-03-10 15:04 Call mum",
+			expected: bot.QuickAdd{
+				Msg: "Call mum",
+			},
+		},
+		{
+			name:  "keyframes are not a word",
+			input: "tomorrow keyframes",
+			expected: bot.QuickAdd{
+				ScheduledAt: time.Date(2025, 3, 13, 9, 0, 0, 0, berlin).UTC(),
+				Msg:         "keyframes",
+			},
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+
+	clk := clock_mocks.NewMockClock(mockCtrl)
+	clk.EXPECT().NowUTC().Return(now.UTC()).AnyTimes()
+
+	parser := timeparse.New(clk)
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			q := bot.ParseQuickAdd(parser, testcase.input, berlin, now.UTC())
+
+			assert.Equal(t, testcase.expected, q)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	telebot "gopkg.in/telebot.v4"
+)
+
+const (
+	quietUsageMsg = "Usage: /quiet [HH:MM-HH:MM | off] [defer | dim | skip]\n" +
+		"- the lamps are kept quiet every day within the window in your time zone\n" +
+		"- defer lights the lamp once the window is over, dim glows static and dim, skip does not light the lamp"
+	dndUsageMsg = "Usage: /dnd <duration, e.g. 2h or 30m> | off"
+
+	offOption = "off"
+)
+
+var quietPolicyNames = map[domain.QuietPolicy]string{
+	domain.QuietDefer: "the lamp glows once they are over",
+	domain.QuietDim:   "the lamp glows static and dim",
+	domain.QuietSkip:  "the lamp is not lit",
+}
+
+// handleQuiet shows the quiet hours of the user or changes the window and the policy if they are given.
+func (b *bot) handleQuiet() func(c telebot.Context) error {
+	return func(c telebot.Context) error {
+		userID := c.Sender().ID
+
+		b.setUserState(userID, &userState{
+			s: menuState,
+		})
+
+		args := strings.Fields(c.Message().Payload)
+		for _, arg := range args {
+			if err := b.setQuietOption(userID, arg); err != nil {
+				if errors.Is(err, domain.ErrInvalidQuietHours) || errors.Is(err, domain.ErrInvalidQuietPolicy) {
+					return c.Send("❌ " + err.Error() + "\n" + quietUsageMsg)
+				}
+				b.logger.Error("failed to set quiet hours", map[string]interface{}{
+					"user_id": userID,
+					"arg":     arg,
+					"err":     err.Error(),
+				})
+				return c.Send(tryAgainMsg)
+			}
+		}
+
+		user, err := b.userUsecase.GetUser(context.TODO(), userID)
+		if err != nil {
+			b.logger.Error("failed to GetUser", map[string]interface{}{
+				"user_id": userID,
+				"err":     err.Error(),
+			})
+			return c.Send(tryAgainMsg)
+		}
+
+		msg := b.describeQuiet(user)
+		if len(args) == 0 {
+			msg += "\n\n" + quietUsageMsg
+		}
+
+		return c.Send(msg)
+	}
+}
+
+func (b *bot) setQuietOption(userID int64, arg string) error {
+	if arg == offOption {
+		return b.userUsecase.SetQuietHours(context.TODO(), userID, "")
+	}
+
+	if policy, err := domain.ParseQuietPolicy(arg); err == nil {
+		return b.userUsecase.SetQuietPolicy(context.TODO(), userID, policy)
+	}
+
+	return b.userUsecase.SetQuietHours(context.TODO(), userID, arg)
+}
+
+// handleDND turns do not disturb on for the given duration or off.
+func (b *bot) handleDND() func(c telebot.Context) error {
+	return func(c telebot.Context) error {
+		userID := c.Sender().ID
+
+		b.setUserState(userID, &userState{
+			s: menuState,
+		})
+
+		args := strings.Fields(c.Message().Payload)
+		if len(args) != 1 {
+			return c.Send(dndUsageMsg)
+		}
+
+		var duration time.Duration
+		if args[0] != offOption {
+			var err error
+			duration, err = time.ParseDuration(args[0])
+			if err != nil || duration <= 0 {
+				return c.Send(dndUsageMsg)
+			}
+		}
+
+		user, err := b.userUsecase.SetDND(context.TODO(), userID, duration)
+		if err != nil {
+			b.logger.Error("failed to SetDND", map[string]interface{}{
+				"user_id":  userID,
+				"duration": duration,
+				"err":      err.Error(),
+			})
+			return c.Send(tryAgainMsg)
+		}
+
+		return c.Send(b.describeQuiet(user))
+	}
+}
+
+// describeQuiet shows when the lamps of the user are kept quiet and what happens to the reminders then.
+func (b *bot) describeQuiet(user *domain.User) string {
+	location, err := user.Location()
+	if err != nil {
+		location = time.UTC
+	}
+
+	description := "🌙 Quiet hours are off"
+	if user.QuietHours != "" {
+		description = fmt.Sprintf("🌙 Quiet hours are %s (%s)", user.QuietHours, location)
+	}
+
+	if user.DNDUntil != nil && user.DNDUntil.After(b.clock.NowUTC()) {
+		description += "\n🔕 Do not disturb until " + user.DNDUntil.In(location).Format(timeFormat)
+	} else {
+		description += "\n🔔 Do not disturb is off"
+	}
+
+	policy := user.QuietPolicy
+	if policy == "" {
+		policy = domain.DefaultQuietPolicy
+	}
+
+	return description + fmt.Sprintf("\nReminders fired then: %s, %s", policy, quietPolicyNames[policy])
+}
@@ -0,0 +1,362 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/usecase"
+	telebot "gopkg.in/telebot.v4"
+)
+
+const (
+	settingsClosedMsg        = "⚙️ The settings are saved"
+	choosingDefaultColourMsg = "🎨 Choose the colour of new reminders or enter a #RRGGBB one"
+	choosingDefaultEffectMsg = "✨ Choose the effect of new reminders"
+	choosingDefaultDeviceMsg = "💡 Which lamps should new reminders light?"
+	enteringTimeZoneMsg      = "🌍 Please enter your time zone, e.g. 'Europe/Berlin', or share your location"
+	choosingTimeLocaleMsg    = "🕐 Choose how to write the times: the examples of the times to enter and the format of the dates"
+	choosingPageSizeMsg      = "📄 How many reminders should a page of the list show? Choose or enter a number from %d to %d"
+	askEveryTimeText         = "ask every time"
+	settingsSkippedStepMsg   = "The steps of a new reminder with a default are skipped, ⬅️ Back opens them to choose another value"
+)
+
+func (b *bot) handleSettingsCommand() func(c telebot.Context) error {
+	return func(c telebot.Context) error {
+		return b.begin(c, settingsState)
+	}
+}
+
+func isSettingsState(s state) bool {
+	return s >= settingsState && s <= settingsPageSizeState
+}
+
+// enterSettings shows the settings of the user with the buttons to change them.
+func (b *bot) enterSettings(u *update) (state, error) {
+	ctx := context.TODO()
+
+	settings, err := b.userUsecase.GetUserSettings(ctx, u.userID)
+	if err != nil {
+		b.logger.Error("failed to GetUserSettings", map[string]interface{}{
+			"user_id": u.userID,
+			"err":     err.Error(),
+		})
+		return menuState, u.Send(tryAgainMsg)
+	}
+
+	location, err := b.userLocation(ctx, u.userID)
+	if err != nil {
+		return menuState, u.Send(failedToLoadTimeZoneMsg)
+	}
+
+	devices, err := b.deviceUsecase.GetDevices(ctx, u.userID)
+	if err != nil {
+		b.logger.Error("failed to GetDevices", map[string]interface{}{
+			"user_id": u.userID,
+			"err":     err.Error(),
+		})
+		return menuState, u.Send(tryAgainMsg)
+	}
+
+	return settingsState, u.Send(describeSettings(settings, location, devices), settingsMenu)
+}
+
+func (b *bot) handleSettings(u *update) (state, error) {
+	switch strings.TrimPrefix(u.Callback().Data, "\f") {
+	case btnSettingsColour.Unique:
+		return settingsColourState, nil
+	case btnSettingsEffect.Unique:
+		return settingsEffectState, nil
+	case btnSettingsDevice.Unique:
+		return settingsDeviceState, nil
+	case btnSettingsTimeZone.Unique:
+		return settingsTimeZoneState, nil
+	case btnSettingsTimeLocale.Unique:
+		return settingsTimeLocaleState, nil
+	case btnSettingsPageSize.Unique:
+		return settingsPageSizeState, nil
+	case btnSettingsDone.Unique:
+		return menuState, u.Send(settingsClosedMsg, menu)
+	default:
+		b.logger.Error("invalid settings choosing", map[string]interface{}{
+			"user_id":       u.userID,
+			"callback_date": u.Callback().Data,
+		})
+		return menuState, u.Send(tryAgainMsg)
+	}
+}
+
+func (b *bot) enterSettingsColour(u *update) (state, error) {
+	return settingsColourState, u.Send(choosingDefaultColourMsg, defaultColourMenu)
+}
+
+func (b *bot) handleSettingsColour(u *update) (state, error) {
+	data := u.Callback().Data
+
+	if data == "\f"+btnNoDefault.Unique {
+		return b.setDefaultColour(u, domain.NoRGB)
+	}
+
+	for _, preset := range colourPresets {
+		if data == "\f"+preset.btn.Unique {
+			return b.setDefaultColour(u, preset.rgb)
+		}
+	}
+
+	b.logger.Error("invalid default colour choosing", map[string]interface{}{
+		"user_id":       u.userID,
+		"callback_date": data,
+	})
+
+	return menuState, u.Send(tryAgainMsg)
+}
+
+func (b *bot) handleSettingsRGBEntering(u *update) (state, error) {
+	rgb, err := domain.ParseRGB(u.Text())
+	if err != nil {
+		return settingsColourState, u.Send("❌ Invalid colour: " + err.Error() + ". Please try again")
+	}
+
+	return b.setDefaultColour(u, rgb)
+}
+
+func (b *bot) setDefaultColour(u *update, rgb domain.RGB) (state, error) {
+	return b.setDefaults(u, func(settings *domain.UserSettings) {
+		settings.DefaultRGB = rgb
+	})
+}
+
+func (b *bot) enterSettingsEffect(u *update) (state, error) {
+	return settingsEffectState, u.Send(choosingDefaultEffectMsg, defaultEffectsMenu)
+}
+
+func (b *bot) handleSettingsEffect(u *update) (state, error) {
+	data := u.Callback().Data
+
+	if data == "\f"+btnNoDefault.Unique {
+		return b.setDefaultEffect(u, "")
+	}
+
+	for _, preset := range effectPresets {
+		if preset.kind != domain.KeyframesEffect && data == "\f"+preset.btn.Unique {
+			return b.setDefaultEffect(u, preset.kind)
+		}
+	}
+
+	b.logger.Error("invalid default effect choosing", map[string]interface{}{
+		"user_id":       u.userID,
+		"callback_date": data,
+	})
+
+	return menuState, u.Send(tryAgainMsg)
+}
+
+func (b *bot) setDefaultEffect(u *update, kind domain.EffectKind) (state, error) {
+	return b.setDefaults(u, func(settings *domain.UserSettings) {
+		settings.DefaultEffect = kind
+	})
+}
+
+// setDefaults changes the default colour or effect, keeping the other one.
+func (b *bot) setDefaults(u *update, change func(settings *domain.UserSettings)) (state, error) {
+	settings, err := b.userUsecase.GetUserSettings(context.TODO(), u.userID)
+	if err != nil {
+		b.logger.Error("failed to GetUserSettings", map[string]interface{}{
+			"user_id": u.userID,
+			"err":     err.Error(),
+		})
+		return menuState, u.Send(tryAgainMsg)
+	}
+
+	change(settings)
+
+	if _, err = b.userUsecase.SetDefaults(context.TODO(), u.userID, settings.DefaultRGB, settings.DefaultEffect); err != nil {
+		b.logger.Error("failed to SetDefaults", map[string]interface{}{
+			"user_id": u.userID,
+			"err":     err.Error(),
+		})
+		return menuState, u.Send(tryAgainMsg)
+	}
+
+	return settingsState, nil
+}
+
+func (b *bot) enterSettingsDevice(u *update) (state, error) {
+	devices, err := b.deviceUsecase.GetDevices(context.TODO(), u.userID)
+	if err != nil {
+		b.logger.Error("failed to GetDevices", map[string]interface{}{
+			"user_id": u.userID,
+			"err":     err.Error(),
+		})
+		return menuState, u.Send(tryAgainMsg)
+	}
+
+	if len(devices) == 0 {
+		return settingsState, u.Send(noDevicesMsg)
+	}
+
+	deviceMenu := &telebot.ReplyMarkup{}
+	rows := append(deviceTargetRows(deviceMenu, devices),
+		deviceMenu.Row(btnNoDefault),
+		deviceMenu.Row(btnBack, btnCancel),
+	)
+	deviceMenu.Inline(rows...)
+
+	return settingsDeviceState, u.Send(choosingDefaultDeviceMsg, deviceMenu)
+}
+
+func (b *bot) handleSettingsDevice(u *update) (state, error) {
+	var err error
+
+	if u.Callback().Data == "\f"+btnNoDefault.Unique {
+		err = b.userUsecase.ResetDefaultDevice(context.TODO(), u.userID)
+	} else {
+		deviceID, deviceGroup, ok := parseDeviceTarget(u.Callback().Data)
+		if !ok {
+			b.logger.Error("invalid default device choosing", map[string]interface{}{
+				"user_id":       u.userID,
+				"callback_date": u.Callback().Data,
+			})
+			return menuState, u.Send(tryAgainMsg)
+		}
+
+		err = b.userUsecase.SetDefaultDevice(context.TODO(), u.userID, deviceID, deviceGroup)
+	}
+
+	if err != nil {
+		b.logger.Error("failed to set default device", map[string]interface{}{
+			"user_id": u.userID,
+			"err":     err.Error(),
+		})
+		return menuState, u.Send(tryAgainMsg)
+	}
+
+	return settingsState, nil
+}
+
+func (b *bot) enterSettingsTimeZone(u *update) (state, error) {
+	return settingsTimeZoneState, u.Send(enteringTimeZoneMsg, navigationMenu)
+}
+
+func (b *bot) handleSettingsTimeZone(u *update) (state, error) {
+	timeZone := strings.TrimSpace(u.Text())
+
+	if err := b.userUsecase.SetTimeZone(context.TODO(), u.userID, timeZone); err != nil {
+		if errors.Is(err, usecase.ErrInvalidTimeZone) {
+			return settingsTimeZoneState, u.Send("❌ Unknown time zone. Please use an IANA name, e.g. 'Europe/Berlin'")
+		}
+		b.logger.Error("failed to SetTimeZone", map[string]interface{}{
+			"user_id":   u.userID,
+			"time_zone": timeZone,
+			"err":       err.Error(),
+		})
+		return menuState, u.Send(tryAgainMsg)
+	}
+
+	return settingsState, nil
+}
+
+func (b *bot) enterSettingsTimeLocale(u *update) (state, error) {
+	return settingsTimeLocaleState, u.Send(choosingTimeLocaleMsg, timeLocaleMenu)
+}
+
+func (b *bot) handleSettingsTimeLocale(u *update) (state, error) {
+	timeLocale, err := domain.ParseTimeLocale(strings.TrimPrefix(u.Callback().Data, "\ftime_locale_"))
+	if err != nil || !strings.HasPrefix(u.Callback().Data, "\ftime_locale_") {
+		b.logger.Error("invalid time locale choosing", map[string]interface{}{
+			"user_id":       u.userID,
+			"callback_date": u.Callback().Data,
+		})
+		return menuState, u.Send(tryAgainMsg)
+	}
+
+	if err = b.userUsecase.SetTimeLocale(context.TODO(), u.userID, timeLocale); err != nil {
+		b.logger.Error("failed to SetTimeLocale", map[string]interface{}{
+			"user_id":     u.userID,
+			"time_locale": timeLocale,
+			"err":         err.Error(),
+		})
+		return menuState, u.Send(tryAgainMsg)
+	}
+
+	return settingsState, nil
+}
+
+func (b *bot) enterSettingsPageSize(u *update) (state, error) {
+	return settingsPageSizeState, u.Send(fmt.Sprintf(choosingPageSizeMsg, domain.MinPageSize, domain.MaxPageSize), pageSizeMenu)
+}
+
+func (b *bot) handleSettingsPageSize(u *update) (state, error) {
+	data := u.Callback().Data
+
+	pageSize, err := strconv.Atoi(strings.TrimPrefix(data, "\fpage_size_"))
+	if !strings.HasPrefix(data, "\fpage_size_") || err != nil {
+		b.logger.Error("invalid page size choosing", map[string]interface{}{
+			"user_id":       u.userID,
+			"callback_date": data,
+		})
+		return menuState, u.Send(tryAgainMsg)
+	}
+
+	return b.setPageSize(u, pageSize)
+}
+
+func (b *bot) handleSettingsPageSizeEntering(u *update) (state, error) {
+	pageSize, err := strconv.Atoi(strings.TrimSpace(u.Text()))
+	if err != nil || domain.ValidatePageSize(pageSize) != nil {
+		return settingsPageSizeState, u.Send(fmt.Sprintf("❌ Invalid page size. Please enter a number from %d to %d",
+			domain.MinPageSize, domain.MaxPageSize))
+	}
+
+	return b.setPageSize(u, pageSize)
+}
+
+func (b *bot) setPageSize(u *update, pageSize int) (state, error) {
+	if err := b.userUsecase.SetPageSize(context.TODO(), u.userID, pageSize); err != nil {
+		b.logger.Error("failed to SetPageSize", map[string]interface{}{
+			"user_id":   u.userID,
+			"page_size": pageSize,
+			"err":       err.Error(),
+		})
+		return menuState, u.Send(tryAgainMsg)
+	}
+
+	return settingsState, nil
+}
+
+func describeSettings(settings *domain.UserSettings, location *time.Location, devices []*domain.Device) string {
+	colour, effect, lamps := askEveryTimeText, askEveryTimeText, askEveryTimeText
+	if settings.DefaultRGB != domain.NoRGB {
+		colour = describeRGB(settings.DefaultRGB)
+	}
+	if settings.DefaultEffect != "" {
+		effect = describeEffect(domain.DefaultEffect(settings.DefaultEffect))
+	}
+	if settings.HasDefaultDevice {
+		lamps = describeDeviceTarget(&domain.Reminder{
+			DeviceID:    settings.DefaultDeviceID,
+			DeviceGroup: settings.DefaultDeviceGroup,
+		}, devices)
+	}
+
+	return "⚙️ Settings\n" +
+		"Colour: " + colour + "\n" +
+		"Effect: " + effect + "\n" +
+		"Lamps: " + lamps + "\n" +
+		"Time zone: " + location.String() + "\n" +
+		"Time format: " + describeTimeLocale(settings.TimeLocale) + "\n" +
+		fmt.Sprintf("Page size: %d reminders", settings.PageSize) + "\n\n" +
+		settingsSkippedStepMsg
+}
+
+func describeTimeLocale(timeLocale domain.TimeLocale) string {
+	if timeLocale == domain.RussianTimeLocale {
+		return btnTimeLocaleRussian.Text
+	}
+
+	return btnTimeLocaleEnglish.Text
+}
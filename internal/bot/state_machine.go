@@ -1,20 +1,213 @@
 package bot
 
-import "github.com/almostinf/glow-reminder/internal/domain"
+import (
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/fsm"
+	telebot "gopkg.in/telebot.v4"
+)
 
 type state int
 
 const (
-	menuState           state = 0
-	timeChoosingState   state = 1
-	textEnteringState   state = 2
-	colourChoosingState state = 3
-	effectChoosingState state = 4
-	listRemindersState  state = 5
+	menuState                state = 0
+	timeChoosingState        state = 1
+	textEnteringState        state = 2
+	colourChoosingState      state = 3
+	effectChoosingState      state = 4
+	listRemindersState       state = 5
+	repeatChoosingState      state = 6
+	repeatDaysEnteringState  state = 7
+	repeatHoursEnteringState state = 8
+	repeatRuleEnteringState  state = 9
+	timeConfirmingState      state = 10
+	deliveryChoosingState    state = 11
+	rgbEnteringState         state = 12
+	brightnessChoosingState  state = 13
+	keyframesEnteringState   state = 14
+	deviceChoosingState      state = 15
+	reminderConfirmingState  state = 16
+	settingsState            state = 17
+	settingsColourState      state = 18
+	settingsEffectState      state = 19
+	settingsDeviceState      state = 20
+	settingsTimeZoneState    state = 21
+	settingsTimeLocaleState  state = 22
+	settingsPageSizeState    state = 23
 )
 
 type userState struct {
 	s        state
 	reminder domain.Reminder
 	offset   int64
+	// editing is set when the wizard updates an existing reminder instead of creating a new one.
+	editing bool
+	// quick is set when the wizard only asks for the fields missing in a reminder added with a single message.
+	quick bool
+}
+
+// update is what the flow handlers work with: the update of the user and their state.
+// The handlers change the state in place, it is stored once the flow has moved to the next state.
+type update struct {
+	telebot.Context
+
+	userID int64
+	us     *userState
+	// back is set while the flow goes back, so that the steps skipped for the defaults of the user are shown.
+	back bool
+}
+
+// newFlow declares the flows of the bot: the reminders list, the settings and the reminder wizard shared
+// by creating and editing. The lamp steps are skipped on entering for a reminder that only sends a message,
+// and the steps with a default are skipped for a new reminder.
+func newFlow(b *bot) *fsm.Machine[state, *update] {
+	flow := fsm.New[state, *update](menuState)
+
+	flow.State(menuState).
+		To(timeChoosingState, listRemindersState, settingsState)
+
+	flow.State(listRemindersState).
+		Enter(b.enterListReminders).
+		OnCallback(b.handleListingReminders).
+		To(timeChoosingState)
+
+	flow.State(timeChoosingState).
+		Enter(b.enterChoosingTime).
+		OnText(b.handleChoosingTime).
+		OnCallback(b.handleKeepingTime).
+		To(timeConfirmingState, textEnteringState)
+
+	flow.State(timeConfirmingState).
+		Enter(b.enterConfirmingTime).
+		OnCallback(b.handleConfirmingTime).
+		To(textEnteringState, timeChoosingState).
+		Back(timeChoosingState)
+
+	flow.State(textEnteringState).
+		Enter(b.enterTextEntering).
+		OnText(b.handleTextEntering).
+		OnCallback(b.handleKeepingText).
+		To(deliveryChoosingState).
+		Back(timeChoosingState)
+
+	flow.State(deliveryChoosingState).
+		Enter(b.enterChoosingDelivery).
+		OnCallback(b.handleChoosingDelivery).
+		To(deviceChoosingState).
+		Back(textEnteringState)
+
+	flow.State(deviceChoosingState).
+		Enter(b.enterChoosingDevice).
+		OnCallback(b.handleChoosingDevice).
+		To(colourChoosingState, repeatChoosingState).
+		Back(deliveryChoosingState)
+
+	flow.State(colourChoosingState).
+		Enter(b.enterChoosingColour).
+		OnCallback(b.handleChoosingColour).
+		To(rgbEnteringState, brightnessChoosingState, repeatChoosingState).
+		Back(deviceChoosingState)
+
+	flow.State(rgbEnteringState).
+		Enter(b.enterRGBEntering).
+		OnText(b.handleRGBEntering).
+		To(brightnessChoosingState).
+		Back(colourChoosingState)
+
+	flow.State(brightnessChoosingState).
+		Enter(b.enterChoosingBrightness).
+		OnText(b.handleBrightnessEntering).
+		OnCallback(b.handleChoosingBrightness).
+		To(effectChoosingState, repeatChoosingState).
+		Back(colourChoosingState)
+
+	flow.State(effectChoosingState).
+		Enter(b.enterChoosingEffect).
+		OnCallback(b.handleChoosingEffect).
+		To(keyframesEnteringState, repeatChoosingState).
+		Back(brightnessChoosingState)
+
+	flow.State(keyframesEnteringState).
+		Enter(b.enterKeyframesEntering).
+		OnText(b.handleKeyframesEntering).
+		To(repeatChoosingState).
+		Back(effectChoosingState)
+
+	flow.State(repeatChoosingState).
+		Enter(b.enterChoosingRepeat).
+		OnCallback(b.handleChoosingRepeat).
+		To(repeatDaysEnteringState, repeatHoursEnteringState, repeatRuleEnteringState, reminderConfirmingState).
+		Back(effectChoosingState)
+
+	flow.State(repeatDaysEnteringState).
+		Enter(b.enterRepeatDaysEntering).
+		OnText(b.handleRepeatDaysEntering).
+		To(reminderConfirmingState).
+		Back(repeatChoosingState)
+
+	flow.State(repeatHoursEnteringState).
+		Enter(b.enterRepeatHoursEntering).
+		OnText(b.handleRepeatHoursEntering).
+		To(reminderConfirmingState).
+		Back(repeatChoosingState)
+
+	flow.State(repeatRuleEnteringState).
+		Enter(b.enterRepeatRuleEntering).
+		OnText(b.handleRepeatRuleEntering).
+		To(reminderConfirmingState).
+		Back(repeatChoosingState)
+
+	// The reminder is only saved once the user has confirmed its summary. A draft whose time has passed
+	// in the meantime goes back to choosing the time.
+	flow.State(reminderConfirmingState).
+		Enter(b.enterConfirmingReminder).
+		OnCallback(b.handleConfirmingReminder).
+		To(timeChoosingState).
+		Back(repeatChoosingState)
+
+	// Every setting is saved once it is chosen and the user returns to the settings.
+	flow.State(settingsState).
+		Enter(b.enterSettings).
+		OnCallback(b.handleSettings).
+		To(settingsColourState, settingsEffectState, settingsDeviceState, settingsTimeZoneState,
+			settingsTimeLocaleState, settingsPageSizeState)
+
+	flow.State(settingsColourState).
+		Enter(b.enterSettingsColour).
+		OnText(b.handleSettingsRGBEntering).
+		OnCallback(b.handleSettingsColour).
+		To(settingsState).
+		Back(settingsState)
+
+	flow.State(settingsEffectState).
+		Enter(b.enterSettingsEffect).
+		OnCallback(b.handleSettingsEffect).
+		To(settingsState).
+		Back(settingsState)
+
+	flow.State(settingsDeviceState).
+		Enter(b.enterSettingsDevice).
+		OnCallback(b.handleSettingsDevice).
+		To(settingsState).
+		Back(settingsState)
+
+	flow.State(settingsTimeZoneState).
+		Enter(b.enterSettingsTimeZone).
+		OnText(b.handleSettingsTimeZone).
+		To(settingsState).
+		Back(settingsState)
+
+	flow.State(settingsTimeLocaleState).
+		Enter(b.enterSettingsTimeLocale).
+		OnCallback(b.handleSettingsTimeLocale).
+		To(settingsState).
+		Back(settingsState)
+
+	flow.State(settingsPageSizeState).
+		Enter(b.enterSettingsPageSize).
+		OnText(b.handleSettingsPageSizeEntering).
+		OnCallback(b.handleSettingsPageSize).
+		To(settingsState).
+		Back(settingsState)
+
+	return flow
 }
@@ -0,0 +1,122 @@
+package bot_test
+
+import (
+	"testing"
+
+	"github.com/almostinf/glow-reminder/internal/bot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlowTransitions(t *testing.T) {
+	t.Parallel()
+
+	// transitions are the moves of the flow besides keeping the state and cancelling to the menu.
+	transitions := map[bot.State][]bot.State{
+		bot.MenuState:               {bot.TimeChoosingState, bot.ListRemindersState, bot.SettingsState},
+		bot.ListRemindersState:      {bot.TimeChoosingState},
+		bot.TimeChoosingState:       {bot.TimeConfirmingState, bot.TextEnteringState},
+		bot.TimeConfirmingState:     {bot.TextEnteringState, bot.TimeChoosingState},
+		bot.TextEnteringState:       {bot.DeliveryChoosingState},
+		bot.DeliveryChoosingState:   {bot.DeviceChoosingState},
+		bot.DeviceChoosingState:     {bot.ColourChoosingState, bot.RepeatChoosingState},
+		bot.ColourChoosingState:     {bot.RGBEnteringState, bot.BrightnessChoosingState, bot.RepeatChoosingState},
+		bot.RGBEnteringState:        {bot.BrightnessChoosingState},
+		bot.BrightnessChoosingState: {bot.EffectChoosingState, bot.RepeatChoosingState},
+		bot.EffectChoosingState:     {bot.KeyframesEnteringState, bot.RepeatChoosingState},
+		bot.KeyframesEnteringState:  {bot.RepeatChoosingState},
+		bot.RepeatChoosingState: {
+			bot.RepeatDaysEnteringState, bot.RepeatHoursEnteringState, bot.RepeatRuleEnteringState,
+			bot.ReminderConfirmingState,
+		},
+		bot.RepeatDaysEnteringState:  {bot.ReminderConfirmingState},
+		bot.RepeatHoursEnteringState: {bot.ReminderConfirmingState},
+		bot.RepeatRuleEnteringState:  {bot.ReminderConfirmingState},
+		bot.ReminderConfirmingState:  {bot.TimeChoosingState},
+		bot.SettingsState: {
+			bot.SettingsColourState, bot.SettingsEffectState, bot.SettingsDeviceState, bot.SettingsTimeZoneState,
+			bot.SettingsTimeLocaleState, bot.SettingsPageSizeState,
+		},
+		bot.SettingsColourState:     {bot.SettingsState},
+		bot.SettingsEffectState:     {bot.SettingsState},
+		bot.SettingsDeviceState:     {bot.SettingsState},
+		bot.SettingsTimeZoneState:   {bot.SettingsState},
+		bot.SettingsTimeLocaleState: {bot.SettingsState},
+		bot.SettingsPageSizeState:   {bot.SettingsState},
+	}
+
+	flow := bot.NewFlow()
+	require.NoError(t, flow.Validate())
+
+	for from, targets := range transitions {
+		for to := range transitions {
+			expected := to == from || to == bot.MenuState
+			for _, target := range targets {
+				expected = expected || to == target
+			}
+
+			assert.Equal(t, expected, flow.Allows(from, to), "%d -> %d", from, to)
+		}
+	}
+}
+
+func TestFlowBack(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name         string
+		s            bot.State
+		expected     bot.State
+		expectedBack bool
+	}{
+		{name: "menu", s: bot.MenuState},
+		{name: "reminders list", s: bot.ListRemindersState},
+		{name: "time choosing is the first step", s: bot.TimeChoosingState},
+		{name: "time confirming", s: bot.TimeConfirmingState, expected: bot.TimeChoosingState, expectedBack: true},
+		{name: "text entering", s: bot.TextEnteringState, expected: bot.TimeChoosingState, expectedBack: true},
+		{name: "delivery choosing", s: bot.DeliveryChoosingState, expected: bot.TextEnteringState, expectedBack: true},
+		{name: "device choosing", s: bot.DeviceChoosingState, expected: bot.DeliveryChoosingState, expectedBack: true},
+		{name: "colour choosing", s: bot.ColourChoosingState, expected: bot.DeviceChoosingState, expectedBack: true},
+		{name: "rgb entering", s: bot.RGBEnteringState, expected: bot.ColourChoosingState, expectedBack: true},
+		{
+			name:         "brightness choosing",
+			s:            bot.BrightnessChoosingState,
+			expected:     bot.ColourChoosingState,
+			expectedBack: true,
+		},
+		{name: "effect choosing", s: bot.EffectChoosingState, expected: bot.BrightnessChoosingState, expectedBack: true},
+		{name: "keyframes entering", s: bot.KeyframesEnteringState, expected: bot.EffectChoosingState, expectedBack: true},
+		{name: "repeat choosing", s: bot.RepeatChoosingState, expected: bot.EffectChoosingState, expectedBack: true},
+		{name: "repeat days", s: bot.RepeatDaysEnteringState, expected: bot.RepeatChoosingState, expectedBack: true},
+		{name: "repeat hours", s: bot.RepeatHoursEnteringState, expected: bot.RepeatChoosingState, expectedBack: true},
+		{name: "repeat rule", s: bot.RepeatRuleEnteringState, expected: bot.RepeatChoosingState, expectedBack: true},
+		{
+			name:         "reminder confirming",
+			s:            bot.ReminderConfirmingState,
+			expected:     bot.RepeatChoosingState,
+			expectedBack: true,
+		},
+		{name: "settings", s: bot.SettingsState},
+		{name: "default colour", s: bot.SettingsColourState, expected: bot.SettingsState, expectedBack: true},
+		{name: "default effect", s: bot.SettingsEffectState, expected: bot.SettingsState, expectedBack: true},
+		{name: "default device", s: bot.SettingsDeviceState, expected: bot.SettingsState, expectedBack: true},
+		{name: "time zone", s: bot.SettingsTimeZoneState, expected: bot.SettingsState, expectedBack: true},
+		{name: "time locale", s: bot.SettingsTimeLocaleState, expected: bot.SettingsState, expectedBack: true},
+		{name: "page size", s: bot.SettingsPageSizeState, expected: bot.SettingsState, expectedBack: true},
+	}
+
+	flow := bot.NewFlow()
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			back, ok := flow.BackOf(testcase.s)
+
+			assert.Equal(t, testcase.expectedBack, ok)
+			assert.Equal(t, testcase.expected, back)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package bot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/clock"
+	rediswrapper "github.com/almostinf/glow-reminder/pkg/redis"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	MemoryStateStore = "memory"
+	RedisStateStore  = "redis"
+
+	userStateKeyPrefix = "bot-user-state:"
+)
+
+// StateStore keeps the conversation state of the users between their updates.
+// A state not updated for the TTL expires, so an abandoned wizard starts over from the menu.
+type StateStore interface {
+	// GetState returns the state of the user and whether it is found.
+	GetState(ctx context.Context, userID int64) (*userState, bool, error)
+	SetState(ctx context.Context, userID int64, us *userState) error
+}
+
+// NewStateStore returns the store selected in the config. The Redis store is shared by the replicas and survives restarts.
+func NewStateStore(cfg Config, redis *rediswrapper.Redis, clock clock.Clock) (StateStore, error) {
+	switch cfg.StateStore {
+	case MemoryStateStore, "":
+		return newMemoryStateStore(cfg.StateTTL, clock), nil
+	case RedisStateStore:
+		return newRedisStateStore(cfg.StateTTL, redis), nil
+	default:
+		return nil, fmt.Errorf("unknown state store %q", cfg.StateStore)
+	}
+}
+
+// storedUserState is the JSON encoding of userState.
+type storedUserState struct {
+	State    state           `json:"state"`
+	Reminder domain.Reminder `json:"reminder"`
+	Offset   int64           `json:"offset,omitempty"`
+	Editing  bool            `json:"editing,omitempty"`
+	Quick    bool            `json:"quick,omitempty"`
+}
+
+func (us *userState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(storedUserState{
+		State:    us.s,
+		Reminder: us.reminder,
+		Offset:   us.offset,
+		Editing:  us.editing,
+		Quick:    us.quick,
+	})
+}
+
+func (us *userState) UnmarshalJSON(data []byte) error {
+	var stored storedUserState
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+
+	*us = userState{
+		s:        stored.State,
+		reminder: stored.Reminder,
+		offset:   stored.Offset,
+		editing:  stored.Editing,
+		quick:    stored.Quick,
+	}
+
+	return nil
+}
+
+type memoryStateEntry struct {
+	us        userState
+	expiresAt time.Time
+}
+
+// memoryStateStore keeps the states in the process memory, they are lost on restart and not shared by the replicas.
+type memoryStateStore struct {
+	ttl   time.Duration
+	clock clock.Clock
+
+	m       sync.Mutex
+	entries map[int64]memoryStateEntry
+	sweptAt time.Time
+}
+
+func newMemoryStateStore(ttl time.Duration, clock clock.Clock) *memoryStateStore {
+	return &memoryStateStore{
+		ttl:     ttl,
+		clock:   clock,
+		entries: make(map[int64]memoryStateEntry),
+	}
+}
+
+func (store *memoryStateStore) GetState(_ context.Context, userID int64) (*userState, bool, error) {
+	store.m.Lock()
+	defer store.m.Unlock()
+
+	entry, ok := store.entries[userID]
+	if !ok {
+		return nil, false, nil
+	}
+
+	if !store.clock.NowUTC().Before(entry.expiresAt) {
+		delete(store.entries, userID)
+		return nil, false, nil
+	}
+
+	// A copy is returned, so that the state only changes with SetState as it does in Redis.
+	us := entry.us
+
+	return &us, true, nil
+}
+
+func (store *memoryStateStore) SetState(_ context.Context, userID int64, us *userState) error {
+	store.m.Lock()
+	defer store.m.Unlock()
+
+	now := store.clock.NowUTC()
+
+	// The expired states of the users that have not come back are swept once in a TTL.
+	if now.Sub(store.sweptAt) >= store.ttl {
+		for id, entry := range store.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(store.entries, id)
+			}
+		}
+		store.sweptAt = now
+	}
+
+	store.entries[userID] = memoryStateEntry{
+		us:        *us,
+		expiresAt: now.Add(store.ttl),
+	}
+
+	return nil
+}
+
+type redisStateStore struct {
+	ttl   time.Duration
+	redis *rediswrapper.Redis
+}
+
+func newRedisStateStore(ttl time.Duration, redis *rediswrapper.Redis) *redisStateStore {
+	return &redisStateStore{
+		ttl:   ttl,
+		redis: redis,
+	}
+}
+
+func (store *redisStateStore) GetState(ctx context.Context, userID int64) (*userState, bool, error) {
+	stateBytes, err := store.redis.Get(ctx, userStateKey(userID)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to Get user state %d: %w", userID, err)
+	}
+
+	us := &userState{}
+	if err = json.Unmarshal(stateBytes, us); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal user state: %w", err)
+	}
+
+	return us, true, nil
+}
+
+func (store *redisStateStore) SetState(ctx context.Context, userID int64, us *userState) error {
+	stateBytes, err := json.Marshal(us)
+	if err != nil {
+		return fmt.Errorf("failed to marshal user state: %w", err)
+	}
+
+	if err = store.redis.Set(ctx, userStateKey(userID), stateBytes, store.ttl).Err(); err != nil {
+		return fmt.Errorf("failed to Set user state %d: %w", userID, err)
+	}
+
+	return nil
+}
+
+func userStateKey(userID int64) string {
+	return userStateKeyPrefix + strconv.FormatInt(userID, 10)
+}
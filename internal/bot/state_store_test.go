@@ -0,0 +1,126 @@
+package bot_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/almostinf/glow-reminder/internal/bot"
+	"github.com/almostinf/glow-reminder/internal/domain"
+	clock_mocks "github.com/almostinf/glow-reminder/pkg/clock/mocks"
+	rediswrapper "github.com/almostinf/glow-reminder/pkg/redis"
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const stateTTL = time.Hour
+
+func TestStateStore(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name string
+		// newStore returns the store and a function moving its time forward.
+		newStore func(t *testing.T) (bot.StateStore, func(d time.Duration))
+	}{
+		{
+			name: "memory",
+			newStore: func(t *testing.T) (bot.StateStore, func(d time.Duration)) {
+				now := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+
+				mockCtrl := gomock.NewController(t)
+
+				clk := clock_mocks.NewMockClock(mockCtrl)
+				clk.EXPECT().NowUTC().DoAndReturn(func() time.Time { return now }).AnyTimes()
+
+				store, err := bot.NewStateStore(bot.Config{StateStore: bot.MemoryStateStore, StateTTL: stateTTL}, nil, clk)
+				require.NoError(t, err)
+
+				return store, func(d time.Duration) { now = now.Add(d) }
+			},
+		},
+		{
+			name: "redis",
+			newStore: func(t *testing.T) (bot.StateStore, func(d time.Duration)) {
+				server := miniredis.RunT(t)
+
+				client := goredis.NewClient(&goredis.Options{Addr: server.Addr()})
+				t.Cleanup(func() { _ = client.Close() })
+
+				store, err := bot.NewStateStore(
+					bot.Config{StateStore: bot.RedisStateStore, StateTTL: stateTTL},
+					&rediswrapper.Redis{Client: client},
+					nil,
+				)
+				require.NoError(t, err)
+
+				return store, server.FastForward
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			store, advance := testcase.newStore(t)
+
+			ctx := context.Background()
+			userID := int64(42)
+
+			_, ok, err := store.GetState(ctx, userID)
+			require.NoError(t, err)
+			assert.False(t, ok, "no state is stored yet")
+
+			draft := bot.NewUserState(domain.Reminder{
+				ID:          uuid.New(),
+				UserID:      userID,
+				Msg:         "Water the plants",
+				RGB:         domain.RGB(0x00FF00),
+				Brightness:  40,
+				Mode:        domain.Blinking,
+				Effect:      domain.DefaultEffect(domain.BreathingEffect),
+				Delivery:    domain.LightAndMessage,
+				DeviceID:    uuid.NullUUID{UUID: uuid.New(), Valid: true},
+				ScheduledAt: time.Date(2025, 5, 11, 9, 0, 0, 0, time.UTC),
+				Recurrence:  domain.Recurrence("daily"),
+			}, 10, true)
+
+			require.NoError(t, store.SetState(ctx, userID, draft))
+
+			us, ok, err := store.GetState(ctx, userID)
+			require.NoError(t, err)
+			require.True(t, ok)
+			assert.Equal(t, draft, us)
+
+			// A state is kept for the TTL since it was last set.
+			advance(stateTTL / 2)
+			require.NoError(t, store.SetState(ctx, userID, bot.NewMenuUserState()))
+			advance(stateTTL / 2)
+
+			us, ok, err = store.GetState(ctx, userID)
+			require.NoError(t, err)
+			require.True(t, ok)
+			assert.Equal(t, bot.NewMenuUserState(), us)
+
+			advance(stateTTL)
+
+			_, ok, err = store.GetState(ctx, userID)
+			require.NoError(t, err)
+			assert.False(t, ok, "an abandoned state expires")
+		})
+	}
+
+	t.Run("unknown store", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := bot.NewStateStore(bot.Config{StateStore: "etcd"}, nil, nil)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,187 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/usecase"
+	telebot "gopkg.in/telebot.v4"
+)
+
+const (
+	tokenUsageMsg = "Usage: /token <name> [read,write,trigger] [ttl=<days>d]\n" +
+		"- read lists the reminders and the lamps, write changes the reminders, trigger lights the lamps\n" +
+		"- all the scopes are granted and the token expires in 90 days by default"
+	tokenRevokeUsageMsg = "Usage: /token_revoke <name>"
+	noTokensMsg         = "🔑 You have no API tokens\n" + tokenUsageMsg
+	tokenPrivateChatMsg = "🔒 API tokens are only managed in a private chat with the bot"
+	tokenIssuedMsg      = "🔑 Token %s (%s) expires on %s. Pass it in the 'Authorization: Bearer <token>' header " +
+		"of the REST API requests. Keep it secret, it is not shown again, revoke it with /token_revoke %s"
+
+	tokenTTLOption = "ttl="
+	dateFormat     = "2006-01-02"
+)
+
+// handleToken lists the tokens of the user or issues a new one if the name is given.
+func (b *bot) handleToken() func(c telebot.Context) error {
+	return func(c telebot.Context) error {
+		userID := c.Sender().ID
+
+		b.setUserState(userID, &userState{
+			s: menuState,
+		})
+
+		if c.Chat().Type != telebot.ChatPrivate {
+			return c.Send(tokenPrivateChatMsg)
+		}
+
+		if strings.TrimSpace(c.Message().Payload) == "" {
+			return b.listTokens(c, userID)
+		}
+
+		name, scopes, ttl, err := parseTokenArgs(c.Message().Payload)
+		if err != nil {
+			return c.Send("❌ " + err.Error() + "\n" + tokenUsageMsg)
+		}
+
+		token, secret, err := b.tokenUsecase.IssueToken(context.TODO(), userID, name, scopes, ttl)
+		if err != nil {
+			return b.sendTokenError(c, userID, name, err)
+		}
+
+		location, err := b.userLocation(context.TODO(), userID)
+		if err != nil {
+			location = time.UTC
+		}
+
+		if err = c.Send(fmt.Sprintf(tokenIssuedMsg,
+			token.Name, token.Scopes, token.ExpiresAt.In(location).Format(dateFormat), token.Name)); err != nil {
+			return err
+		}
+
+		// The secret is sent separately to be copied with a tap.
+		return c.Send(secret)
+	}
+}
+
+func (b *bot) handleTokenRevoke() func(c telebot.Context) error {
+	return func(c telebot.Context) error {
+		userID := c.Sender().ID
+
+		b.setUserState(userID, &userState{
+			s: menuState,
+		})
+
+		args := strings.Fields(c.Message().Payload)
+		if len(args) != 1 {
+			return c.Send(tokenRevokeUsageMsg)
+		}
+
+		if err := b.tokenUsecase.RevokeToken(context.TODO(), userID, args[0]); err != nil {
+			return b.sendTokenError(c, userID, args[0], err)
+		}
+
+		return c.Send(fmt.Sprintf("🗑 Token %s is revoked", args[0]))
+	}
+}
+
+func (b *bot) listTokens(c telebot.Context, userID int64) error {
+	tokens, err := b.tokenUsecase.GetTokens(context.TODO(), userID)
+	if err != nil {
+		b.logger.Error("failed to GetTokens", map[string]interface{}{
+			"user_id": userID,
+			"err":     err.Error(),
+		})
+		return c.Send(tryAgainMsg)
+	}
+
+	if len(tokens) == 0 {
+		return c.Send(noTokensMsg)
+	}
+
+	location, err := b.userLocation(context.TODO(), userID)
+	if err != nil {
+		location = time.UTC
+	}
+
+	now := b.clock.NowUTC()
+
+	tokensMsg := "🔑 Your API tokens:"
+	for _, token := range tokens {
+		expiry := "expires on " + token.ExpiresAt.In(location).Format(dateFormat)
+		if !token.Active(now) {
+			expiry = "expired"
+		}
+		tokensMsg += fmt.Sprintf("\n- %s (%s, %s)", token.Name, token.Scopes, expiry)
+	}
+
+	return c.Send(tokensMsg + "\n\n" + tokenUsageMsg)
+}
+
+func (b *bot) sendTokenError(c telebot.Context, userID int64, name string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return c.Send(fmt.Sprintf("❌ You have no token named %s", name))
+	case errors.Is(err, domain.ErrAlreadyExists):
+		return c.Send(fmt.Sprintf("❌ You already have a token named %s", name))
+	case errors.Is(err, domain.ErrInvalidTokenName), errors.Is(err, domain.ErrInvalidTokenScopes),
+		errors.Is(err, usecase.ErrInvalidTokenTTL):
+		return c.Send("❌ " + err.Error())
+	default:
+		b.logger.Error("failed to manage token", map[string]interface{}{
+			"user_id": userID,
+			"name":    name,
+			"err":     err.Error(),
+		})
+		return c.Send(tryAgainMsg)
+	}
+}
+
+// parseTokenArgs parses the arguments of /token.
+func parseTokenArgs(payload string) (string, domain.TokenScopes, time.Duration, error) {
+	args := strings.Fields(payload)
+
+	name := args[0]
+	scopes := domain.AllScopes
+	ttl := usecase.DefaultTokenTTL
+
+	for _, arg := range args[1:] {
+		if strings.HasPrefix(arg, tokenTTLOption) {
+			var err error
+			if ttl, err = parseTokenTTL(strings.TrimPrefix(arg, tokenTTLOption)); err != nil {
+				return "", 0, 0, err
+			}
+			continue
+		}
+
+		var err error
+		if scopes, err = domain.ParseTokenScopes(arg); err != nil {
+			return "", 0, 0, err
+		}
+	}
+
+	return name, scopes, ttl, nil
+}
+
+// parseTokenTTL parses the number of days, e.g. "30d", or a duration, e.g. "12h".
+func parseTokenTTL(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %q", usecase.ErrInvalidTokenTTL, s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	ttl, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", usecase.ErrInvalidTokenTTL, s)
+	}
+
+	return ttl, nil
+}
@@ -0,0 +1,54 @@
+package device
+
+import (
+	"strings"
+	"time"
+
+	"github.com/almostinf/glow-reminder/config"
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/leader"
+)
+
+type Config struct {
+	// DefaultHost is the lamp lighting the reminders of the users who have not registered any lamps.
+	DefaultHost string
+	// DefaultCapabilities are the features supported by the firmware of the default lamp.
+	DefaultCapabilities domain.DeviceCapabilities
+	// CallbackURL is the REST API base URL the HTTP lamps confirm the deliveries at.
+	CallbackURL string
+	MQTT        MQTTConfig
+}
+
+type MQTTConfig struct {
+	// BrokerURL is empty if the MQTT transport is disabled.
+	BrokerURL string
+	// ClientID is the configured client id followed by the id of the instance, since the broker
+	// drops the session of a client once another one connects with the same id.
+	ClientID    string
+	Username    string
+	Password    string
+	TopicPrefix string
+	// AckTimeout is how long a command waits for the lamp to acknowledge it.
+	AckTimeout time.Duration
+}
+
+func FromAppConfig(appCfg *config.AppConfig, leaderCfg leader.Config) Config {
+	capabilities := domain.AllCapabilities
+	if appCfg.GlowReminderClient.LegacyColours {
+		capabilities = 0
+	}
+
+	return Config{
+		DefaultHost:         appCfg.GlowReminderClient.Host,
+		DefaultCapabilities: capabilities,
+		CallbackURL:         strings.TrimSuffix(appCfg.GlowReminderClient.CallbackURL, "/"),
+		MQTT: MQTTConfig{
+			BrokerURL:   appCfg.MQTT.BrokerURL,
+			ClientID:    appCfg.MQTT.ClientID + "-" + leaderCfg.InstanceID,
+			Username:    appCfg.MQTT.Username,
+			Password:    appCfg.MQTT.Password,
+			TopicPrefix: appCfg.MQTT.TopicPrefix,
+			AckTimeout:  appCfg.MQTT.AckTimeout,
+		},
+	}
+}
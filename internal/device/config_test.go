@@ -0,0 +1,23 @@
+package device_test
+
+import (
+	"testing"
+
+	"github.com/almostinf/glow-reminder/config"
+	"github.com/almostinf/glow-reminder/internal/device"
+	"github.com/almostinf/glow-reminder/internal/leader"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromAppConfigMQTTClientID(t *testing.T) {
+	t.Parallel()
+
+	appCfg := &config.AppConfig{}
+	appCfg.MQTT.ClientID = "glow_reminder"
+
+	first := device.FromAppConfig(appCfg, leader.Config{InstanceID: "host-1a2b3c4d"})
+	second := device.FromAppConfig(appCfg, leader.Config{InstanceID: "host-5e6f7a8b"})
+
+	assert.Equal(t, "glow_reminder-host-1a2b3c4d", first.MQTT.ClientID)
+	assert.NotEqual(t, first.MQTT.ClientID, second.MQTT.ClientID, "the replicas connect with their own client ids")
+}
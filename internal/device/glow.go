@@ -0,0 +1,75 @@
+package device
+
+import (
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/glow_reminder/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+)
+
+// Glow lights the lamp with the colour and the effect of the reminder, downgrading them to the lamp capabilities.
+// The lamps with the legacy firmware do not confirm the delivery.
+func (registry *registry) Glow(device *domain.Device, reminder *domain.Reminder, deliveryID uuid.UUID) error {
+	transport, err := registry.transport(device)
+	if err != nil {
+		return err
+	}
+
+	if !device.Capabilities.Has(domain.RGBCapability) {
+		return transport.GlowReminder(device, &models.GlowReminder{
+			Colour: int64(reminder.RGB.LegacyColour()),
+			Mode:   int64(reminder.Mode),
+		})
+	}
+
+	body := &models.GlowReminderV2{
+		Rgb:        swag.String(reminder.RGB.Hex()),
+		Brightness: swag.Int64(int64(reminder.Brightness)),
+		Mode:       int64(reminder.Mode),
+	}
+	if device.Capabilities.Has(domain.EffectsCapability) {
+		body.Effect = effectModel(reminder.Effect)
+	}
+	if deliveryID != uuid.Nil {
+		body.DeliveryID = strfmt.UUID(deliveryID.String())
+		if !device.AcknowledgesCommands() && registry.cfg.CallbackURL != "" {
+			body.AckURL = registry.cfg.CallbackURL + "/v1/deliveries/" + deliveryID.String() + "/ack"
+		}
+	}
+
+	return transport.GlowReminderV2(device, body)
+}
+
+// effectModel converts the effect into the glow API payload. Reminders without an effect are played by their mode.
+func effectModel(effect domain.Effect) *models.Effect {
+	if effect.Kind == "" {
+		return nil
+	}
+
+	keyframes := make([]*models.Keyframe, 0, len(effect.Keyframes))
+	for _, keyframe := range effect.Keyframes {
+		keyframes = append(keyframes, &models.Keyframe{
+			Rgb:        swag.String(keyframe.RGB.Hex()),
+			Brightness: swag.Int64(int64(keyframe.Brightness)),
+			DurationMs: swag.Int64(keyframe.Duration.Milliseconds()),
+		})
+	}
+
+	return &models.Effect{
+		Kind:       swag.String(string(effect.Kind)),
+		PeriodMs:   effect.Period.Milliseconds(),
+		DurationMs: effect.Duration.Milliseconds(),
+		Repeat:     int64(effect.Repeat),
+		Keyframes:  keyframes,
+	}
+}
+
+func (registry *registry) StopGlow(device *domain.Device) error {
+	transport, err := registry.transport(device)
+	if err != nil {
+		return err
+	}
+
+	return transport.StopGlow(device)
+}
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/almostinf/glow-reminder/internal/device (interfaces: Registry)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/device_mocks.go github.com/almostinf/glow-reminder/internal/device Registry
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/almostinf/glow-reminder/internal/domain"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRegistry is a mock of Registry interface.
+type MockRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegistryMockRecorder
+}
+
+// MockRegistryMockRecorder is the mock recorder for MockRegistry.
+type MockRegistryMockRecorder struct {
+	mock *MockRegistry
+}
+
+// NewMockRegistry creates a new mock instance.
+func NewMockRegistry(ctrl *gomock.Controller) *MockRegistry {
+	mock := &MockRegistry{ctrl: ctrl}
+	mock.recorder = &MockRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegistry) EXPECT() *MockRegistryMockRecorder {
+	return m.recorder
+}
+
+// Glow mocks base method.
+func (m *MockRegistry) Glow(arg0 *domain.Device, arg1 *domain.Reminder, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Glow", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Glow indicates an expected call of Glow.
+func (mr *MockRegistryMockRecorder) Glow(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Glow", reflect.TypeOf((*MockRegistry)(nil).Glow), arg0, arg1, arg2)
+}
+
+// Probe mocks base method.
+func (m *MockRegistry) Probe(arg0 context.Context, arg1 *domain.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Probe", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Probe indicates an expected call of Probe.
+func (mr *MockRegistryMockRecorder) Probe(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Probe", reflect.TypeOf((*MockRegistry)(nil).Probe), arg0, arg1)
+}
+
+// ReminderDevices mocks base method.
+func (m *MockRegistry) ReminderDevices(arg0 context.Context, arg1 *domain.Reminder) ([]*domain.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReminderDevices", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReminderDevices indicates an expected call of ReminderDevices.
+func (mr *MockRegistryMockRecorder) ReminderDevices(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReminderDevices", reflect.TypeOf((*MockRegistry)(nil).ReminderDevices), arg0, arg1)
+}
+
+// StopGlow mocks base method.
+func (m *MockRegistry) StopGlow(arg0 *domain.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopGlow", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopGlow indicates an expected call of StopGlow.
+func (mr *MockRegistryMockRecorder) StopGlow(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopGlow", reflect.TypeOf((*MockRegistry)(nil).StopGlow), arg0)
+}
@@ -0,0 +1,321 @@
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/glow_reminder/models"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/google/uuid"
+)
+
+const (
+	glowReminderTopic   = "glow_reminder"
+	glowReminderV2Topic = "glow_reminder/v2"
+	stopGlowTopic       = "stop"
+	ackTopic            = "ack"
+	statusTopic         = "status"
+	onlineStatus        = "online"
+
+	// commandQoS delivers the commands at least once, the lamp acks the same command id again on redelivery.
+	commandQoS = 1
+
+	// disconnectQuiesce is how long the in-flight messages are given to be sent on disconnect in milliseconds.
+	disconnectQuiesce = 250
+)
+
+var (
+	ErrMQTTDisabled = errors.New("MQTT transport is disabled, set the broker URL in the config")
+	ErrAckTimeout   = errors.New("lamp did not acknowledge the command in time")
+	ErrLampOffline  = errors.New("lamp is offline")
+)
+
+var _ MQTTTransport = (*mqttTransport)(nil)
+
+// MQTTTransport delivers the commands to the lamps behind NAT through the MQTT broker.
+//
+// A command is published to "<prefix>/<lamp id>/<command>" as {"id": "<command id>", "body": <glow API body>}
+// and succeeds once the lamp publishes {"id": "<command id>", "ok": true} to "<prefix>/<lamp id>/ack".
+// The lamp reports "online" or "offline" as a retained message to "<prefix>/<lamp id>/status"
+// and sets the "offline" status as its last will, so the broker reports a lamp that dropped off.
+type MQTTTransport interface {
+	Transport
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+}
+
+type mqttCommand struct {
+	ID   string      `json:"id"`
+	Body interface{} `json:"body,omitempty"`
+}
+
+type mqttAck struct {
+	ID    string `json:"id"`
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+// pendingCommand waits for the ack of a command from the lamp it was sent to.
+type pendingCommand struct {
+	deviceID string
+	acks     chan mqttAck
+}
+
+type mqttTransport struct {
+	cfg    MQTTConfig
+	logger logger.Logger
+
+	client mqtt.Client
+
+	mu      sync.Mutex
+	pending map[string]pendingCommand
+	// statuses are the last statuses reported by the lamps by their ids.
+	statuses map[string]string
+}
+
+func NewMQTT(cfg Config, logger logger.Logger) *mqttTransport {
+	return &mqttTransport{
+		cfg:      cfg.MQTT,
+		logger:   logger,
+		pending:  make(map[string]pendingCommand),
+		statuses: make(map[string]string),
+	}
+}
+
+// Start connects to the broker and subscribes to the acks and the statuses of the lamps.
+// The subscriptions are renewed on every reconnect, since the session is not persisted by the broker.
+func (transport *mqttTransport) Start(ctx context.Context) error {
+	if transport.cfg.BrokerURL == "" {
+		transport.logger.Info("MQTT transport is disabled", map[string]interface{}{})
+		return nil
+	}
+
+	subscribed := make(chan error, 1)
+
+	opts := mqtt.NewClientOptions().
+		AddBroker(transport.cfg.BrokerURL).
+		SetClientID(transport.cfg.ClientID).
+		SetUsername(transport.cfg.Username).
+		SetPassword(transport.cfg.Password).
+		SetAutoReconnect(true).
+		SetOrderMatters(false).
+		SetOnConnectHandler(func(client mqtt.Client) {
+			err := transport.subscribe(client)
+			if err != nil {
+				transport.logger.Error("failed to subscribe to MQTT topics", map[string]interface{}{
+					"error": err.Error(),
+				})
+			}
+
+			select {
+			case subscribed <- err:
+			default:
+			}
+		}).
+		SetConnectionLostHandler(func(_ mqtt.Client, err error) {
+			transport.logger.Warn("MQTT connection lost", map[string]interface{}{
+				"error": err.Error(),
+			})
+		})
+
+	transport.client = mqtt.NewClient(opts)
+
+	transport.logger.Info("Connect to MQTT broker", map[string]interface{}{
+		"broker_url": transport.cfg.BrokerURL,
+	})
+
+	if err := wait(ctx, transport.client.Connect()); err != nil {
+		return fmt.Errorf("failed to connect to MQTT broker %s: %w", transport.cfg.BrokerURL, err)
+	}
+
+	select {
+	case err := <-subscribed:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (transport *mqttTransport) Stop(_ context.Context) error {
+	if transport.client == nil {
+		return nil
+	}
+
+	transport.client.Disconnect(disconnectQuiesce)
+
+	return nil
+}
+
+func (transport *mqttTransport) GlowReminder(device *domain.Device, body *models.GlowReminder) error {
+	if err := transport.command(device, glowReminderTopic, uuid.NewString(), body); err != nil {
+		return fmt.Errorf("failed to GlowReminder: %w", err)
+	}
+
+	return nil
+}
+
+func (transport *mqttTransport) GlowReminderV2(device *domain.Device, body *models.GlowReminderV2) error {
+	id := body.DeliveryID.String()
+	if id == "" {
+		id = uuid.NewString()
+	}
+
+	if err := transport.command(device, glowReminderV2Topic, id, body); err != nil {
+		return fmt.Errorf("failed to GlowReminderV2: %w", err)
+	}
+
+	return nil
+}
+
+func (transport *mqttTransport) StopGlow(device *domain.Device) error {
+	if err := transport.command(device, stopGlowTopic, uuid.NewString(), nil); err != nil {
+		return fmt.Errorf("failed to StopGlow: %w", err)
+	}
+
+	return nil
+}
+
+// Probe checks the last status reported by the lamp, the broker reports the last will of a lamp that dropped off.
+func (transport *mqttTransport) Probe(_ context.Context, device *domain.Device) error {
+	if transport.client == nil {
+		return ErrMQTTDisabled
+	}
+
+	transport.mu.Lock()
+	status, ok := transport.statuses[device.Host]
+	transport.mu.Unlock()
+
+	switch {
+	case !ok:
+		return fmt.Errorf("%w: lamp %s has not reported its status", ErrLampOffline, device.Host)
+	case status != onlineStatus:
+		return fmt.Errorf("%w: lamp %s reported %q", ErrLampOffline, device.Host, status)
+	default:
+		return nil
+	}
+}
+
+// command publishes the command to the lamp and waits for its ack. The glow commands of a reminder
+// are identified by the delivery id, so the lamp can tell a retry from a new reminder.
+// Commands are not retained, so a lamp coming online later does not replay a stale reminder.
+func (transport *mqttTransport) command(device *domain.Device, command, id string, body interface{}) error {
+	if transport.client == nil {
+		return ErrMQTTDisabled
+	}
+
+	payload, err := json.Marshal(mqttCommand{ID: id, Body: body})
+	if err != nil {
+		return fmt.Errorf("failed to marshal command: %w", err)
+	}
+
+	acks := make(chan mqttAck, 1)
+
+	transport.mu.Lock()
+	transport.pending[id] = pendingCommand{deviceID: device.Host, acks: acks}
+	transport.mu.Unlock()
+
+	defer func() {
+		transport.mu.Lock()
+		delete(transport.pending, id)
+		transport.mu.Unlock()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), transport.cfg.AckTimeout)
+	defer cancel()
+
+	if err = wait(ctx, transport.client.Publish(transport.topic(device.Host, command), commandQoS, false, payload)); err != nil {
+		return fmt.Errorf("failed to publish to lamp %s: %w", device.Host, err)
+	}
+
+	select {
+	case ack := <-acks:
+		if !ack.OK {
+			return fmt.Errorf("lamp %s rejected the command: %s", device.Host, ack.Error)
+		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%w: lamp %s", ErrAckTimeout, device.Host)
+	}
+}
+
+func (transport *mqttTransport) subscribe(client mqtt.Client) error {
+	token := client.SubscribeMultiple(map[string]byte{
+		transport.topic("+", ackTopic):    commandQoS,
+		transport.topic("+", statusTopic): commandQoS,
+	}, transport.handleMessage)
+
+	return wait(context.Background(), token)
+}
+
+func (transport *mqttTransport) handleMessage(_ mqtt.Client, msg mqtt.Message) {
+	deviceID, kind, ok := transport.parseTopic(msg.Topic())
+	if !ok {
+		return
+	}
+
+	switch kind {
+	case ackTopic:
+		transport.handleAck(deviceID, msg.Payload())
+	case statusTopic:
+		transport.mu.Lock()
+		transport.statuses[deviceID] = string(msg.Payload())
+		transport.mu.Unlock()
+	}
+}
+
+func (transport *mqttTransport) handleAck(deviceID string, payload []byte) {
+	var ack mqttAck
+	if err := json.Unmarshal(payload, &ack); err != nil {
+		transport.logger.Warn("invalid lamp ack", map[string]interface{}{
+			"device": deviceID,
+			"error":  err.Error(),
+		})
+		return
+	}
+
+	transport.mu.Lock()
+	command, ok := transport.pending[ack.ID]
+	transport.mu.Unlock()
+
+	// Acks of the timed out commands and of the commands sent to the other lamps are ignored.
+	if !ok || command.deviceID != deviceID {
+		return
+	}
+
+	select {
+	case command.acks <- ack:
+	default:
+	}
+}
+
+func (transport *mqttTransport) topic(deviceID, kind string) string {
+	return transport.cfg.TopicPrefix + "/" + deviceID + "/" + kind
+}
+
+// parseTopic splits "<prefix>/<lamp id>/<kind>" into the lamp id and the kind of the message.
+func (transport *mqttTransport) parseTopic(topic string) (string, string, bool) {
+	rest, ok := strings.CutPrefix(topic, transport.cfg.TopicPrefix+"/")
+	if !ok {
+		return "", "", false
+	}
+
+	deviceID, kind, ok := strings.Cut(rest, "/")
+
+	return deviceID, kind, ok
+}
+
+// wait waits for the MQTT operation to complete or the context to be done.
+func wait(ctx context.Context, token mqtt.Token) error {
+	select {
+	case <-token.Done():
+		return token.Error()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
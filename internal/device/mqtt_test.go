@@ -0,0 +1,258 @@
+package device_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/device"
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/glow_reminder/models"
+	logger_mocks "github.com/almostinf/glow-reminder/pkg/logger/mocks"
+	"github.com/go-openapi/swag"
+	mochi "github.com/mochi-mqtt/server/v2"
+	"github.com/mochi-mqtt/server/v2/hooks/auth"
+	"github.com/mochi-mqtt/server/v2/listeners"
+	"github.com/mochi-mqtt/server/v2/packets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const topicPrefix = "glow_reminder"
+
+type lampCommand struct {
+	ID   string          `json:"id"`
+	Body json.RawMessage `json:"body"`
+}
+
+// newBroker runs an in-process MQTT broker and returns it with its URL.
+func newBroker(t *testing.T) (*mochi.Server, string) {
+	t.Helper()
+
+	broker := mochi.New(&mochi.Options{InlineClient: true})
+	require.NoError(t, broker.AddHook(new(auth.AllowHook), nil))
+
+	listener := listeners.NewTCP(listeners.Config{ID: "tcp", Address: "127.0.0.1:0"})
+	require.NoError(t, broker.AddListener(listener))
+	require.NoError(t, broker.Serve())
+	t.Cleanup(func() { _ = broker.Close() })
+
+	return broker, "tcp://" + listener.Address()
+}
+
+// startBroker runs an in-process MQTT broker and returns its URL.
+// The lamps are emulated by the inline client of the broker answering the commands with the ack.
+func startBroker(t *testing.T, ack func(deviceID string, command lampCommand) *string) string {
+	t.Helper()
+
+	broker, url := newBroker(t)
+
+	require.NoError(t, broker.Subscribe(topicPrefix+"/+/#", 1, func(_ *mochi.Client, _ packets.Subscription, pk packets.Packet) {
+		parts := strings.SplitN(pk.TopicName, "/", 3)
+		if len(parts) != 3 || parts[2] == "ack" || parts[2] == "status" {
+			return
+		}
+
+		var command lampCommand
+		if err := json.Unmarshal(pk.Payload, &command); err != nil {
+			return
+		}
+
+		payload := ack(parts[1], command)
+		if payload == nil {
+			return
+		}
+
+		_ = broker.Publish(topicPrefix+"/"+parts[1]+"/ack", []byte(*payload), false, 1)
+	}))
+
+	return url
+}
+
+func TestMQTTTransport(t *testing.T) {
+	t.Parallel()
+
+	kitchen := &domain.Device{Name: "kitchen", Host: "kitchen-lamp", Protocol: domain.MQTTProtocol}
+	body := &models.GlowReminderV2{Rgb: swag.String("#FF0000"), Brightness: swag.Int64(100)}
+
+	testcases := []struct {
+		name          string
+		ack           func(deviceID string, command lampCommand) *string
+		send          func(transport device.MQTTTransport) error
+		expectedError string
+	}{
+		{
+			name: "acked glow",
+			ack: func(deviceID string, command lampCommand) *string {
+				if deviceID != kitchen.Host || !strings.Contains(string(command.Body), `"rgb":"#FF0000"`) {
+					return nil
+				}
+				return swag.String(`{"id":"` + command.ID + `","ok":true}`)
+			},
+			send: func(transport device.MQTTTransport) error {
+				return transport.GlowReminderV2(kitchen, body)
+			},
+		},
+		{
+			name: "acked stop",
+			ack: func(_ string, command lampCommand) *string {
+				return swag.String(`{"id":"` + command.ID + `","ok":true}`)
+			},
+			send: func(transport device.MQTTTransport) error {
+				return transport.StopGlow(kitchen)
+			},
+		},
+		{
+			name: "rejected glow",
+			ack: func(_ string, command lampCommand) *string {
+				return swag.String(`{"id":"` + command.ID + `","ok":false,"error":"unknown effect"}`)
+			},
+			send: func(transport device.MQTTTransport) error {
+				return transport.GlowReminderV2(kitchen, body)
+			},
+			expectedError: "unknown effect",
+		},
+		{
+			name: "ack of another command",
+			ack: func(string, lampCommand) *string {
+				return swag.String(`{"id":"stale","ok":true}`)
+			},
+			send: func(transport device.MQTTTransport) error {
+				return transport.GlowReminderV2(kitchen, body)
+			},
+			expectedError: device.ErrAckTimeout.Error(),
+		},
+		{
+			name: "lamp offline",
+			ack: func(string, lampCommand) *string {
+				return nil
+			},
+			send: func(transport device.MQTTTransport) error {
+				return transport.GlowReminder(kitchen, &models.GlowReminder{Colour: 1})
+			},
+			expectedError: device.ErrAckTimeout.Error(),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			log := logger_mocks.NewMockLogger(mockCtrl)
+			log.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			log.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+			transport := device.NewMQTT(device.Config{
+				MQTT: device.MQTTConfig{
+					BrokerURL:   startBroker(t, testcase.ack),
+					ClientID:    "glow_reminder_test",
+					TopicPrefix: topicPrefix,
+					AckTimeout:  500 * time.Millisecond,
+				},
+			}, log)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			require.NoError(t, transport.Start(ctx))
+			t.Cleanup(func() { _ = transport.Stop(context.Background()) })
+
+			err := testcase.send(transport)
+			if testcase.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, testcase.expectedError)
+			}
+		})
+	}
+}
+
+func TestMQTTTransportDisabled(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+
+	log := logger_mocks.NewMockLogger(mockCtrl)
+	log.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	transport := device.NewMQTT(device.Config{}, log)
+
+	require.NoError(t, transport.Start(context.Background()))
+
+	err := transport.StopGlow(&domain.Device{Host: "kitchen-lamp", Protocol: domain.MQTTProtocol})
+	assert.ErrorIs(t, err, device.ErrMQTTDisabled)
+}
+
+func TestMQTTTransportProbe(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name          string
+		status        string
+		expectedError error
+	}{
+		{
+			name:   "online lamp",
+			status: "online",
+		},
+		{
+			name:          "offline lamp",
+			status:        "offline",
+			expectedError: device.ErrLampOffline,
+		},
+		{
+			name:          "lamp without status",
+			expectedError: device.ErrLampOffline,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			log := logger_mocks.NewMockLogger(mockCtrl)
+			log.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+			log.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+			broker, url := newBroker(t)
+
+			if testcase.status != "" {
+				require.NoError(t, broker.Publish(topicPrefix+"/kitchen-lamp/status", []byte(testcase.status), true, 1))
+			}
+
+			transport := device.NewMQTT(device.Config{
+				MQTT: device.MQTTConfig{
+					BrokerURL:   url,
+					ClientID:    "glow_reminder_test",
+					TopicPrefix: topicPrefix,
+					AckTimeout:  500 * time.Millisecond,
+				},
+			}, log)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			require.NoError(t, transport.Start(ctx))
+			t.Cleanup(func() { _ = transport.Stop(context.Background()) })
+
+			kitchen := &domain.Device{Name: "kitchen", Host: "kitchen-lamp", Protocol: domain.MQTTProtocol}
+
+			// The retained status is delivered right after the subscription.
+			assert.Eventually(t, func() bool {
+				return errors.Is(transport.Probe(ctx, kitchen), testcase.expectedError)
+			}, time.Second, 10*time.Millisecond)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package device
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/repository/pg"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -package mocks -destination mocks/device_mocks.go github.com/almostinf/glow-reminder/internal/device Registry
+
+var _ Registry = (*registry)(nil)
+
+type Registry interface {
+	// ReminderDevices returns the lamps lit by the reminder: its device, the devices of its group
+	// or all the devices of the owner. The owner without devices gets the default lamp from the config.
+	ReminderDevices(ctx context.Context, reminder *domain.Reminder) ([]*domain.Device, error)
+	// Glow lights the lamp with the colour and the effect of the reminder. The lamp confirms the delivery
+	// with the given id, the test glows are not tracked and pass uuid.Nil.
+	Glow(device *domain.Device, reminder *domain.Reminder, deliveryID uuid.UUID) error
+	// StopGlow turns off the lamp lit by the last reminder.
+	StopGlow(device *domain.Device) error
+	// Probe checks that the lamp is up.
+	Probe(ctx context.Context, device *domain.Device) error
+}
+
+type registry struct {
+	cfg        Config
+	deviceRepo pg.DeviceRepo
+	logger     logger.Logger
+
+	transports map[domain.DeviceProtocol]Transport
+}
+
+func New(
+	cfg Config,
+	deviceRepo pg.DeviceRepo,
+	formats strfmt.Registry,
+	mqttTransport MQTTTransport,
+	logger logger.Logger,
+) *registry {
+	return &registry{
+		cfg:        cfg,
+		deviceRepo: deviceRepo,
+		logger:     logger,
+		transports: map[domain.DeviceProtocol]Transport{
+			domain.HTTPProtocol: newHTTPTransport(formats),
+			domain.MQTTProtocol: mqttTransport,
+		},
+	}
+}
+
+func (registry *registry) ReminderDevices(ctx context.Context, reminder *domain.Reminder) ([]*domain.Device, error) {
+	if reminder.DeviceID.Valid {
+		device, err := registry.deviceRepo.GetDevice(ctx, reminder.DeviceID.UUID)
+		if err == nil {
+			return []*domain.Device{device}, nil
+		}
+		if !errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("failed to GetDevice: %w", err)
+		}
+
+		registry.logger.Warn("reminder device not found, lighting all the lamps of the user", map[string]interface{}{
+			"reminder_id": reminder.ID,
+			"device_id":   reminder.DeviceID.UUID,
+		})
+	}
+
+	devices, err := registry.deviceRepo.GetDevices(ctx, reminder.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to GetDevices: %w", err)
+	}
+
+	if len(devices) == 0 {
+		return []*domain.Device{registry.defaultDevice(reminder.UserID)}, nil
+	}
+
+	if reminder.DeviceGroup == "" {
+		return devices, nil
+	}
+
+	groupDevices := make([]*domain.Device, 0, len(devices))
+	for _, device := range devices {
+		if device.Group == reminder.DeviceGroup {
+			groupDevices = append(groupDevices, device)
+		}
+	}
+
+	if len(groupDevices) == 0 {
+		registry.logger.Warn("reminder device group is empty, lighting all the lamps of the user", map[string]interface{}{
+			"reminder_id":  reminder.ID,
+			"device_group": reminder.DeviceGroup,
+		})
+		return devices, nil
+	}
+
+	return groupDevices, nil
+}
+
+func (registry *registry) Probe(ctx context.Context, device *domain.Device) error {
+	transport, err := registry.transport(device)
+	if err != nil {
+		return err
+	}
+
+	return transport.Probe(ctx, device)
+}
+
+// transport returns the transport of the device protocol. Lamps registered before the protocols were added use HTTP.
+func (registry *registry) transport(device *domain.Device) (Transport, error) {
+	protocol := device.Protocol
+	if protocol == "" {
+		protocol = domain.HTTPProtocol
+	}
+
+	transport, ok := registry.transports[protocol]
+	if !ok {
+		return nil, fmt.Errorf("unsupported protocol %q of lamp %s", protocol, device.Name)
+	}
+
+	return transport, nil
+}
+
+func (registry *registry) defaultDevice(userID int64) *domain.Device {
+	return &domain.Device{
+		UserID:       userID,
+		Name:         domain.DefaultDeviceName,
+		Host:         registry.cfg.DefaultHost,
+		Protocol:     domain.HTTPProtocol,
+		Capabilities: registry.cfg.DefaultCapabilities,
+	}
+}
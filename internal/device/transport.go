@@ -0,0 +1,95 @@
+package device
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/glow_reminder/client"
+	"github.com/almostinf/glow-reminder/pkg/glow_reminder/client/operations"
+	"github.com/almostinf/glow-reminder/pkg/glow_reminder/models"
+	"github.com/go-openapi/strfmt"
+)
+
+var _ Transport = (*httpTransport)(nil)
+
+// Transport delivers the glow API commands to the lamp. The transport of a lamp is chosen by its protocol.
+type Transport interface {
+	GlowReminder(device *domain.Device, body *models.GlowReminder) error
+	GlowReminderV2(device *domain.Device, body *models.GlowReminderV2) error
+	StopGlow(device *domain.Device) error
+	// Probe checks that the lamp is up.
+	Probe(ctx context.Context, device *domain.Device) error
+}
+
+// httpTransport calls the glow API served by the lamp in the local network.
+type httpTransport struct {
+	formats strfmt.Registry
+
+	mu sync.Mutex
+	// clients are cached by host, since a transport keeps a pool of connections to the lamp.
+	clients map[string]operations.ClientService
+}
+
+func newHTTPTransport(formats strfmt.Registry) *httpTransport {
+	return &httpTransport{
+		formats: formats,
+		clients: make(map[string]operations.ClientService),
+	}
+}
+
+func (transport *httpTransport) GlowReminder(device *domain.Device, body *models.GlowReminder) error {
+	if _, err := transport.client(device).GlowReminder(&operations.GlowReminderParams{
+		Body: body,
+	}); err != nil {
+		return fmt.Errorf("failed to GlowReminder: %w", err)
+	}
+
+	return nil
+}
+
+func (transport *httpTransport) GlowReminderV2(device *domain.Device, body *models.GlowReminderV2) error {
+	if _, err := transport.client(device).GlowReminderV2(&operations.GlowReminderV2Params{
+		Body: body,
+	}); err != nil {
+		return fmt.Errorf("failed to GlowReminderV2: %w", err)
+	}
+
+	return nil
+}
+
+func (transport *httpTransport) StopGlow(device *domain.Device) error {
+	if _, err := transport.client(device).StopGlow(&operations.StopGlowParams{}); err != nil {
+		return fmt.Errorf("failed to StopGlow: %w", err)
+	}
+
+	return nil
+}
+
+func (transport *httpTransport) Probe(ctx context.Context, device *domain.Device) error {
+	if _, err := transport.client(device).Health(operations.NewHealthParamsWithContext(ctx)); err != nil {
+		return fmt.Errorf("failed to Health: %w", err)
+	}
+
+	return nil
+}
+
+// client returns the glow API client of the device.
+func (transport *httpTransport) client(device *domain.Device) operations.ClientService {
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+
+	if glowReminderClient, ok := transport.clients[device.Host]; ok {
+		return glowReminderClient
+	}
+
+	glowReminderClient := client.NewHTTPClientWithConfig(transport.formats, &client.TransportConfig{
+		Host:    device.Host,
+		Schemes: []string{string(domain.HTTPProtocol)},
+	}).Operations
+
+	transport.clients[device.Host] = glowReminderClient
+
+	return glowReminderClient
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RGB is a 24-bit colour 0xRRGGBB.
+type RGB uint32
+
+const (
+	NoRGB    RGB = 0
+	RedRGB   RGB = 0xFF0000
+	GreenRGB RGB = 0x00FF00
+	BlueRGB  RGB = 0x0000FF
+)
+
+const (
+	MinBrightness int8 = 1
+	MaxBrightness int8 = 100
+)
+
+var (
+	ErrInvalidRGB        = errors.New("invalid rgb colour")
+	ErrInvalidBrightness = errors.New("invalid brightness")
+)
+
+// ParseRGB parses a "#RRGGBB" or "RRGGBB" colour. Black is rejected, since it does not light the lamp.
+func ParseRGB(s string) (RGB, error) {
+	hex := strings.TrimPrefix(strings.TrimSpace(s), "#")
+	if len(hex) != 6 {
+		return NoRGB, fmt.Errorf("%w: %q is not in #RRGGBB format", ErrInvalidRGB, s)
+	}
+
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return NoRGB, fmt.Errorf("%w: %q is not in #RRGGBB format", ErrInvalidRGB, s)
+	}
+
+	if value == 0 {
+		return NoRGB, fmt.Errorf("%w: black does not light the lamp", ErrInvalidRGB)
+	}
+
+	return RGB(value), nil
+}
+
+// ValidateBrightness checks that the brightness is a percentage the lamp can show.
+func ValidateBrightness(brightness int8) error {
+	if brightness < MinBrightness || brightness > MaxBrightness {
+		return fmt.Errorf("%w: %d is not in [%d, %d]", ErrInvalidBrightness, brightness, MinBrightness, MaxBrightness)
+	}
+
+	return nil
+}
+
+// Hex returns the colour in "#RRGGBB" format.
+func (rgb RGB) Hex() string {
+	return fmt.Sprintf("#%06X", uint32(rgb))
+}
+
+func (rgb RGB) Red() uint8 {
+	return uint8(rgb >> 16)
+}
+
+func (rgb RGB) Green() uint8 {
+	return uint8(rgb >> 8)
+}
+
+func (rgb RGB) Blue() uint8 {
+	return uint8(rgb)
+}
+
+// LegacyColour maps the colour to one of the three colours of legacy devices by its dominant channel.
+// On a tie red wins over green and green wins over blue.
+func (rgb RGB) LegacyColour() Colour {
+	red, green, blue := rgb.Red(), rgb.Green(), rgb.Blue()
+
+	switch {
+	case rgb == NoRGB:
+		return UnknownColour
+	case red >= green && red >= blue:
+		return Red
+	case green >= blue:
+		return Green
+	default:
+		return Blue
+	}
+}
+
+// RGB returns the colour of the legacy colour.
+func (colour Colour) RGB() RGB {
+	switch colour {
+	case Red:
+		return RedRGB
+	case Green:
+		return GreenRGB
+	case Blue:
+		return BlueRGB
+	default:
+		return NoRGB
+	}
+}
@@ -0,0 +1,119 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRGB(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		input       string
+		expectedRGB domain.RGB
+		expectedErr error
+	}{
+		{
+			name:        "with hash",
+			input:       "#FF8800",
+			expectedRGB: 0xFF8800,
+		},
+		{
+			name:        "without hash, lower case and spaces",
+			input:       " 00ff7f ",
+			expectedRGB: 0x00FF7F,
+		},
+		{
+			name:        "too short",
+			input:       "#FFF",
+			expectedErr: domain.ErrInvalidRGB,
+		},
+		{
+			name:        "not hex",
+			input:       "#GGGGGG",
+			expectedErr: domain.ErrInvalidRGB,
+		},
+		{
+			name:        "black",
+			input:       "#000000",
+			expectedErr: domain.ErrInvalidRGB,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			rgb, err := domain.ParseRGB(testcase.input)
+
+			if testcase.expectedErr != nil {
+				assert.ErrorIs(t, err, testcase.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testcase.expectedRGB, rgb)
+			}
+		})
+	}
+}
+
+func TestRGBLegacyColour(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name           string
+		rgb            domain.RGB
+		expectedColour domain.Colour
+	}{
+		{
+			name:           "red",
+			rgb:            domain.RedRGB,
+			expectedColour: domain.Red,
+		},
+		{
+			name:           "orange is red",
+			rgb:            0xFF8800,
+			expectedColour: domain.Red,
+		},
+		{
+			name:           "spring green is green",
+			rgb:            0x00FF7F,
+			expectedColour: domain.Green,
+		},
+		{
+			name:           "violet is blue",
+			rgb:            0x8000FF,
+			expectedColour: domain.Blue,
+		},
+		{
+			name:           "white is red on a tie",
+			rgb:            0xFFFFFF,
+			expectedColour: domain.Red,
+		},
+		{
+			name:           "cyan is green on a tie",
+			rgb:            0x00FFFF,
+			expectedColour: domain.Green,
+		},
+		{
+			name:           "no colour",
+			rgb:            domain.NoRGB,
+			expectedColour: domain.UnknownColour,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expectedColour, testcase.rgb.LegacyColour())
+		})
+	}
+}
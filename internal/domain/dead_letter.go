@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeadLetter is a reminder occurrence that could not be delivered to the lamp
+// after all the retries or a recurring reminder whose rule can no longer be parsed.
+// It keeps a copy of the reminder, since a one-off reminder is deleted once it is given up.
+type DeadLetter struct {
+	ID          int64      `db:"id"`
+	ReminderID  uuid.UUID  `db:"reminder_id"`
+	UserID      int64      `db:"user_id"`
+	Msg         string     `db:"msg"`
+	Colour      Colour     `db:"colour"`
+	Mode        Mode       `db:"mode"`
+	Recurrence  Recurrence `db:"recurrence"`
+	ScheduledAt time.Time  `db:"scheduled_at"`
+	Attempts    int64      `db:"attempts"`
+	LastError   string     `db:"last_error"`
+	CreatedAt   time.Time  `db:"created_at"`
+}
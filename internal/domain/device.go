@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultDeviceName is the name of the lamp from the config, which lights the reminders of the users without lamps.
+const DefaultDeviceName = "default"
+
+var (
+	ErrInvalidDeviceName = errors.New("invalid device name")
+
+	deviceNameRegexp = regexp.MustCompile(`^[\p{L}\p{N}_-]{1,32}$`)
+)
+
+type DeviceProtocol string
+
+const (
+	HTTPProtocol DeviceProtocol = "http"
+	// MQTTProtocol is for the lamps behind NAT, which receive the commands through the MQTT broker.
+	// The host of such a lamp is its id in the topics.
+	MQTTProtocol DeviceProtocol = "mqtt"
+)
+
+// DeviceCapabilities is a set of features supported by the lamp firmware.
+type DeviceCapabilities int16
+
+const (
+	// RGBCapability is set for lamps supporting any RGB colour and brightness,
+	// the others only glow red, green or blue at full brightness.
+	RGBCapability DeviceCapabilities = 1 << iota
+	// EffectsCapability is set for lamps playing effects, the others only support static and blinking light.
+	EffectsCapability
+
+	AllCapabilities = RGBCapability | EffectsCapability
+)
+
+func (capabilities DeviceCapabilities) Has(capability DeviceCapabilities) bool {
+	return capabilities&capability == capability
+}
+
+// Device is a lamp registered by the user. Lamps can be grouped to light them with one reminder.
+type Device struct {
+	ID           uuid.UUID          `db:"id"`
+	UserID       int64              `db:"user_id"`
+	Name         string             `db:"name"`
+	Host         string             `db:"host"`
+	Protocol     DeviceProtocol     `db:"protocol"`
+	Capabilities DeviceCapabilities `db:"capabilities"`
+	Group        string             `db:"group_name"`
+	CreatedAt    time.Time          `db:"created_at"`
+	UpdatedAt    time.Time          `db:"updated_at"`
+}
+
+// ValidateDeviceName checks that the name is a single word, so it can be used in the bot commands.
+// Group names follow the same rules.
+func ValidateDeviceName(name string) error {
+	if !deviceNameRegexp.MatchString(name) {
+		return fmt.Errorf("%w: %q must be up to 32 letters, digits, '_' or '-'", ErrInvalidDeviceName, name)
+	}
+
+	return nil
+}
+
+// AcknowledgesCommands reports whether a command to the lamp succeeds only once the lamp has confirmed it is lit.
+// HTTP lamps reply before lighting and confirm the delivery later with the callback.
+func (device *Device) AcknowledgesCommands() bool {
+	return device.Protocol == MQTTProtocol
+}
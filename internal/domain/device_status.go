@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeviceStatus is the result of the health checks of a lamp.
+type DeviceStatus struct {
+	DeviceID uuid.UUID `db:"device_id"`
+	Online   bool      `db:"online"`
+	// Failures is the number of the failed health checks in a row.
+	Failures  int64  `db:"failures"`
+	LastError string `db:"last_error"`
+	// LastSeen is not set for a lamp that has never been reachable.
+	LastSeen  *time.Time `db:"last_seen"`
+	CheckedAt time.Time  `db:"checked_at"`
+	// ChangedAt is when the lamp went online or offline.
+	ChangedAt time.Time `db:"changed_at"`
+}
+
+// NewDeviceStatus returns the status of a lamp that has not been checked yet, it is assumed to be online.
+func NewDeviceStatus(deviceID uuid.UUID, now time.Time) *DeviceStatus {
+	return &DeviceStatus{
+		DeviceID:  deviceID,
+		Online:    true,
+		ChangedAt: now,
+	}
+}
+
+// Observe records the result of a health check made at now and reports whether the lamp went online or offline.
+// The lamp goes offline after threshold failed checks in a row and comes back on the first successful one.
+func (status *DeviceStatus) Observe(now time.Time, probeErr error, threshold int64) bool {
+	status.CheckedAt = now
+
+	online := status.Online
+	if probeErr == nil {
+		status.Failures = 0
+		status.LastError = ""
+		status.LastSeen = &now
+		online = true
+	} else {
+		status.Failures++
+		status.LastError = probeErr.Error()
+		if status.Failures >= threshold {
+			online = false
+		}
+	}
+
+	if online == status.Online {
+		return false
+	}
+
+	status.Online = online
+	status.ChangedAt = now
+
+	return true
+}
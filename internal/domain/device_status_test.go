@@ -0,0 +1,107 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeviceStatusObserve(t *testing.T) {
+	t.Parallel()
+
+	var (
+		checkedAt = time.Date(2025, 4, 25, 12, 0, 0, 0, time.UTC)
+		now       = checkedAt.Add(time.Minute)
+		errProbe  = errors.New("connection refused")
+	)
+
+	testcases := []struct {
+		name             string
+		status           domain.DeviceStatus
+		probeErr         error
+		expectedChanged  bool
+		expectedOnline   bool
+		expectedFailures int64
+	}{
+		{
+			name:            "online lamp answers",
+			status:          domain.DeviceStatus{Online: true, ChangedAt: checkedAt},
+			expectedChanged: false,
+			expectedOnline:  true,
+		},
+		{
+			name:             "online lamp fails below the threshold",
+			status:           domain.DeviceStatus{Online: true, ChangedAt: checkedAt},
+			probeErr:         errProbe,
+			expectedChanged:  false,
+			expectedOnline:   true,
+			expectedFailures: 1,
+		},
+		{
+			name:             "online lamp reaches the threshold",
+			status:           domain.DeviceStatus{Online: true, Failures: 1, ChangedAt: checkedAt},
+			probeErr:         errProbe,
+			expectedChanged:  true,
+			expectedOnline:   false,
+			expectedFailures: 2,
+		},
+		{
+			name:             "offline lamp keeps failing",
+			status:           domain.DeviceStatus{Online: false, Failures: 2, ChangedAt: checkedAt},
+			probeErr:         errProbe,
+			expectedChanged:  false,
+			expectedOnline:   false,
+			expectedFailures: 3,
+		},
+		{
+			name:            "offline lamp comes back",
+			status:          domain.DeviceStatus{Online: false, Failures: 3, LastError: "timeout", ChangedAt: checkedAt},
+			expectedChanged: true,
+			expectedOnline:  true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			status := testcase.status
+			changed := status.Observe(now, testcase.probeErr, 2)
+
+			assert.Equal(t, testcase.expectedChanged, changed)
+			assert.Equal(t, testcase.expectedOnline, status.Online)
+			assert.Equal(t, testcase.expectedFailures, status.Failures)
+			assert.Equal(t, now, status.CheckedAt)
+
+			if testcase.expectedChanged {
+				assert.Equal(t, now, status.ChangedAt)
+			} else {
+				assert.Equal(t, checkedAt, status.ChangedAt)
+			}
+
+			if testcase.probeErr == nil {
+				assert.Empty(t, status.LastError)
+				assert.Equal(t, &now, status.LastSeen)
+			} else {
+				assert.Equal(t, testcase.probeErr.Error(), status.LastError)
+			}
+		})
+	}
+
+	t.Run("new lamp starts online", func(t *testing.T) {
+		t.Parallel()
+
+		deviceID := uuid.New()
+		status := domain.NewDeviceStatus(deviceID, now)
+
+		assert.Equal(t, deviceID, status.DeviceID)
+		assert.True(t, status.Online)
+		assert.Nil(t, status.LastSeen)
+	})
+}
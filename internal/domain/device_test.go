@@ -0,0 +1,104 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDeviceName(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		input       string
+		expectedErr error
+	}{
+		{
+			name:  "latin",
+			input: "kitchen-lamp_2",
+		},
+		{
+			name:  "cyrillic",
+			input: "кухня",
+		},
+		{
+			name:        "empty",
+			input:       "",
+			expectedErr: domain.ErrInvalidDeviceName,
+		},
+		{
+			name:        "with space",
+			input:       "kitchen lamp",
+			expectedErr: domain.ErrInvalidDeviceName,
+		},
+		{
+			name:        "too long",
+			input:       "a_very_long_lamp_name_that_does_not_fit",
+			expectedErr: domain.ErrInvalidDeviceName,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := domain.ValidateDeviceName(testcase.input)
+
+			if testcase.expectedErr != nil {
+				assert.ErrorIs(t, err, testcase.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeviceCapabilitiesHas(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name         string
+		capabilities domain.DeviceCapabilities
+		capability   domain.DeviceCapabilities
+		expected     bool
+	}{
+		{
+			name:         "all has effects",
+			capabilities: domain.AllCapabilities,
+			capability:   domain.EffectsCapability,
+			expected:     true,
+		},
+		{
+			name:         "rgb only has no effects",
+			capabilities: domain.RGBCapability,
+			capability:   domain.EffectsCapability,
+			expected:     false,
+		},
+		{
+			name:         "rgb only has no all",
+			capabilities: domain.RGBCapability,
+			capability:   domain.AllCapabilities,
+			expected:     false,
+		},
+		{
+			name:         "legacy has no rgb",
+			capabilities: 0,
+			capability:   domain.RGBCapability,
+			expected:     false,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expected, testcase.capabilities.Has(testcase.capability))
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type EffectKind string
+
+const (
+	StaticEffect    EffectKind = "static"
+	BlinkingEffect  EffectKind = "blinking"
+	BreathingEffect EffectKind = "breathing"
+	PulseEffect     EffectKind = "pulse"
+	RainbowEffect   EffectKind = "rainbow"
+	StrobeEffect    EffectKind = "strobe"
+	SunriseEffect   EffectKind = "sunrise"
+	KeyframesEffect EffectKind = "keyframes"
+)
+
+const (
+	MinEffectPeriod      = 50 * time.Millisecond
+	MaxEffectPeriod      = time.Minute
+	MaxEffectDuration    = 30 * time.Minute
+	MaxEffectRepeat      = 100
+	MaxKeyframes         = 16
+	MaxKeyframeTransient = time.Minute
+)
+
+var ErrInvalidEffect = errors.New("invalid effect")
+
+// Keyframe is a point of a custom effect: the lamp changes smoothly
+// from the previous keyframe to this one during Duration.
+type Keyframe struct {
+	RGB        RGB           `json:"rgb"`
+	Brightness int8          `json:"brightness"`
+	Duration   time.Duration `json:"duration"`
+}
+
+// Effect describes how the lamp glows. Period is the length of one cycle of a periodic effect
+// and Repeat is the number of cycles. Static and sunrise effects last for Duration instead.
+// A keyframes effect plays its keyframes Repeat times.
+type Effect struct {
+	Kind      EffectKind    `json:"kind"`
+	Period    time.Duration `json:"period,omitempty"`
+	Duration  time.Duration `json:"duration,omitempty"`
+	Repeat    int           `json:"repeat,omitempty"`
+	Keyframes []Keyframe    `json:"keyframes,omitempty"`
+}
+
+// DefaultEffect returns the effect of the given kind with the parameters offered in the bot.
+func DefaultEffect(kind EffectKind) Effect {
+	switch kind {
+	case StaticEffect:
+		return Effect{Kind: kind, Duration: 10 * time.Second}
+	case BlinkingEffect:
+		return Effect{Kind: kind, Period: time.Second, Repeat: 10}
+	case BreathingEffect:
+		return Effect{Kind: kind, Period: 4 * time.Second, Repeat: 5}
+	case PulseEffect:
+		return Effect{Kind: kind, Period: time.Second, Repeat: 10}
+	case RainbowEffect:
+		return Effect{Kind: kind, Period: 6 * time.Second, Repeat: 3}
+	case StrobeEffect:
+		return Effect{Kind: kind, Period: 100 * time.Millisecond, Repeat: 50}
+	case SunriseEffect:
+		return Effect{Kind: kind, Duration: time.Minute}
+	default:
+		return Effect{Kind: kind}
+	}
+}
+
+// EffectFromMode returns the effect the legacy mode used to be played with.
+func EffectFromMode(mode Mode) Effect {
+	if mode == Blinking {
+		return DefaultEffect(BlinkingEffect)
+	}
+
+	return DefaultEffect(StaticEffect)
+}
+
+// Validate checks that the lamp is able to play the effect.
+func (effect Effect) Validate() error {
+	switch effect.Kind {
+	case StaticEffect, SunriseEffect:
+		if effect.Duration <= 0 || effect.Duration > MaxEffectDuration {
+			return fmt.Errorf("%w: duration must be in (0, %s]", ErrInvalidEffect, MaxEffectDuration)
+		}
+	case BlinkingEffect, BreathingEffect, PulseEffect, RainbowEffect, StrobeEffect:
+		if effect.Period < MinEffectPeriod || effect.Period > MaxEffectPeriod {
+			return fmt.Errorf("%w: period must be in [%s, %s]", ErrInvalidEffect, MinEffectPeriod, MaxEffectPeriod)
+		}
+		if err := validateRepeat(effect.Repeat); err != nil {
+			return err
+		}
+	case KeyframesEffect:
+		if err := validateKeyframes(effect.Keyframes); err != nil {
+			return err
+		}
+		if err := validateRepeat(effect.Repeat); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidEffect, effect.Kind)
+	}
+
+	if effect.TotalDuration() > MaxEffectDuration {
+		return fmt.Errorf("%w: the effect must not last longer than %s", ErrInvalidEffect, MaxEffectDuration)
+	}
+
+	return nil
+}
+
+func validateRepeat(repeat int) error {
+	if repeat < 1 || repeat > MaxEffectRepeat {
+		return fmt.Errorf("%w: repeat count must be in [1, %d]", ErrInvalidEffect, MaxEffectRepeat)
+	}
+
+	return nil
+}
+
+func validateKeyframes(keyframes []Keyframe) error {
+	if len(keyframes) == 0 || len(keyframes) > MaxKeyframes {
+		return fmt.Errorf("%w: there must be from 1 to %d keyframes", ErrInvalidEffect, MaxKeyframes)
+	}
+
+	var cycle time.Duration
+	for i, keyframe := range keyframes {
+		cycle += keyframe.Duration
+
+		if keyframe.RGB > 0xFFFFFF {
+			return fmt.Errorf("%w: keyframe %d: colour out of range", ErrInvalidEffect, i+1)
+		}
+		if keyframe.Brightness < 0 || keyframe.Brightness > MaxBrightness {
+			return fmt.Errorf("%w: keyframe %d: brightness must be in [0, %d]", ErrInvalidEffect, i+1, MaxBrightness)
+		}
+		if keyframe.Duration < 0 || keyframe.Duration > MaxKeyframeTransient {
+			return fmt.Errorf("%w: keyframe %d: duration must be in [0, %s]", ErrInvalidEffect, i+1, MaxKeyframeTransient)
+		}
+	}
+
+	if cycle == 0 {
+		return fmt.Errorf("%w: keyframes must last longer than zero", ErrInvalidEffect)
+	}
+
+	return nil
+}
+
+// TotalDuration returns how long the lamp glows.
+func (effect Effect) TotalDuration() time.Duration {
+	switch effect.Kind {
+	case StaticEffect, SunriseEffect:
+		return effect.Duration
+	case KeyframesEffect:
+		var cycle time.Duration
+		for _, keyframe := range effect.Keyframes {
+			cycle += keyframe.Duration
+		}
+		return cycle * time.Duration(effect.Repeat)
+	default:
+		return effect.Period * time.Duration(effect.Repeat)
+	}
+}
+
+// LegacyMode maps the effect to one of the modes of legacy devices:
+// blinking for the flashing effects and static for the others.
+func (effect Effect) LegacyMode() Mode {
+	switch effect.Kind {
+	case BlinkingEffect, PulseEffect, StrobeEffect:
+		return Blinking
+	default:
+		return Static
+	}
+}
+
+// ParseKeyframes parses a custom effect written as comma separated keyframes
+// "<#RRGGBB> [<brightness>%] <duration>" with an optional trailing "x<repeat>",
+// e.g. "#FF0000 1s, #0000FF 50% 500ms x3". The brightness is 100% by default.
+func ParseKeyframes(s string) (Effect, error) {
+	effect := Effect{Kind: KeyframesEffect, Repeat: 1}
+
+	parts := strings.Split(s, ",")
+	for i, part := range parts {
+		fields := strings.Fields(part)
+
+		// The repeat count ends the last keyframe.
+		if i == len(parts)-1 && len(fields) != 0 {
+			if last := fields[len(fields)-1]; strings.HasPrefix(last, "x") {
+				repeat, err := strconv.Atoi(last[1:])
+				if err != nil {
+					return Effect{}, fmt.Errorf("%w: invalid repeat count %q", ErrInvalidEffect, last)
+				}
+				effect.Repeat = repeat
+				fields = fields[:len(fields)-1]
+			}
+		}
+
+		keyframe, err := parseKeyframe(fields)
+		if err != nil {
+			return Effect{}, fmt.Errorf("keyframe %d: %w", i+1, err)
+		}
+
+		effect.Keyframes = append(effect.Keyframes, keyframe)
+	}
+
+	if err := effect.Validate(); err != nil {
+		return Effect{}, err
+	}
+
+	return effect, nil
+}
+
+func parseKeyframe(fields []string) (Keyframe, error) {
+	if len(fields) != 2 && len(fields) != 3 {
+		return Keyframe{}, fmt.Errorf("%w: expected '<#RRGGBB> [<brightness>%%] <duration>'", ErrInvalidEffect)
+	}
+
+	rgb, err := ParseRGB(fields[0])
+	if err != nil {
+		return Keyframe{}, err
+	}
+
+	keyframe := Keyframe{
+		RGB:        rgb,
+		Brightness: MaxBrightness,
+	}
+
+	if len(fields) == 3 {
+		brightness, err := strconv.ParseInt(strings.TrimSuffix(fields[1], "%"), 10, 8)
+		if err != nil {
+			return Keyframe{}, fmt.Errorf("%w: invalid brightness %q", ErrInvalidEffect, fields[1])
+		}
+		keyframe.Brightness = int8(brightness)
+	}
+
+	keyframe.Duration, err = time.ParseDuration(fields[len(fields)-1])
+	if err != nil {
+		return Keyframe{}, fmt.Errorf("%w: invalid duration %q", ErrInvalidEffect, fields[len(fields)-1])
+	}
+
+	return keyframe, nil
+}
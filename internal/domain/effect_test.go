@@ -0,0 +1,174 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEffectValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		effect      domain.Effect
+		expectedErr error
+	}{
+		{
+			name:   "default breathing",
+			effect: domain.DefaultEffect(domain.BreathingEffect),
+		},
+		{
+			name:   "default sunrise",
+			effect: domain.DefaultEffect(domain.SunriseEffect),
+		},
+		{
+			name:        "unknown kind",
+			effect:      domain.Effect{Kind: "disco"},
+			expectedErr: domain.ErrInvalidEffect,
+		},
+		{
+			name:        "static without duration",
+			effect:      domain.Effect{Kind: domain.StaticEffect},
+			expectedErr: domain.ErrInvalidEffect,
+		},
+		{
+			name:        "strobe too fast",
+			effect:      domain.Effect{Kind: domain.StrobeEffect, Period: 10 * time.Millisecond, Repeat: 10},
+			expectedErr: domain.ErrInvalidEffect,
+		},
+		{
+			name:        "pulse without repeat",
+			effect:      domain.Effect{Kind: domain.PulseEffect, Period: time.Second},
+			expectedErr: domain.ErrInvalidEffect,
+		},
+		{
+			name:        "rainbow too long",
+			effect:      domain.Effect{Kind: domain.RainbowEffect, Period: time.Minute, Repeat: 31},
+			expectedErr: domain.ErrInvalidEffect,
+		},
+		{
+			name: "keyframes",
+			effect: domain.Effect{
+				Kind:   domain.KeyframesEffect,
+				Repeat: 2,
+				Keyframes: []domain.Keyframe{
+					{RGB: domain.RedRGB, Brightness: 100, Duration: time.Second},
+					{RGB: domain.BlueRGB, Brightness: 0, Duration: 0},
+				},
+			},
+		},
+		{
+			name:        "no keyframes",
+			effect:      domain.Effect{Kind: domain.KeyframesEffect, Repeat: 1},
+			expectedErr: domain.ErrInvalidEffect,
+		},
+		{
+			name: "keyframes without duration",
+			effect: domain.Effect{
+				Kind:      domain.KeyframesEffect,
+				Repeat:    1,
+				Keyframes: []domain.Keyframe{{RGB: domain.RedRGB, Brightness: 100}},
+			},
+			expectedErr: domain.ErrInvalidEffect,
+		},
+		{
+			name: "keyframe brightness out of range",
+			effect: domain.Effect{
+				Kind:      domain.KeyframesEffect,
+				Repeat:    1,
+				Keyframes: []domain.Keyframe{{RGB: domain.RedRGB, Brightness: 101, Duration: time.Second}},
+			},
+			expectedErr: domain.ErrInvalidEffect,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testcase.effect.Validate()
+
+			if testcase.expectedErr != nil {
+				assert.ErrorIs(t, err, testcase.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseKeyframes(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name           string
+		input          string
+		expectedEffect domain.Effect
+		expectedErr    error
+	}{
+		{
+			name:  "single keyframe",
+			input: "#FF0000 1s",
+			expectedEffect: domain.Effect{
+				Kind:      domain.KeyframesEffect,
+				Repeat:    1,
+				Keyframes: []domain.Keyframe{{RGB: domain.RedRGB, Brightness: 100, Duration: time.Second}},
+			},
+		},
+		{
+			name:  "brightness and repeat",
+			input: "#FF0000 1s, 0000ff 50% 500ms x3",
+			expectedEffect: domain.Effect{
+				Kind:   domain.KeyframesEffect,
+				Repeat: 3,
+				Keyframes: []domain.Keyframe{
+					{RGB: domain.RedRGB, Brightness: 100, Duration: time.Second},
+					{RGB: domain.BlueRGB, Brightness: 50, Duration: 500 * time.Millisecond},
+				},
+			},
+		},
+		{
+			name:        "invalid colour",
+			input:       "red 1s",
+			expectedErr: domain.ErrInvalidRGB,
+		},
+		{
+			name:        "missing duration",
+			input:       "#FF0000",
+			expectedErr: domain.ErrInvalidEffect,
+		},
+		{
+			name:        "invalid repeat",
+			input:       "#FF0000 1s xmany",
+			expectedErr: domain.ErrInvalidEffect,
+		},
+		{
+			name:        "too many repeats",
+			input:       "#FF0000 1s x1000",
+			expectedErr: domain.ErrInvalidEffect,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			effect, err := domain.ParseKeyframes(testcase.input)
+
+			if testcase.expectedErr != nil {
+				assert.ErrorIs(t, err, testcase.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testcase.expectedEffect, effect)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "time"
+
+// FireEvent is published when a reminder has been delivered to its owner.
+type FireEvent struct {
+	Reminder Reminder
+	FiredAt  time.Time
+	// Snoozed is set if the reminder fires again after it has been snoozed.
+	Snoozed bool
+}
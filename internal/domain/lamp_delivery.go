@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidDeliveryTransition = errors.New("invalid delivery transition")
+
+// DeliveryStatus is a state of a LampDelivery. A delivery is queued when the reminder fires and stays
+// queued while the lamp cannot be reached. It is sent once the lamp has accepted the command and
+// acknowledged once the lamp has confirmed that it is lit. It is completed when the owner marks
+// the reminder as done and failed when the lamp reports an error or the retries are exhausted.
+type DeliveryStatus string
+
+const (
+	DeliveryQueued       DeliveryStatus = "queued"
+	DeliverySent         DeliveryStatus = "sent"
+	DeliveryAcknowledged DeliveryStatus = "acknowledged"
+	DeliveryCompleted    DeliveryStatus = "completed"
+	DeliveryFailed       DeliveryStatus = "failed"
+)
+
+// deliveryTransitions maps a status to the statuses a delivery may move to it from.
+var deliveryTransitions = map[DeliveryStatus][]DeliveryStatus{
+	DeliveryQueued:       {},
+	DeliverySent:         {DeliveryQueued},
+	DeliveryAcknowledged: {DeliveryQueued, DeliverySent},
+	DeliveryCompleted:    {DeliverySent, DeliveryAcknowledged},
+	DeliveryFailed:       {DeliveryQueued, DeliverySent},
+}
+
+// Sources returns the statuses a delivery may move to the status from, including the status itself,
+// so that a repeated transition, e.g. a duplicate ack, is a no-op.
+func (status DeliveryStatus) Sources() []DeliveryStatus {
+	sources, ok := deliveryTransitions[status]
+	if !ok {
+		return nil
+	}
+
+	return append([]DeliveryStatus{status}, sources...)
+}
+
+// CanMoveTo reports whether a delivery in the status may move to the given one.
+func (status DeliveryStatus) CanMoveTo(to DeliveryStatus) bool {
+	for _, source := range to.Sources() {
+		if source == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsFinal reports whether the delivery can no longer change.
+func (status DeliveryStatus) IsFinal() bool {
+	return status == DeliveryCompleted || status == DeliveryFailed
+}
+
+// LampDelivery tracks a fired reminder on one of its lamps. The deliveries of the same firing share FiredAt.
+type LampDelivery struct {
+	ID         uuid.UUID `db:"id"`
+	ReminderID uuid.UUID `db:"reminder_id"`
+	UserID     int64     `db:"user_id"`
+	// DeviceID is not set for the default lamp from the config.
+	DeviceID   uuid.NullUUID  `db:"device_id"`
+	DeviceName string         `db:"device_name"`
+	FiredAt    time.Time      `db:"fired_at"`
+	Status     DeliveryStatus `db:"status"`
+	Attempts   int64          `db:"attempts"`
+	LastError  string         `db:"last_error"`
+	CreatedAt  time.Time      `db:"created_at"`
+	UpdatedAt  time.Time      `db:"updated_at"`
+}
+
+// IsFor reports whether the delivery lights the device.
+func (delivery *LampDelivery) IsFor(device *Device) bool {
+	if !delivery.DeviceID.Valid {
+		return device.ID == uuid.Nil
+	}
+
+	return delivery.DeviceID.UUID == device.ID
+}
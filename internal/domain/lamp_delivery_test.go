@@ -0,0 +1,45 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeliveryStatusCanMoveTo(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		from     domain.DeliveryStatus
+		to       domain.DeliveryStatus
+		expected bool
+	}{
+		{name: "queued to sent", from: domain.DeliveryQueued, to: domain.DeliverySent, expected: true},
+		{name: "queued to acknowledged", from: domain.DeliveryQueued, to: domain.DeliveryAcknowledged, expected: true},
+		{name: "queued to failed", from: domain.DeliveryQueued, to: domain.DeliveryFailed, expected: true},
+		{name: "queued to completed", from: domain.DeliveryQueued, to: domain.DeliveryCompleted, expected: false},
+		{name: "queued again", from: domain.DeliveryQueued, to: domain.DeliveryQueued, expected: true},
+		{name: "sent to acknowledged", from: domain.DeliverySent, to: domain.DeliveryAcknowledged, expected: true},
+		{name: "sent to completed", from: domain.DeliverySent, to: domain.DeliveryCompleted, expected: true},
+		{name: "sent to failed", from: domain.DeliverySent, to: domain.DeliveryFailed, expected: true},
+		{name: "sent back to queued", from: domain.DeliverySent, to: domain.DeliveryQueued, expected: false},
+		{name: "duplicate ack", from: domain.DeliveryAcknowledged, to: domain.DeliveryAcknowledged, expected: true},
+		{name: "acknowledged to completed", from: domain.DeliveryAcknowledged, to: domain.DeliveryCompleted, expected: true},
+		{name: "acknowledged to failed", from: domain.DeliveryAcknowledged, to: domain.DeliveryFailed, expected: false},
+		{name: "completed to acknowledged", from: domain.DeliveryCompleted, to: domain.DeliveryAcknowledged, expected: false},
+		{name: "failed to sent", from: domain.DeliveryFailed, to: domain.DeliverySent, expected: false},
+		{name: "unknown status", from: domain.DeliveryQueued, to: "lost", expected: false},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expected, testcase.from.CanMoveTo(testcase.to))
+		})
+	}
+}
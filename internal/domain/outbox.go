@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OutboxOperation int8
+
+const (
+	UnknownOutboxOperation OutboxOperation = 0
+	UpsertReminderTask     OutboxOperation = 1
+	DeleteReminderTask     OutboxOperation = 2
+)
+
+// OutboxEvent is a change of a reminder that must be projected into the reminder tasks queue.
+// It is written in the same transaction as the reminder itself.
+type OutboxEvent struct {
+	ID          int64           `db:"id"`
+	ReminderID  uuid.UUID       `db:"reminder_id"`
+	Operation   OutboxOperation `db:"operation"`
+	ScheduledAt time.Time       `db:"scheduled_at"`
+	CreatedAt   time.Time       `db:"created_at"`
+}
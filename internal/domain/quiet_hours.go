@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// QuietPolicy defines what happens to a reminder that fires during the quiet hours of its owner.
+type QuietPolicy string
+
+const (
+	// QuietDefer lights the lamp once the quiet hours are over.
+	QuietDefer QuietPolicy = "defer"
+	// QuietDim lights the lamp right away with a dim static glow.
+	QuietDim QuietPolicy = "dim"
+	// QuietSkip does not light the lamp at all.
+	QuietSkip QuietPolicy = "skip"
+
+	DefaultQuietPolicy = QuietDefer
+)
+
+// QuietBrightness is the brightness a reminder is dimmed to during the quiet hours.
+const QuietBrightness int8 = 10
+
+const quietHoursClockFormat = "15:04"
+
+var (
+	ErrInvalidQuietHours  = errors.New("invalid quiet hours")
+	ErrInvalidQuietPolicy = errors.New("invalid quiet policy")
+)
+
+func ParseQuietPolicy(s string) (QuietPolicy, error) {
+	switch policy := QuietPolicy(strings.ToLower(s)); policy {
+	case QuietDefer, QuietDim, QuietSkip:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidQuietPolicy, s)
+	}
+}
+
+// QuietHours is a daily window in the owner time zone, e.g. 22:00-07:00.
+// Start and End are minutes since midnight, the window wraps around midnight if End is before Start.
+type QuietHours struct {
+	Start int
+	End   int
+}
+
+// ParseQuietHours parses a window in the "HH:MM-HH:MM" format.
+func ParseQuietHours(s string) (QuietHours, error) {
+	start, end, ok := strings.Cut(strings.TrimSpace(s), "-")
+	if !ok {
+		return QuietHours{}, fmt.Errorf("%w: %q is not HH:MM-HH:MM", ErrInvalidQuietHours, s)
+	}
+
+	startTime, err := time.Parse(quietHoursClockFormat, strings.TrimSpace(start))
+	if err != nil {
+		return QuietHours{}, fmt.Errorf("%w: %q is not HH:MM-HH:MM", ErrInvalidQuietHours, s)
+	}
+
+	endTime, err := time.Parse(quietHoursClockFormat, strings.TrimSpace(end))
+	if err != nil {
+		return QuietHours{}, fmt.Errorf("%w: %q is not HH:MM-HH:MM", ErrInvalidQuietHours, s)
+	}
+
+	quietHours := QuietHours{
+		Start: startTime.Hour()*60 + startTime.Minute(),
+		End:   endTime.Hour()*60 + endTime.Minute(),
+	}
+	if quietHours.Start == quietHours.End {
+		return QuietHours{}, fmt.Errorf("%w: the window %q is empty", ErrInvalidQuietHours, s)
+	}
+
+	return quietHours, nil
+}
+
+func (quietHours QuietHours) String() string {
+	return fmt.Sprintf("%02d:%02d-%02d:%02d",
+		quietHours.Start/60, quietHours.Start%60, quietHours.End/60, quietHours.End%60)
+}
+
+// Until reports whether t falls within the window and returns the end of the window if so.
+func (quietHours QuietHours) Until(t time.Time) (time.Time, bool) {
+	year, month, day := t.Date()
+	minute := t.Hour()*60 + t.Minute()
+
+	endAt := func(days int) time.Time {
+		return time.Date(year, month, day+days, quietHours.End/60, quietHours.End%60, 0, 0, t.Location())
+	}
+
+	if quietHours.Start < quietHours.End {
+		if minute >= quietHours.Start && minute < quietHours.End {
+			return endAt(0), true
+		}
+		return time.Time{}, false
+	}
+
+	switch {
+	case minute >= quietHours.Start:
+		return endAt(1), true
+	case minute < quietHours.End:
+		return endAt(0), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// Dimmed returns a copy of the reminder that glows static at no more than QuietBrightness.
+func (reminder *Reminder) Dimmed() *Reminder {
+	dimmed := *reminder
+	dimmed.Mode = Static
+	dimmed.Effect = DefaultEffect(StaticEffect)
+	dimmed.Brightness = min(reminder.Brightness, QuietBrightness)
+
+	return &dimmed
+}
@@ -0,0 +1,210 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuietHours(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		s           string
+		expected    domain.QuietHours
+		expectedErr error
+	}{
+		{name: "overnight", s: "22:00-07:00", expected: domain.QuietHours{Start: 22 * 60, End: 7 * 60}},
+		{name: "daytime with spaces", s: " 13:30 - 15:00 ", expected: domain.QuietHours{Start: 13*60 + 30, End: 15 * 60}},
+		{name: "missing end", s: "22:00", expectedErr: domain.ErrInvalidQuietHours},
+		{name: "invalid clock", s: "25:00-07:00", expectedErr: domain.ErrInvalidQuietHours},
+		{name: "empty window", s: "07:00-07:00", expectedErr: domain.ErrInvalidQuietHours},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			quietHours, err := domain.ParseQuietHours(testcase.s)
+			if testcase.expectedErr != nil {
+				assert.ErrorIs(t, err, testcase.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testcase.expected, quietHours)
+		})
+	}
+}
+
+func TestQuietHoursUntil(t *testing.T) {
+	t.Parallel()
+
+	overnight := domain.QuietHours{Start: 22 * 60, End: 7 * 60}
+	daytime := domain.QuietHours{Start: 13 * 60, End: 15 * 60}
+
+	testcases := []struct {
+		name          string
+		quietHours    domain.QuietHours
+		t             time.Time
+		expected      time.Time
+		expectedQuiet bool
+	}{
+		{
+			name:          "overnight before midnight",
+			quietHours:    overnight,
+			t:             time.Date(2025, 5, 1, 23, 30, 0, 0, time.UTC),
+			expected:      time.Date(2025, 5, 2, 7, 0, 0, 0, time.UTC),
+			expectedQuiet: true,
+		},
+		{
+			name:          "overnight after midnight",
+			quietHours:    overnight,
+			t:             time.Date(2025, 5, 2, 3, 0, 0, 0, time.UTC),
+			expected:      time.Date(2025, 5, 2, 7, 0, 0, 0, time.UTC),
+			expectedQuiet: true,
+		},
+		{
+			name:          "overnight at the end",
+			quietHours:    overnight,
+			t:             time.Date(2025, 5, 2, 7, 0, 0, 0, time.UTC),
+			expectedQuiet: false,
+		},
+		{
+			name:          "overnight during the day",
+			quietHours:    overnight,
+			t:             time.Date(2025, 5, 2, 12, 0, 0, 0, time.UTC),
+			expectedQuiet: false,
+		},
+		{
+			name:          "daytime inside",
+			quietHours:    daytime,
+			t:             time.Date(2025, 5, 2, 14, 0, 0, 0, time.UTC),
+			expected:      time.Date(2025, 5, 2, 15, 0, 0, 0, time.UTC),
+			expectedQuiet: true,
+		},
+		{
+			name:          "daytime outside",
+			quietHours:    daytime,
+			t:             time.Date(2025, 5, 2, 22, 0, 0, 0, time.UTC),
+			expectedQuiet: false,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			until, quiet := testcase.quietHours.Until(testcase.t)
+
+			assert.Equal(t, testcase.expectedQuiet, quiet)
+			assert.Equal(t, testcase.expected, until)
+		})
+	}
+}
+
+func TestUserQuietUntil(t *testing.T) {
+	t.Parallel()
+
+	// 03:00 in Moscow.
+	now := time.Date(2025, 5, 2, 0, 0, 0, 0, time.UTC)
+	dndUntil := func(d time.Duration) *time.Time {
+		until := now.Add(d)
+		return &until
+	}
+
+	testcases := []struct {
+		name          string
+		user          domain.User
+		expected      time.Time
+		expectedQuiet bool
+	}{
+		{
+			name:          "nothing set",
+			user:          domain.User{TimeZone: "Europe/Moscow"},
+			expectedQuiet: false,
+		},
+		{
+			name:          "quiet hours in the user time zone",
+			user:          domain.User{TimeZone: "Europe/Moscow", QuietHours: "22:00-07:00"},
+			expected:      time.Date(2025, 5, 2, 4, 0, 0, 0, time.UTC),
+			expectedQuiet: true,
+		},
+		{
+			name:          "quiet hours over",
+			user:          domain.User{TimeZone: "Europe/Moscow", QuietHours: "01:00-02:00"},
+			expectedQuiet: false,
+		},
+		{
+			name:          "do not disturb",
+			user:          domain.User{TimeZone: "Europe/Moscow", DNDUntil: dndUntil(2 * time.Hour)},
+			expected:      now.Add(2 * time.Hour),
+			expectedQuiet: true,
+		},
+		{
+			name:          "do not disturb is over",
+			user:          domain.User{TimeZone: "Europe/Moscow", DNDUntil: dndUntil(-time.Hour)},
+			expectedQuiet: false,
+		},
+		{
+			name: "do not disturb extended by quiet hours",
+			user: domain.User{
+				TimeZone:   "Europe/Moscow",
+				QuietHours: "04:00-08:00",
+				DNDUntil:   dndUntil(2 * time.Hour),
+			},
+			expected:      time.Date(2025, 5, 2, 5, 0, 0, 0, time.UTC),
+			expectedQuiet: true,
+		},
+		{
+			name: "quiet hours extended by do not disturb",
+			user: domain.User{
+				TimeZone:   "Europe/Moscow",
+				QuietHours: "22:00-07:00",
+				DNDUntil:   dndUntil(6 * time.Hour),
+			},
+			expected:      now.Add(6 * time.Hour),
+			expectedQuiet: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			until, quiet := testcase.user.QuietUntil(now)
+
+			assert.Equal(t, testcase.expectedQuiet, quiet)
+			assert.Equal(t, testcase.expected, until)
+		})
+	}
+}
+
+func TestReminderDimmed(t *testing.T) {
+	t.Parallel()
+
+	reminder := &domain.Reminder{
+		Msg:        "Stretch",
+		Brightness: domain.MaxBrightness,
+		Mode:       domain.Blinking,
+		Effect:     domain.DefaultEffect(domain.StrobeEffect),
+	}
+
+	dimmed := reminder.Dimmed()
+
+	assert.Equal(t, domain.Static, dimmed.Mode)
+	assert.Equal(t, domain.DefaultEffect(domain.StaticEffect), dimmed.Effect)
+	assert.Equal(t, domain.QuietBrightness, dimmed.Brightness)
+	assert.Equal(t, "Stretch", dimmed.Msg)
+	assert.Equal(t, domain.Blinking, reminder.Mode)
+}
@@ -0,0 +1,284 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Recurrence is an iCalendar RRULE (RFC 5545) describing how a reminder repeats,
+// e.g. "FREQ=WEEKLY;BYDAY=MO,WE". An empty Recurrence means a one-shot reminder.
+type Recurrence string
+
+const NoRecurrence Recurrence = ""
+
+type Frequency int8
+
+const (
+	UnknownFrequency Frequency = 0
+	Hourly           Frequency = 1
+	Daily            Frequency = 2
+	Weekly           Frequency = 3
+	Monthly          Frequency = 4
+)
+
+// maxRecurrenceIterations bounds the search for the next occurrence,
+// so a pathological rule can never spin the scheduler forever.
+const maxRecurrenceIterations = 100000
+
+var (
+	ErrInvalidRecurrence     = errors.New("invalid recurrence rule")
+	ErrUnsupportedRecurrence = errors.New("unsupported recurrence rule")
+)
+
+var frequencies = map[string]Frequency{
+	"HOURLY":  Hourly,
+	"DAILY":   Daily,
+	"WEEKLY":  Weekly,
+	"MONTHLY": Monthly,
+}
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// RecurrenceRule is a parsed Recurrence.
+type RecurrenceRule struct {
+	Freq     Frequency
+	Interval int
+	ByDay    []time.Weekday
+	Until    time.Time
+	// UntilFloating is set for the date-only and local UNTIL values: Until keeps their wall clock
+	// in UTC, and it is read in the location of the start of the reminder.
+	UntilFloating bool
+}
+
+// IsRecurring reports whether the reminder must be fired more than once.
+func (r Recurrence) IsRecurring() bool {
+	return strings.TrimSpace(string(r)) != ""
+}
+
+// Parse parses the supported subset of RRULE: FREQ (HOURLY, DAILY, WEEKLY, MONTHLY),
+// INTERVAL, BYDAY (only with WEEKLY) and UNTIL.
+func (r Recurrence) Parse() (*RecurrenceRule, error) {
+	rule := strings.ToUpper(strings.TrimSpace(string(r)))
+	rule = strings.TrimPrefix(rule, "RRULE:")
+	if rule == "" {
+		return nil, fmt.Errorf("%w: empty rule", ErrInvalidRecurrence)
+	}
+
+	parsed := &RecurrenceRule{
+		Interval: 1,
+	}
+
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("%w: malformed part %q", ErrInvalidRecurrence, part)
+		}
+
+		switch key {
+		case "FREQ":
+			freq, ok := frequencies[value]
+			if !ok {
+				return nil, fmt.Errorf("%w: frequency %q", ErrUnsupportedRecurrence, value)
+			}
+			parsed.Freq = freq
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval <= 0 {
+				return nil, fmt.Errorf("%w: interval %q", ErrInvalidRecurrence, value)
+			}
+			parsed.Interval = interval
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekday, ok := weekdays[day]
+				if !ok {
+					return nil, fmt.Errorf("%w: weekday %q", ErrInvalidRecurrence, day)
+				}
+				parsed.ByDay = append(parsed.ByDay, weekday)
+			}
+		case "UNTIL":
+			until, floating, err := parseUntil(value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: until %q", ErrInvalidRecurrence, value)
+			}
+			parsed.Until, parsed.UntilFloating = until, floating
+		case "WKST":
+			if value != "MO" {
+				return nil, fmt.Errorf("%w: week start %q", ErrUnsupportedRecurrence, value)
+			}
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedRecurrence, key)
+		}
+	}
+
+	if parsed.Freq == UnknownFrequency {
+		return nil, fmt.Errorf("%w: FREQ is required", ErrInvalidRecurrence)
+	}
+
+	if len(parsed.ByDay) != 0 && parsed.Freq != Weekly {
+		return nil, fmt.Errorf("%w: BYDAY is supported only with FREQ=WEEKLY", ErrUnsupportedRecurrence)
+	}
+
+	sort.Slice(parsed.ByDay, func(i, j int) bool {
+		return mondayOffset(parsed.ByDay[i]) < mondayOffset(parsed.ByDay[j])
+	})
+
+	return parsed, nil
+}
+
+// parseUntil parses the UTC, local and date-only UNTIL values. A date-only value lasts till the end of the day.
+func parseUntil(value string) (time.Time, bool, error) {
+	if until, err := time.Parse("20060102T150405Z", value); err == nil {
+		return until, false, nil
+	}
+
+	if until, err := time.Parse("20060102T150405", value); err == nil {
+		return until, true, nil
+	}
+
+	if until, err := time.Parse("20060102", value); err == nil {
+		return until.AddDate(0, 0, 1).Add(-time.Nanosecond), true, nil
+	}
+
+	return time.Time{}, false, ErrInvalidRecurrence
+}
+
+// UntilIn returns the end of the rule in the location, the zero time if the rule has no end.
+func (rule *RecurrenceRule) UntilIn(location *time.Location) time.Time {
+	if !rule.UntilFloating || rule.Until.IsZero() {
+		return rule.Until
+	}
+
+	until := rule.Until
+	return time.Date(until.Year(), until.Month(), until.Day(),
+		until.Hour(), until.Minute(), until.Second(), until.Nanosecond(), location)
+}
+
+// Next returns the first occurrence of the rule strictly after the given time.
+// Occurrences are aligned to start and keep its wall clock time in start's location.
+// The second value is false when the rule has no more occurrences.
+func (rule *RecurrenceRule) Next(start, after time.Time) (time.Time, bool) {
+	var next time.Time
+
+	switch rule.Freq {
+	case Hourly:
+		next = rule.nextHourly(start, after)
+	case Daily:
+		next = rule.nextDaily(start, after)
+	case Weekly:
+		next = rule.nextWeekly(start, after)
+	case Monthly:
+		next = rule.nextMonthly(start, after)
+	}
+
+	until := rule.UntilIn(start.Location())
+	if next.IsZero() || (!until.IsZero() && next.After(until)) {
+		return time.Time{}, false
+	}
+
+	return next, true
+}
+
+func (rule *RecurrenceRule) nextHourly(start, after time.Time) time.Time {
+	if start.After(after) {
+		return start
+	}
+
+	step := time.Duration(rule.Interval) * time.Hour
+	steps := after.Sub(start)/step + 1
+
+	return start.Add(steps * step)
+}
+
+func (rule *RecurrenceRule) nextDaily(start, after time.Time) time.Time {
+	k := 0
+	if after.After(start) {
+		k = max(int(after.Sub(start).Hours()/24)/rule.Interval-1, 0)
+	}
+
+	for i := 0; i < maxRecurrenceIterations; i, k = i+1, k+1 {
+		candidate := start.AddDate(0, 0, k*rule.Interval)
+		if candidate.After(after) {
+			return candidate
+		}
+	}
+
+	return time.Time{}
+}
+
+func (rule *RecurrenceRule) nextWeekly(start, after time.Time) time.Time {
+	byDay := rule.ByDay
+	if len(byDay) == 0 {
+		byDay = []time.Weekday{start.Weekday()}
+	}
+
+	weekStart := start.AddDate(0, 0, -mondayOffset(start.Weekday()))
+
+	k := 0
+	if after.After(start) {
+		k = max(int(after.Sub(start).Hours()/24/7)/rule.Interval-1, 0)
+	}
+
+	for i := 0; i < maxRecurrenceIterations; i, k = i+1, k+1 {
+		week := weekStart.AddDate(0, 0, 7*k*rule.Interval)
+		for _, day := range byDay {
+			candidate := week.AddDate(0, 0, mondayOffset(day))
+			if candidate.Before(start) {
+				continue
+			}
+			if candidate.After(after) {
+				return candidate
+			}
+		}
+	}
+
+	return time.Time{}
+}
+
+func (rule *RecurrenceRule) nextMonthly(start, after time.Time) time.Time {
+	k := 0
+	if after.After(start) {
+		months := (after.Year()-start.Year())*12 + int(after.Month()-start.Month())
+		k = max(months/rule.Interval-1, 0)
+	}
+
+	for i := 0; i < maxRecurrenceIterations; i, k = i+1, k+1 {
+		firstOfMonth := time.Date(start.Year(), start.Month()+time.Month(k*rule.Interval), 1,
+			start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+
+		// Months without such a day are skipped, as RFC 5545 requires.
+		if start.Day() > daysIn(firstOfMonth) {
+			continue
+		}
+
+		candidate := firstOfMonth.AddDate(0, 0, start.Day()-1)
+		if candidate.After(after) {
+			return candidate
+		}
+	}
+
+	return time.Time{}
+}
+
+func mondayOffset(day time.Weekday) int {
+	return (int(day) + 6) % 7
+}
+
+func daysIn(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,281 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurrenceParse(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name         string
+		recurrence   domain.Recurrence
+		expectedRule *domain.RecurrenceRule
+		expectedErr  error
+	}{
+		{
+			name:       "daily",
+			recurrence: "FREQ=DAILY",
+			expectedRule: &domain.RecurrenceRule{
+				Freq:     domain.Daily,
+				Interval: 1,
+			},
+		},
+		{
+			name:       "weekly with rrule prefix, lower case and sorted weekdays",
+			recurrence: "rrule:freq=weekly;interval=2;byday=fr,mo",
+			expectedRule: &domain.RecurrenceRule{
+				Freq:     domain.Weekly,
+				Interval: 2,
+				ByDay:    []time.Weekday{time.Monday, time.Friday},
+			},
+		},
+		{
+			name:       "hourly until",
+			recurrence: "FREQ=HOURLY;INTERVAL=3;UNTIL=20250301T000000Z",
+			expectedRule: &domain.RecurrenceRule{
+				Freq:     domain.Hourly,
+				Interval: 3,
+				Until:    time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:       "local until",
+			recurrence: "FREQ=DAILY;UNTIL=20250301T090000",
+			expectedRule: &domain.RecurrenceRule{
+				Freq:          domain.Daily,
+				Interval:      1,
+				Until:         time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC),
+				UntilFloating: true,
+			},
+		},
+		{
+			name:       "date-only until lasts till the end of the day",
+			recurrence: "FREQ=DAILY;UNTIL=20250301",
+			expectedRule: &domain.RecurrenceRule{
+				Freq:          domain.Daily,
+				Interval:      1,
+				Until:         time.Date(2025, 3, 1, 23, 59, 59, int(time.Second-time.Nanosecond), time.UTC),
+				UntilFloating: true,
+			},
+		},
+		{
+			name:        "empty rule",
+			recurrence:  "",
+			expectedErr: domain.ErrInvalidRecurrence,
+		},
+		{
+			name:        "missing frequency",
+			recurrence:  "INTERVAL=2",
+			expectedErr: domain.ErrInvalidRecurrence,
+		},
+		{
+			name:        "invalid interval",
+			recurrence:  "FREQ=DAILY;INTERVAL=0",
+			expectedErr: domain.ErrInvalidRecurrence,
+		},
+		{
+			name:        "invalid weekday",
+			recurrence:  "FREQ=WEEKLY;BYDAY=XX",
+			expectedErr: domain.ErrInvalidRecurrence,
+		},
+		{
+			name:        "unsupported frequency",
+			recurrence:  "FREQ=YEARLY",
+			expectedErr: domain.ErrUnsupportedRecurrence,
+		},
+		{
+			name:        "unsupported count",
+			recurrence:  "FREQ=DAILY;COUNT=3",
+			expectedErr: domain.ErrUnsupportedRecurrence,
+		},
+		{
+			name:        "weekdays with daily frequency",
+			recurrence:  "FREQ=DAILY;BYDAY=MO",
+			expectedErr: domain.ErrUnsupportedRecurrence,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := testcase.recurrence.Parse()
+
+			if testcase.expectedErr != nil {
+				assert.ErrorIs(t, err, testcase.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testcase.expectedRule, rule)
+			}
+		})
+	}
+}
+
+func TestRecurrenceRuleNext(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	// Wednesday.
+	start := time.Date(2025, 1, 15, 9, 30, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name         string
+		recurrence   domain.Recurrence
+		start        time.Time
+		after        time.Time
+		expectedNext time.Time
+		expectedOk   bool
+	}{
+		{
+			name:         "start is in the future",
+			recurrence:   "FREQ=DAILY",
+			start:        start,
+			after:        start.Add(-time.Hour),
+			expectedNext: start,
+			expectedOk:   true,
+		},
+		{
+			name:         "every 3 hours",
+			recurrence:   "FREQ=HOURLY;INTERVAL=3",
+			start:        start,
+			after:        start.Add(7 * time.Hour),
+			expectedNext: start.Add(9 * time.Hour),
+			expectedOk:   true,
+		},
+		{
+			name:         "daily right at the occurrence",
+			recurrence:   "FREQ=DAILY",
+			start:        start,
+			after:        start,
+			expectedNext: start.AddDate(0, 0, 1),
+			expectedOk:   true,
+		},
+		{
+			name:         "every other day after a long pause",
+			recurrence:   "FREQ=DAILY;INTERVAL=2",
+			start:        start,
+			after:        time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+			expectedNext: time.Date(2025, 3, 2, 9, 30, 0, 0, time.UTC),
+			expectedOk:   true,
+		},
+		{
+			name:         "daily keeps wall clock across DST",
+			recurrence:   "FREQ=DAILY",
+			start:        time.Date(2025, 3, 29, 9, 0, 0, 0, berlin),
+			after:        time.Date(2025, 3, 29, 10, 0, 0, 0, berlin),
+			expectedNext: time.Date(2025, 3, 30, 9, 0, 0, 0, berlin),
+			expectedOk:   true,
+		},
+		{
+			name:         "weekly on start weekday",
+			recurrence:   "FREQ=WEEKLY",
+			start:        start,
+			after:        start,
+			expectedNext: start.AddDate(0, 0, 7),
+			expectedOk:   true,
+		},
+		{
+			name:         "weekly on monday and friday",
+			recurrence:   "FREQ=WEEKLY;BYDAY=MO,FR",
+			start:        start,
+			after:        start,
+			expectedNext: time.Date(2025, 1, 17, 9, 30, 0, 0, time.UTC),
+			expectedOk:   true,
+		},
+		{
+			name:         "weekly skips days before start",
+			recurrence:   "FREQ=WEEKLY;BYDAY=MO",
+			start:        start,
+			after:        start.Add(-time.Hour),
+			expectedNext: time.Date(2025, 1, 20, 9, 30, 0, 0, time.UTC),
+			expectedOk:   true,
+		},
+		{
+			name:         "every other week on tuesday",
+			recurrence:   "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU",
+			start:        start,
+			after:        time.Date(2025, 1, 22, 0, 0, 0, 0, time.UTC),
+			expectedNext: time.Date(2025, 1, 28, 9, 30, 0, 0, time.UTC),
+			expectedOk:   true,
+		},
+		{
+			name:         "monthly",
+			recurrence:   "FREQ=MONTHLY",
+			start:        start,
+			after:        start,
+			expectedNext: time.Date(2025, 2, 15, 9, 30, 0, 0, time.UTC),
+			expectedOk:   true,
+		},
+		{
+			name:         "monthly skips months without the day",
+			recurrence:   "FREQ=MONTHLY",
+			start:        time.Date(2025, 1, 31, 9, 30, 0, 0, time.UTC),
+			after:        time.Date(2025, 1, 31, 9, 30, 0, 0, time.UTC),
+			expectedNext: time.Date(2025, 3, 31, 9, 30, 0, 0, time.UTC),
+			expectedOk:   true,
+		},
+		{
+			name:       "no occurrences after until",
+			recurrence: "FREQ=DAILY;UNTIL=20250116T000000Z",
+			start:      start,
+			after:      start,
+			expectedOk: false,
+		},
+		{
+			name:         "date-only until includes its day in the start location",
+			recurrence:   "FREQ=DAILY;UNTIL=20250116",
+			start:        time.Date(2025, 1, 15, 23, 30, 0, 0, berlin),
+			after:        time.Date(2025, 1, 15, 23, 30, 0, 0, berlin),
+			expectedNext: time.Date(2025, 1, 16, 23, 30, 0, 0, berlin),
+			expectedOk:   true,
+		},
+		{
+			name:       "no occurrences after date-only until in the start location",
+			recurrence: "FREQ=DAILY;UNTIL=20250116",
+			start:      time.Date(2025, 1, 15, 23, 30, 0, 0, berlin),
+			after:      time.Date(2025, 1, 16, 23, 30, 0, 0, berlin),
+			expectedOk: false,
+		},
+		{
+			name:         "local until in the start location",
+			recurrence:   "FREQ=DAILY;UNTIL=20250116T093000",
+			start:        time.Date(2025, 1, 15, 9, 30, 0, 0, berlin),
+			after:        time.Date(2025, 1, 15, 9, 30, 0, 0, berlin),
+			expectedNext: time.Date(2025, 1, 16, 9, 30, 0, 0, berlin),
+			expectedOk:   true,
+		},
+		{
+			name:       "no occurrences after local until in the start location",
+			recurrence: "FREQ=DAILY;UNTIL=20250116T090000",
+			start:      time.Date(2025, 1, 15, 9, 30, 0, 0, berlin),
+			after:      time.Date(2025, 1, 15, 9, 30, 0, 0, berlin),
+			expectedOk: false,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := testcase.recurrence.Parse()
+			require.NoError(t, err)
+
+			next, ok := rule.Next(testcase.start, testcase.after)
+
+			assert.Equal(t, testcase.expectedOk, ok)
+			assert.True(t, testcase.expectedNext.Equal(next), "expected %s, got %s", testcase.expectedNext, next)
+		})
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"github.com/google/uuid"
 )
 
+// DefaultTimeZone is the IANA time zone reminder times are entered and rendered in.
+const DefaultTimeZone = "Europe/Moscow"
+
 type Colour int8
 
 const (
@@ -23,20 +26,64 @@ const (
 	Blinking    Mode = 2
 )
 
+// Delivery defines how a fired reminder reaches its owner: the lamp lights up,
+// the reminder text is sent as a message, or both.
+type Delivery int8
+
+const (
+	UnknownDelivery Delivery = 0
+	LightOnly       Delivery = 1
+	MessageOnly     Delivery = 2
+	LightAndMessage Delivery = LightOnly | MessageOnly
+)
+
+// HasLight reports whether the lamp must be lit. Reminders without a delivery light the lamp, as they always did.
+func (delivery Delivery) HasLight() bool {
+	return delivery == UnknownDelivery || delivery&LightOnly != 0
+}
+
+// HasMessage reports whether the reminder text must be sent to the owner.
+func (delivery Delivery) HasMessage() bool {
+	return delivery&MessageOnly != 0
+}
+
 type Reminder struct {
-	ID          uuid.UUID `db:"id"`
-	UserID      int64     `db:"user_id"`
-	Msg         string    `db:"msg"`
-	Colour      Colour    `db:"colour"`
-	Mode        Mode      `db:"mode"`
-	ScheduledAt time.Time `db:"scheduled_at"`
-	CreatedAt   time.Time `db:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at"`
+	ID     uuid.UUID `db:"id"`
+	UserID int64     `db:"user_id"`
+	Msg    string    `db:"msg"`
+	// Colour is the legacy colour derived from RGB for devices that only support three colours.
+	Colour     Colour `db:"colour"`
+	RGB        RGB    `db:"rgb"`
+	Brightness int8   `db:"brightness"`
+	// Mode is the legacy mode derived from Effect for devices that do not support effects.
+	Mode     Mode     `db:"mode"`
+	Effect   Effect   `db:"effect"`
+	Delivery Delivery `db:"delivery"`
+	// DeviceID is the lamp lit by the reminder. If it is not set, the reminder lights the lamps of DeviceGroup
+	// or all the lamps of the user if the group is empty.
+	DeviceID    uuid.NullUUID `db:"device_id"`
+	DeviceGroup string        `db:"device_group"`
+	ScheduledAt time.Time     `db:"scheduled_at"`
+	Recurrence  Recurrence    `db:"recurrence"`
+	CreatedAt   time.Time     `db:"created_at"`
+	UpdatedAt   time.Time     `db:"updated_at"`
 }
 
 type ReminderTask struct {
 	ID          uuid.UUID `json:"id"`
 	ScheduledAt time.Time `json:"-"`
+	// Snoozed is set for a task re-enqueued by the owner after the reminder fired.
+	// Such a task fires the saved copy of the fired reminder and does not reschedule it.
+	Snoozed bool `json:"snoozed,omitempty"`
+}
+
+// ReminderAck records that the owner has seen a fired reminder.
+type ReminderAck struct {
+	ID          int64     `db:"id"`
+	ReminderID  uuid.UUID `db:"reminder_id"`
+	UserID      int64     `db:"user_id"`
+	ScheduledAt time.Time `db:"scheduled_at"`
+	AckedAt     time.Time `db:"acked_at"`
 }
 
 type GetRemindersParams struct {
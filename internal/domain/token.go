@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TokenPrefix starts every personal access token, so a leaked token is easy to recognise.
+const TokenPrefix = "glr_"
+
+var (
+	ErrInvalidToken       = errors.New("invalid token")
+	ErrInvalidTokenName   = errors.New("invalid token name")
+	ErrInvalidTokenScopes = errors.New("invalid token scopes")
+
+	tokenNameRegexp = regexp.MustCompile(`^[\p{L}\p{N}_-]{1,32}$`)
+)
+
+// TokenScopes is a set of actions a personal access token is allowed to perform.
+type TokenScopes int16
+
+const (
+	// ReadScope allows listing the reminders and the lamps.
+	ReadScope TokenScopes = 1 << iota
+	// WriteScope allows creating, editing and deleting the reminders.
+	WriteScope
+	// TriggerScope allows lighting the lamps.
+	TriggerScope
+
+	AllScopes = ReadScope | WriteScope | TriggerScope
+)
+
+var tokenScopeNames = []struct {
+	scope TokenScopes
+	name  string
+}{
+	{scope: ReadScope, name: "read"},
+	{scope: WriteScope, name: "write"},
+	{scope: TriggerScope, name: "trigger"},
+}
+
+func (scopes TokenScopes) Has(scope TokenScopes) bool {
+	return scopes&scope == scope
+}
+
+func (scopes TokenScopes) String() string {
+	names := make([]string, 0, len(tokenScopeNames))
+	for _, scopeName := range tokenScopeNames {
+		if scopes.Has(scopeName.scope) {
+			names = append(names, scopeName.name)
+		}
+	}
+
+	return strings.Join(names, ",")
+}
+
+// ParseTokenScopes parses a comma separated list of scopes, e.g. "read,trigger".
+func ParseTokenScopes(s string) (TokenScopes, error) {
+	var scopes TokenScopes
+
+	for _, name := range strings.Split(s, ",") {
+		scope, ok := parseTokenScope(strings.TrimSpace(name))
+		if !ok {
+			return 0, fmt.Errorf("%w: %q, use read, write or trigger", ErrInvalidTokenScopes, name)
+		}
+		scopes |= scope
+	}
+
+	return scopes, nil
+}
+
+func parseTokenScope(name string) (TokenScopes, bool) {
+	for _, scopeName := range tokenScopeNames {
+		if scopeName.name == name {
+			return scopeName.scope, true
+		}
+	}
+
+	return 0, false
+}
+
+// Token is a personal access token of the REST API. Only the SHA-256 hash of the token is stored,
+// the token itself is shown to the user once.
+type Token struct {
+	ID        uuid.UUID   `db:"id"`
+	UserID    int64       `db:"user_id"`
+	Name      string      `db:"name"`
+	Hash      []byte      `db:"hash"`
+	Scopes    TokenScopes `db:"scopes"`
+	ExpiresAt time.Time   `db:"expires_at"`
+	RevokedAt *time.Time  `db:"revoked_at"`
+	CreatedAt time.Time   `db:"created_at"`
+}
+
+// Active reports whether the token can be used at the given moment.
+func (token *Token) Active(now time.Time) bool {
+	return token.RevokedAt == nil && now.Before(token.ExpiresAt)
+}
+
+// ValidateTokenName checks that the name is a single word, so it can be used in the bot commands.
+func ValidateTokenName(name string) error {
+	if !tokenNameRegexp.MatchString(name) {
+		return fmt.Errorf("%w: %q must be up to 32 letters, digits, '_' or '-'", ErrInvalidTokenName, name)
+	}
+
+	return nil
+}
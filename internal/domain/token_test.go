@@ -0,0 +1,133 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTokenScopes(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		input       string
+		expected    domain.TokenScopes
+		expectedErr error
+	}{
+		{
+			name:     "single",
+			input:    "read",
+			expected: domain.ReadScope,
+		},
+		{
+			name:     "several with spaces",
+			input:    "read, trigger",
+			expected: domain.ReadScope | domain.TriggerScope,
+		},
+		{
+			name:     "all",
+			input:    "trigger,write,read",
+			expected: domain.AllScopes,
+		},
+		{
+			name:        "unknown",
+			input:       "read,admin",
+			expectedErr: domain.ErrInvalidTokenScopes,
+		},
+		{
+			name:        "empty",
+			input:       "",
+			expectedErr: domain.ErrInvalidTokenScopes,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			scopes, err := domain.ParseTokenScopes(testcase.input)
+
+			if testcase.expectedErr != nil {
+				assert.ErrorIs(t, err, testcase.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testcase.expected, scopes)
+			}
+		})
+	}
+}
+
+func TestTokenScopesString(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		scopes   domain.TokenScopes
+		expected string
+	}{
+		{
+			name:     "all",
+			scopes:   domain.AllScopes,
+			expected: "read,write,trigger",
+		},
+		{
+			name:     "read and trigger",
+			scopes:   domain.TriggerScope | domain.ReadScope,
+			expected: "read,trigger",
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expected, testcase.scopes.String())
+		})
+	}
+}
+
+func TestTokenActive(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 4, 20, 12, 0, 0, 0, time.UTC)
+	revokedAt := now.Add(-time.Hour)
+
+	testcases := []struct {
+		name     string
+		token    domain.Token
+		expected bool
+	}{
+		{
+			name:     "active",
+			token:    domain.Token{ExpiresAt: now.Add(time.Hour)},
+			expected: true,
+		},
+		{
+			name:     "expired",
+			token:    domain.Token{ExpiresAt: now},
+			expected: false,
+		},
+		{
+			name:     "revoked",
+			token:    domain.Token{ExpiresAt: now.Add(time.Hour), RevokedAt: &revokedAt},
+			expected: false,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expected, testcase.token.Active(now))
+		})
+	}
+}
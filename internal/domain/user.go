@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+type User struct {
+	ID       int64  `db:"id"`
+	TimeZone string `db:"time_zone"`
+	// QuietHours is the daily window in the "HH:MM-HH:MM" format the lamps are kept quiet in, empty if not set.
+	QuietHours  string      `db:"quiet_hours"`
+	QuietPolicy QuietPolicy `db:"quiet_policy"`
+	// DNDUntil is when the do not disturb turned on with /dnd ends.
+	DNDUntil  *time.Time `db:"dnd_until"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+}
+
+// Location returns the user time zone, falling back to DefaultTimeZone if it is not set.
+func (user *User) Location() (*time.Location, error) {
+	timeZone := user.TimeZone
+	if timeZone == "" {
+		timeZone = DefaultTimeZone
+	}
+
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load location %s: %w", timeZone, err)
+	}
+
+	return location, nil
+}
+
+// QuietUntil reports whether a lamp must not be lit normally at now, because the user has turned on
+// do not disturb or now falls within the quiet hours, and returns when the lamp can be lit again.
+// Do not disturb ending within the quiet hours is extended to the end of them and vice versa.
+func (user *User) QuietUntil(now time.Time) (time.Time, bool) {
+	var quietHours *QuietHours
+	if user.QuietHours != "" {
+		if parsed, err := ParseQuietHours(user.QuietHours); err == nil {
+			quietHours = &parsed
+		}
+	}
+
+	location, err := user.Location()
+	if err != nil {
+		location = time.UTC
+	}
+
+	until := now.In(location)
+	for extended := true; extended; {
+		extended = false
+
+		if user.DNDUntil != nil && user.DNDUntil.After(until) {
+			until = user.DNDUntil.In(location)
+			extended = true
+		}
+
+		if quietHours != nil {
+			if end, ok := quietHours.Until(until); ok {
+				until = end
+				extended = true
+			}
+		}
+	}
+
+	if !until.After(now) {
+		return time.Time{}, false
+	}
+
+	return until.UTC(), true
+}
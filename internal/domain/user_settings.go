@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TimeLocale is the locale the bot writes the times in: the examples of the times to enter and the format
+// of the dates. The rest of the messages of the bot are in English.
+type TimeLocale string
+
+const (
+	EnglishTimeLocale TimeLocale = "en"
+	RussianTimeLocale TimeLocale = "ru"
+
+	DefaultTimeLocale = EnglishTimeLocale
+)
+
+const (
+	MinPageSize = 1
+	MaxPageSize = 20
+	// DefaultPageSize is the number of reminders on a page of the list.
+	DefaultPageSize = 5
+)
+
+var (
+	ErrInvalidTimeLocale = errors.New("invalid time locale")
+	ErrInvalidPageSize   = errors.New("invalid page size")
+)
+
+// UserSettings are the preferences of the user applied to the reminders they create.
+type UserSettings struct {
+	UserID int64 `db:"user_id"`
+	// DefaultRGB is the colour of a new reminder, NoRGB if not set.
+	DefaultRGB RGB `db:"default_rgb"`
+	// DefaultEffect is the effect kind of a new reminder, empty if not set.
+	DefaultEffect EffectKind `db:"default_effect"`
+	// HasDefaultDevice is set if the new reminders light DefaultDeviceID or the lamps of DefaultDeviceGroup.
+	// If both are empty, they light all the lamps of the user.
+	HasDefaultDevice   bool          `db:"has_default_device"`
+	DefaultDeviceID    uuid.NullUUID `db:"default_device_id"`
+	DefaultDeviceGroup string        `db:"default_device_group"`
+	TimeLocale         TimeLocale    `db:"time_locale"`
+	PageSize           int           `db:"page_size"`
+	CreatedAt          time.Time     `db:"created_at"`
+	UpdatedAt          time.Time     `db:"updated_at"`
+}
+
+func ParseTimeLocale(s string) (TimeLocale, error) {
+	switch timeLocale := TimeLocale(strings.ToLower(strings.TrimSpace(s))); timeLocale {
+	case EnglishTimeLocale, RussianTimeLocale:
+		return timeLocale, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidTimeLocale, s)
+	}
+}
+
+func ValidatePageSize(pageSize int) error {
+	if pageSize < MinPageSize || pageSize > MaxPageSize {
+		return fmt.Errorf("%w: %d is not in [%d, %d]", ErrInvalidPageSize, pageSize, MinPageSize, MaxPageSize)
+	}
+
+	return nil
+}
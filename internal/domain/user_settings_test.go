@@ -0,0 +1,63 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimeLocale(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		s           string
+		expected    domain.TimeLocale
+		expectedErr error
+	}{
+		{name: "english", s: "en", expected: domain.EnglishTimeLocale},
+		{name: "russian in upper case", s: " RU ", expected: domain.RussianTimeLocale},
+		{name: "unknown", s: "de", expectedErr: domain.ErrInvalidTimeLocale},
+		{name: "empty", s: "", expectedErr: domain.ErrInvalidTimeLocale},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			timeLocale, err := domain.ParseTimeLocale(testcase.s)
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+			assert.Equal(t, testcase.expected, timeLocale)
+		})
+	}
+}
+
+func TestValidatePageSize(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		pageSize    int
+		expectedErr error
+	}{
+		{name: "min", pageSize: domain.MinPageSize},
+		{name: "default", pageSize: domain.DefaultPageSize},
+		{name: "max", pageSize: domain.MaxPageSize},
+		{name: "zero", pageSize: 0, expectedErr: domain.ErrInvalidPageSize},
+		{name: "too large", pageSize: domain.MaxPageSize + 1, expectedErr: domain.ErrInvalidPageSize},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ErrorIs(t, domain.ValidatePageSize(testcase.pageSize), testcase.expectedErr)
+		})
+	}
+}
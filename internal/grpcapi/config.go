@@ -0,0 +1,18 @@
+package grpcapi
+
+import (
+	"fmt"
+
+	"github.com/almostinf/glow-reminder/config"
+)
+
+type Config struct {
+	// Addr is the "host:port" the gRPC API listens on.
+	Addr string
+}
+
+func FromAppConfig(appCfg *config.AppConfig) Config {
+	return Config{
+		Addr: fmt.Sprintf("%s:%d", appCfg.GRPC.Host, appCfg.GRPC.Port),
+	}
+}
@@ -0,0 +1,246 @@
+package grpcapi
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	pb "github.com/almostinf/glow-reminder/pkg/pb/glowreminder/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var deliveries = map[pb.Delivery]domain.Delivery{
+	pb.Delivery_DELIVERY_LIGHT:   domain.LightOnly,
+	pb.Delivery_DELIVERY_MESSAGE: domain.MessageOnly,
+	pb.Delivery_DELIVERY_BOTH:    domain.LightAndMessage,
+}
+
+var effectKinds = map[pb.EffectKind]domain.EffectKind{
+	pb.EffectKind_EFFECT_KIND_STATIC:    domain.StaticEffect,
+	pb.EffectKind_EFFECT_KIND_BLINKING:  domain.BlinkingEffect,
+	pb.EffectKind_EFFECT_KIND_BREATHING: domain.BreathingEffect,
+	pb.EffectKind_EFFECT_KIND_PULSE:     domain.PulseEffect,
+	pb.EffectKind_EFFECT_KIND_RAINBOW:   domain.RainbowEffect,
+	pb.EffectKind_EFFECT_KIND_STROBE:    domain.StrobeEffect,
+	pb.EffectKind_EFFECT_KIND_SUNRISE:   domain.SunriseEffect,
+	pb.EffectKind_EFFECT_KIND_KEYFRAMES: domain.KeyframesEffect,
+}
+
+// reminderChanges are the fields of a create or update request, nil fields are left as is.
+type reminderChanges struct {
+	msg         *string
+	scheduledAt *timestamppb.Timestamp
+	recurrence  *string
+	delivery    pb.Delivery
+	rgb         *string
+	brightness  *int32
+	effect      *pb.Effect
+	deviceID    *string
+	deviceGroup *string
+}
+
+// apply sets the changes to the reminder or returns an InvalidArgument error.
+func (changes reminderChanges) apply(reminder *domain.Reminder, now time.Time) error {
+	if changes.msg != nil {
+		if *changes.msg == "" {
+			return status.Error(codes.InvalidArgument, "msg must not be empty")
+		}
+		reminder.Msg = *changes.msg
+	}
+
+	if changes.scheduledAt != nil {
+		if err := changes.scheduledAt.CheckValid(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "scheduled_at: %s", err)
+		}
+		scheduledAt := changes.scheduledAt.AsTime()
+		if !scheduledAt.After(now) {
+			return status.Error(codes.InvalidArgument, "scheduled_at must be in the future")
+		}
+		reminder.ScheduledAt = scheduledAt.Truncate(time.Second)
+	}
+
+	if changes.recurrence != nil {
+		recurrence := domain.Recurrence(*changes.recurrence)
+		if recurrence.IsRecurring() {
+			if _, err := recurrence.Parse(); err != nil {
+				return status.Errorf(codes.InvalidArgument, "recurrence: %s", err)
+			}
+		}
+		reminder.Recurrence = recurrence
+	}
+
+	if changes.delivery != pb.Delivery_DELIVERY_UNSPECIFIED {
+		delivery, ok := deliveries[changes.delivery]
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "unknown delivery %s", changes.delivery)
+		}
+		reminder.Delivery = delivery
+	}
+
+	if changes.rgb != nil {
+		rgb, err := domain.ParseRGB(*changes.rgb)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "rgb: %s", err)
+		}
+		reminder.RGB = rgb
+		reminder.Colour = rgb.LegacyColour()
+	}
+
+	if changes.brightness != nil {
+		brightness := int8(*changes.brightness)
+		if int32(brightness) != *changes.brightness || domain.ValidateBrightness(brightness) != nil {
+			return status.Error(codes.InvalidArgument, "brightness must be from 1 to 100")
+		}
+		reminder.Brightness = brightness
+	}
+
+	if changes.effect != nil {
+		effect, err := effectToDomain(changes.effect)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "effect: %s", err)
+		}
+		reminder.Effect = effect
+		reminder.Mode = effect.LegacyMode()
+	}
+
+	if changes.deviceID != nil {
+		deviceID, err := parseNullUUID(*changes.deviceID)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "invalid device_id")
+		}
+		reminder.DeviceID = deviceID
+	}
+
+	if changes.deviceGroup != nil {
+		reminder.DeviceGroup = *changes.deviceGroup
+	}
+
+	return nil
+}
+
+// effectToDomain converts the effect and checks that the lamp can play it.
+func effectToDomain(message *pb.Effect) (domain.Effect, error) {
+	kind, ok := effectKinds[message.GetKind()]
+	if !ok {
+		return domain.Effect{}, fmt.Errorf("%w: unknown kind %s", domain.ErrInvalidEffect, message.GetKind())
+	}
+
+	effect := domain.Effect{
+		Kind:     kind,
+		Period:   message.GetPeriod().AsDuration(),
+		Duration: message.GetDuration().AsDuration(),
+		Repeat:   int(message.GetRepeat()),
+	}
+
+	for i, keyframe := range message.GetKeyframes() {
+		rgb, err := domain.ParseRGB(keyframe.GetRgb())
+		if err != nil {
+			return domain.Effect{}, fmt.Errorf("keyframe %d: %w", i+1, err)
+		}
+
+		effect.Keyframes = append(effect.Keyframes, domain.Keyframe{
+			RGB:        rgb,
+			Brightness: int8(keyframe.GetBrightness()),
+			Duration:   keyframe.GetDuration().AsDuration(),
+		})
+	}
+
+	if err := effect.Validate(); err != nil {
+		return domain.Effect{}, err
+	}
+
+	return effect, nil
+}
+
+func newReminder(reminder *domain.Reminder) *pb.Reminder {
+	message := &pb.Reminder{
+		Id:          reminder.ID.String(),
+		Msg:         reminder.Msg,
+		ScheduledAt: timestamppb.New(reminder.ScheduledAt),
+		Recurrence:  string(reminder.Recurrence),
+		Delivery:    pb.Delivery_DELIVERY_LIGHT,
+		DeviceGroup: reminder.DeviceGroup,
+		CreatedAt:   timestamppb.New(reminder.CreatedAt),
+		UpdatedAt:   timestamppb.New(reminder.UpdatedAt),
+	}
+
+	for delivery, domainDelivery := range deliveries {
+		if domainDelivery == reminder.Delivery {
+			message.Delivery = delivery
+		}
+	}
+
+	if reminder.Delivery.HasLight() {
+		message.Rgb = reminder.RGB.Hex()
+		message.Brightness = int32(reminder.Brightness)
+		if reminder.Effect.Kind != "" {
+			message.Effect = newEffect(reminder.Effect)
+		}
+	}
+
+	if reminder.DeviceID.Valid {
+		message.DeviceId = reminder.DeviceID.UUID.String()
+	}
+
+	return message
+}
+
+func newEffect(effect domain.Effect) *pb.Effect {
+	message := &pb.Effect{
+		Repeat: int32(effect.Repeat),
+	}
+
+	for kind, domainKind := range effectKinds {
+		if domainKind == effect.Kind {
+			message.Kind = kind
+		}
+	}
+
+	if effect.Period != 0 {
+		message.Period = durationpb.New(effect.Period)
+	}
+
+	if effect.Duration != 0 {
+		message.Duration = durationpb.New(effect.Duration)
+	}
+
+	for _, keyframe := range effect.Keyframes {
+		message.Keyframes = append(message.Keyframes, &pb.Keyframe{
+			Rgb:        keyframe.RGB.Hex(),
+			Brightness: int32(keyframe.Brightness),
+			Duration:   durationpb.New(keyframe.Duration),
+		})
+	}
+
+	return message
+}
+
+func newDevice(device *domain.Device) *pb.Device {
+	return &pb.Device{
+		Id:       device.ID.String(),
+		Name:     device.Name,
+		Host:     device.Host,
+		Protocol: string(device.Protocol),
+		Rgb:      device.Capabilities.Has(domain.RGBCapability),
+		Effects:  device.Capabilities.Has(domain.EffectsCapability),
+		Group:    device.Group,
+	}
+}
+
+// parseNullUUID parses the id, an empty id is not set.
+func parseNullUUID(s string) (uuid.NullUUID, error) {
+	if s == "" {
+		return uuid.NullUUID{}, nil
+	}
+
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.NullUUID{}, err
+	}
+
+	return uuid.NullUUID{UUID: id, Valid: id != uuid.Nil}, nil
+}
@@ -0,0 +1,98 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/device"
+	"github.com/almostinf/glow-reminder/internal/usecase"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	pb "github.com/almostinf/glow-reminder/pkg/pb/glowreminder/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type deviceService struct {
+	pb.UnimplementedDeviceServiceServer
+
+	reminderUsecase usecase.ReminderUsecase
+	deviceUsecase   usecase.DeviceUsecase
+	deviceRegistry  device.Registry
+	logger          logger.Logger
+}
+
+func (service *deviceService) ListDevices(
+	ctx context.Context,
+	_ *pb.ListDevicesRequest,
+) (*pb.ListDevicesResponse, error) {
+	devices, err := service.deviceUsecase.GetDevices(ctx, userIDFromContext(ctx))
+	if err != nil {
+		return nil, internalError(ctx, service.logger, "failed to GetDevices", err)
+	}
+
+	response := &pb.ListDevicesResponse{
+		Devices: make([]*pb.Device, 0, len(devices)),
+	}
+	for _, device := range devices {
+		response.Devices = append(response.Devices, newDevice(device))
+	}
+
+	return response, nil
+}
+
+// Glow lights the lamps of the user with a test reminder, which is not saved.
+func (service *deviceService) Glow(ctx context.Context, req *pb.GlowRequest) (*pb.GlowResponse, error) {
+	reminder := service.reminderUsecase.NewDefaultReminder(userIDFromContext(ctx))
+	reminder.Msg = "Test glow"
+	reminder.DeviceGroup = req.GetDeviceGroup()
+
+	changes := reminderChanges{
+		effect:   req.GetEffect(),
+		deviceID: &req.DeviceId,
+	}
+	if req.GetRgb() != "" {
+		changes.rgb = &req.Rgb
+	}
+	if req.GetBrightness() != 0 {
+		changes.brightness = &req.Brightness
+	}
+
+	// The test reminder is not scheduled, so the current time is not needed.
+	if err := changes.apply(&reminder, time.Time{}); err != nil {
+		return nil, err
+	}
+
+	if err := deviceOwnerError(ctx, service.logger, service.deviceUsecase.CheckDeviceOwner(ctx, &reminder)); err != nil {
+		return nil, err
+	}
+
+	devices, err := service.deviceRegistry.ReminderDevices(ctx, &reminder)
+	if err != nil {
+		return nil, internalError(ctx, service.logger, "failed to get ReminderDevices", err)
+	}
+
+	response := &pb.GlowResponse{
+		Devices: make([]string, 0, len(devices)),
+	}
+
+	var errs []error
+	for _, device := range devices {
+		if err = service.deviceRegistry.Glow(device, &reminder, uuid.Nil); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		response.Devices = append(response.Devices, device.Name)
+	}
+
+	if err = errors.Join(errs...); err != nil {
+		service.logger.Error("failed to glow test reminder", map[string]interface{}{
+			"user_id": reminder.UserID,
+			"error":   err.Error(),
+		})
+		return nil, status.Error(codes.Unavailable, "failed to reach the lamps: "+err.Error())
+	}
+
+	return response, nil
+}
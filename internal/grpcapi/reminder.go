@@ -0,0 +1,233 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/scheduler"
+	"github.com/almostinf/glow-reminder/internal/usecase"
+	"github.com/almostinf/glow-reminder/pkg/clock"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	pb "github.com/almostinf/glow-reminder/pkg/pb/glowreminder/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+type reminderService struct {
+	pb.UnimplementedReminderServiceServer
+
+	reminderUsecase usecase.ReminderUsecase
+	deviceUsecase   usecase.DeviceUsecase
+	fireEvents      scheduler.FireEvents
+	clock           clock.Clock
+	logger          logger.Logger
+}
+
+func (service *reminderService) ListReminders(
+	ctx context.Context,
+	req *pb.ListRemindersRequest,
+) (*pb.ListRemindersResponse, error) {
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be from 1 to %d", maxLimit)
+	}
+
+	reminders, err := service.reminderUsecase.GetReminders(ctx, domain.GetRemindersParams{
+		UserID: userIDFromContext(ctx),
+		Offset: req.GetOffset(),
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, internalError(ctx, service.logger, "failed to GetReminders", err)
+	}
+
+	response := &pb.ListRemindersResponse{
+		Reminders: make([]*pb.Reminder, 0, len(reminders)),
+	}
+	for _, reminder := range reminders {
+		response.Reminders = append(response.Reminders, newReminder(reminder))
+	}
+
+	return response, nil
+}
+
+func (service *reminderService) GetReminder(ctx context.Context, req *pb.GetReminderRequest) (*pb.Reminder, error) {
+	reminder, err := service.getOwnReminder(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return newReminder(reminder), nil
+}
+
+func (service *reminderService) CreateReminder(
+	ctx context.Context,
+	req *pb.CreateReminderRequest,
+) (*pb.Reminder, error) {
+	if req.GetMsg() == "" || req.GetScheduledAt() == nil {
+		return nil, status.Error(codes.InvalidArgument, "msg and scheduled_at are required")
+	}
+
+	reminder := service.reminderUsecase.NewDefaultReminder(userIDFromContext(ctx))
+
+	changes := reminderChanges{
+		msg:         &req.Msg,
+		scheduledAt: req.GetScheduledAt(),
+		recurrence:  &req.Recurrence,
+		delivery:    req.GetDelivery(),
+		effect:      req.GetEffect(),
+		deviceID:    &req.DeviceId,
+		deviceGroup: &req.DeviceGroup,
+	}
+	if req.GetRgb() != "" {
+		changes.rgb = &req.Rgb
+	}
+	if req.GetBrightness() != 0 {
+		changes.brightness = &req.Brightness
+	}
+
+	if err := service.applyChanges(ctx, changes, &reminder); err != nil {
+		return nil, err
+	}
+
+	if err := service.reminderUsecase.CreateReminder(ctx, reminder); err != nil {
+		return nil, reminderError(ctx, service.logger, "failed to CreateReminder", err)
+	}
+
+	return newReminder(&reminder), nil
+}
+
+func (service *reminderService) UpdateReminder(
+	ctx context.Context,
+	req *pb.UpdateReminderRequest,
+) (*pb.Reminder, error) {
+	reminder, err := service.getOwnReminder(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	changes := reminderChanges{
+		msg:         req.Msg,
+		scheduledAt: req.GetScheduledAt(),
+		recurrence:  req.Recurrence,
+		delivery:    req.GetDelivery(),
+		rgb:         req.Rgb,
+		brightness:  req.Brightness,
+		effect:      req.GetEffect(),
+		deviceID:    req.DeviceId,
+		deviceGroup: req.DeviceGroup,
+	}
+
+	if err = service.applyChanges(ctx, changes, reminder); err != nil {
+		return nil, err
+	}
+
+	reminder.UpdatedAt = service.clock.NowUTC()
+
+	if err = service.reminderUsecase.UpdateReminder(ctx, *reminder); err != nil {
+		return nil, reminderError(ctx, service.logger, "failed to UpdateReminder", err)
+	}
+
+	return newReminder(reminder), nil
+}
+
+func (service *reminderService) DeleteReminder(
+	ctx context.Context,
+	req *pb.DeleteReminderRequest,
+) (*emptypb.Empty, error) {
+	reminder, err := service.getOwnReminder(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = service.reminderUsecase.DeleteReminder(ctx, reminder.UserID, reminder.ID); err != nil {
+		return nil, internalError(ctx, service.logger, "failed to DeleteReminder", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// WatchReminders streams the fire events of the user, which any scheduler instance publishes through Redis pub/sub.
+func (service *reminderService) WatchReminders(
+	_ *pb.WatchRemindersRequest,
+	stream pb.ReminderService_WatchRemindersServer,
+) error {
+	ctx := stream.Context()
+
+	for event := range service.fireEvents.Subscribe(ctx, userIDFromContext(ctx)) {
+		event := event
+
+		if err := stream.Send(&pb.FireEvent{
+			Reminder: newReminder(&event.Reminder),
+			FiredAt:  timestamppb.New(event.FiredAt),
+			Snoozed:  event.Snoozed,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return ctx.Err()
+}
+
+// getOwnReminder returns NotFound if the reminder does not exist or belongs to another user.
+func (service *reminderService) getOwnReminder(ctx context.Context, id string) (*domain.Reminder, error) {
+	reminderID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid reminder id")
+	}
+
+	reminder, err := service.reminderUsecase.GetOwnReminder(ctx, userIDFromContext(ctx), reminderID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "reminder not found")
+	}
+	if err != nil {
+		return nil, internalError(ctx, service.logger, "failed to GetReminder", err)
+	}
+
+	return reminder, nil
+}
+
+// applyChanges returns InvalidArgument if the changes are invalid or target a lamp of another user.
+func (service *reminderService) applyChanges(
+	ctx context.Context,
+	changes reminderChanges,
+	reminder *domain.Reminder,
+) error {
+	if err := changes.apply(reminder, service.clock.NowUTC()); err != nil {
+		return err
+	}
+
+	return deviceOwnerError(ctx, service.logger, service.deviceUsecase.CheckDeviceOwner(ctx, reminder))
+}
+
+// deviceOwnerError returns InvalidArgument for a lamp of another user.
+func deviceOwnerError(ctx context.Context, logger logger.Logger, err error) error {
+	if errors.Is(err, usecase.ErrUnknownDevice) {
+		return status.Error(codes.InvalidArgument, "unknown device_id")
+	}
+	if err != nil {
+		return internalError(ctx, logger, "failed to CheckDeviceOwner", err)
+	}
+
+	return nil
+}
+
+func reminderError(ctx context.Context, logger logger.Logger, msg string, err error) error {
+	if errors.Is(err, domain.ErrInvalidEffect) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return internalError(ctx, logger, msg, err)
+}
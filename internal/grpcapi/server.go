@@ -0,0 +1,229 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/almostinf/glow-reminder/internal/device"
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/scheduler"
+	"github.com/almostinf/glow-reminder/internal/usecase"
+	"github.com/almostinf/glow-reminder/pkg/clock"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	pb "github.com/almostinf/glow-reminder/pkg/pb/glowreminder/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// bearerPrefix starts the authorization metadata with a personal access token issued by the /token bot command.
+const bearerPrefix = "Bearer "
+
+// reflectionPrefix starts the methods of the server reflection, which are not authenticated.
+const reflectionPrefix = "/grpc.reflection."
+
+// methodScopes are the scopes required by the methods. A method missing here is denied.
+var methodScopes = map[string]domain.TokenScopes{
+	pb.ReminderService_ListReminders_FullMethodName:  domain.ReadScope,
+	pb.ReminderService_GetReminder_FullMethodName:    domain.ReadScope,
+	pb.ReminderService_CreateReminder_FullMethodName: domain.WriteScope,
+	pb.ReminderService_UpdateReminder_FullMethodName: domain.WriteScope,
+	pb.ReminderService_DeleteReminder_FullMethodName: domain.WriteScope,
+	pb.ReminderService_WatchReminders_FullMethodName: domain.ReadScope,
+	pb.DeviceService_ListDevices_FullMethodName:      domain.ReadScope,
+	pb.DeviceService_Glow_FullMethodName:             domain.TriggerScope,
+}
+
+var _ Server = (*server)(nil)
+
+// Server is the gRPC API for home-automation controllers.
+type Server interface {
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+}
+
+type server struct {
+	cfg          Config
+	grpcServer   *grpc.Server
+	logger       logger.Logger
+	tokenUsecase usecase.TokenUsecase
+}
+
+func New(
+	cfg Config,
+	logger logger.Logger,
+	reminderUsecase usecase.ReminderUsecase,
+	deviceUsecase usecase.DeviceUsecase,
+	tokenUsecase usecase.TokenUsecase,
+	deviceRegistry device.Registry,
+	fireEvents scheduler.FireEvents,
+	clock clock.Clock,
+) *server {
+	server := &server{
+		cfg:          cfg,
+		logger:       logger,
+		tokenUsecase: tokenUsecase,
+	}
+
+	server.grpcServer = grpc.NewServer(
+		grpc.UnaryInterceptor(server.unaryAuthInterceptor),
+		grpc.StreamInterceptor(server.streamAuthInterceptor),
+	)
+
+	pb.RegisterReminderServiceServer(server.grpcServer, &reminderService{
+		reminderUsecase: reminderUsecase,
+		deviceUsecase:   deviceUsecase,
+		fireEvents:      fireEvents,
+		clock:           clock,
+		logger:          logger,
+	})
+	pb.RegisterDeviceServiceServer(server.grpcServer, &deviceService{
+		reminderUsecase: reminderUsecase,
+		deviceUsecase:   deviceUsecase,
+		deviceRegistry:  deviceRegistry,
+		logger:          logger,
+	})
+	reflection.Register(server.grpcServer)
+
+	return server
+}
+
+// Serve serves the gRPC API on the listener until Stop is called.
+func (server *server) Serve(listener net.Listener) error {
+	return server.grpcServer.Serve(listener)
+}
+
+func (server *server) Start(_ context.Context) error {
+	listener, err := net.Listen("tcp", server.cfg.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen %s: %w", server.cfg.Addr, err)
+	}
+
+	server.logger.Info("Start gRPC API server", map[string]interface{}{
+		"addr": server.cfg.Addr,
+	})
+
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			server.logger.Error("failed to serve gRPC API", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+	}()
+
+	return nil
+}
+
+// Stop waits for the unary calls to finish, the WatchReminders streams are cancelled once the context is done.
+func (server *server) Stop(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		server.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		server.grpcServer.Stop()
+	}
+
+	return nil
+}
+
+type userIDKey struct{}
+
+func (server *server) unaryAuthInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	ctx, err := server.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (server *server) streamAuthInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := server.authenticate(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+}
+
+// authenticate resolves the user of the call by the bearer token
+// and rejects the calls without a valid token having the scope of the method.
+func (server *server) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if strings.HasPrefix(method, reflectionPrefix) {
+		return ctx, nil
+	}
+
+	scope, ok := methodScopes[method]
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "unknown method")
+	}
+
+	var authorization string
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get("authorization")) > 0 {
+		authorization = md.Get("authorization")[0]
+	}
+
+	secret, ok := strings.CutPrefix(authorization, bearerPrefix)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	token, err := server.tokenUsecase.Authenticate(ctx, strings.TrimSpace(secret))
+	if errors.Is(err, domain.ErrInvalidToken) {
+		return nil, status.Error(codes.Unauthenticated, "invalid, revoked or expired token")
+	}
+	if err != nil {
+		return nil, internalError(ctx, server.logger, method, err)
+	}
+
+	if !token.Scopes.Has(scope) {
+		return nil, status.Error(codes.PermissionDenied, "the token lacks the "+scope.String()+" scope")
+	}
+
+	return context.WithValue(ctx, userIDKey{}, token.UserID), nil
+}
+
+func userIDFromContext(ctx context.Context) int64 {
+	userID, _ := ctx.Value(userIDKey{}).(int64)
+	return userID
+}
+
+// authenticatedStream passes the context with the user of the call to the stream handler.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *authenticatedStream) Context() context.Context {
+	return stream.ctx
+}
+
+// internalError logs the error and hides its details from the client.
+func internalError(ctx context.Context, logger logger.Logger, msg string, err error) error {
+	logger.Error(msg, map[string]interface{}{
+		"user_id": userIDFromContext(ctx),
+		"error":   err.Error(),
+	})
+
+	return status.Error(codes.Internal, "internal error")
+}
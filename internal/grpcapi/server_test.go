@@ -0,0 +1,523 @@
+package grpcapi_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	device_mocks "github.com/almostinf/glow-reminder/internal/device/mocks"
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/grpcapi"
+	"github.com/almostinf/glow-reminder/internal/repository/redis"
+	"github.com/almostinf/glow-reminder/internal/scheduler"
+	"github.com/almostinf/glow-reminder/internal/usecase"
+	usecase_mocks "github.com/almostinf/glow-reminder/internal/usecase/mocks"
+	clock_mocks "github.com/almostinf/glow-reminder/pkg/clock/mocks"
+	logger_mocks "github.com/almostinf/glow-reminder/pkg/logger/mocks"
+	pb "github.com/almostinf/glow-reminder/pkg/pb/glowreminder/v1"
+	rediswrapper "github.com/almostinf/glow-reminder/pkg/redis"
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	userID = int64(42)
+
+	fullToken     = "glr_full"
+	readOnlyToken = "glr_read"
+
+	bufSize = 1024 * 1024
+)
+
+var now = time.Date(2025, 4, 20, 12, 0, 0, 0, time.UTC)
+
+type serverMocks struct {
+	reminderUsecase *usecase_mocks.MockReminderUsecase
+	deviceUsecase   *usecase_mocks.MockDeviceUsecase
+	deviceRegistry  *device_mocks.MockRegistry
+}
+
+type clients struct {
+	conn      *grpc.ClientConn
+	reminders pb.ReminderServiceClient
+	devices   pb.DeviceServiceClient
+}
+
+// serverHelper serves the gRPC API over an in-memory connection.
+func serverHelper(t *testing.T, mock func(serverMocks)) (clients, scheduler.FireEvents) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	mocks := serverMocks{
+		reminderUsecase: usecase_mocks.NewMockReminderUsecase(mockCtrl),
+		deviceUsecase:   usecase_mocks.NewMockDeviceUsecase(mockCtrl),
+		deviceRegistry:  device_mocks.NewMockRegistry(mockCtrl),
+	}
+	mock(mocks)
+
+	// The usecases fill the defaults of a new reminder and check its lamp, the cases only set their failures.
+	mocks.reminderUsecase.EXPECT().NewDefaultReminder(userID).DoAndReturn(func(userID int64) domain.Reminder {
+		return domain.Reminder{
+			ID:         uuid.New(),
+			UserID:     userID,
+			Colour:     domain.Red,
+			RGB:        domain.RedRGB,
+			Brightness: domain.MaxBrightness,
+			Mode:       domain.Static,
+			Effect:     domain.DefaultEffect(domain.StaticEffect),
+			Delivery:   domain.LightOnly,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
+	}).AnyTimes()
+	mocks.deviceUsecase.EXPECT().CheckDeviceOwner(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	tokenUsecase := usecase_mocks.NewMockTokenUsecase(mockCtrl)
+	tokenUsecase.EXPECT().Authenticate(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, secret string) (*domain.Token, error) {
+			switch secret {
+			case fullToken:
+				return &domain.Token{UserID: userID, Scopes: domain.AllScopes}, nil
+			case readOnlyToken:
+				return &domain.Token{UserID: userID, Scopes: domain.ReadScope}, nil
+			default:
+				return nil, domain.ErrInvalidToken
+			}
+		}).
+		AnyTimes()
+
+	log := logger_mocks.NewMockLogger(mockCtrl)
+	log.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	log.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	clk := clock_mocks.NewMockClock(mockCtrl)
+	clk.EXPECT().NowUTC().Return(now).AnyTimes()
+
+	redisServer := miniredis.RunT(t)
+
+	redisClient := goredis.NewClient(&goredis.Options{Addr: redisServer.Addr()})
+	t.Cleanup(func() { _ = redisClient.Close() })
+
+	fireEvents := scheduler.NewFireEvents(redis.NewFireEventRepo(&rediswrapper.Redis{Client: redisClient}, log), log)
+	require.NoError(t, fireEvents.Start(context.Background()))
+	t.Cleanup(func() { _ = fireEvents.Stop(context.Background()) })
+
+	server := grpcapi.New(
+		grpcapi.Config{},
+		log,
+		mocks.reminderUsecase,
+		mocks.deviceUsecase,
+		tokenUsecase,
+		mocks.deviceRegistry,
+		fireEvents,
+		clk,
+	)
+
+	listener := bufconn.Listen(bufSize)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		_ = server.Stop(context.Background())
+	})
+
+	return clients{
+		conn:      conn,
+		reminders: pb.NewReminderServiceClient(conn),
+		devices:   pb.NewDeviceServiceClient(conn),
+	}, fireEvents
+}
+
+func withToken(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	reminderID := uuid.New()
+
+	ownReminder := &domain.Reminder{
+		ID:          reminderID,
+		UserID:      userID,
+		Msg:         "water the plants",
+		ScheduledAt: now.Add(time.Hour),
+		RGB:         domain.RedRGB,
+		Brightness:  domain.MaxBrightness,
+		Effect:      domain.DefaultEffect(domain.StaticEffect),
+		Delivery:    domain.LightOnly,
+	}
+
+	kitchen := &domain.Device{ID: uuid.New(), UserID: userID, Name: "kitchen"}
+
+	testcases := []struct {
+		name         string
+		token        string
+		call         func(ctx context.Context, clients clients) error
+		mock         func(serverMocks)
+		expectedCode codes.Code
+	}{
+		{
+			name: "missing token",
+			call: func(ctx context.Context, clients clients) error {
+				_, err := clients.reminders.ListReminders(ctx, &pb.ListRemindersRequest{})
+				return err
+			},
+			mock:         func(serverMocks) {},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:  "revoked token",
+			token: "glr_revoked",
+			call: func(ctx context.Context, clients clients) error {
+				_, err := clients.reminders.ListReminders(ctx, &pb.ListRemindersRequest{})
+				return err
+			},
+			mock:         func(serverMocks) {},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:  "token without write scope",
+			token: readOnlyToken,
+			call: func(ctx context.Context, clients clients) error {
+				_, err := clients.reminders.DeleteReminder(ctx, &pb.DeleteReminderRequest{Id: reminderID.String()})
+				return err
+			},
+			mock:         func(serverMocks) {},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:  "list reminders",
+			token: readOnlyToken,
+			call: func(ctx context.Context, clients clients) error {
+				response, err := clients.reminders.ListReminders(ctx, &pb.ListRemindersRequest{})
+				if err == nil && (len(response.GetReminders()) != 1 || response.GetReminders()[0].GetMsg() != ownReminder.Msg) {
+					return errors.New("unexpected reminders")
+				}
+				return err
+			},
+			mock: func(mocks serverMocks) {
+				mocks.reminderUsecase.EXPECT().
+					GetReminders(gomock.Any(), domain.GetRemindersParams{UserID: userID, Limit: 20}).
+					Return([]*domain.Reminder{ownReminder}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "limit out of range",
+			token: fullToken,
+			call: func(ctx context.Context, clients clients) error {
+				_, err := clients.reminders.ListReminders(ctx, &pb.ListRemindersRequest{Limit: 1000})
+				return err
+			},
+			mock:         func(serverMocks) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:  "create without scheduled_at",
+			token: fullToken,
+			call: func(ctx context.Context, clients clients) error {
+				_, err := clients.reminders.CreateReminder(ctx, &pb.CreateReminderRequest{Msg: "water the plants"})
+				return err
+			},
+			mock:         func(serverMocks) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:  "create with invalid effect",
+			token: fullToken,
+			call: func(ctx context.Context, clients clients) error {
+				_, err := clients.reminders.CreateReminder(ctx, &pb.CreateReminderRequest{
+					Msg:         "water the plants",
+					ScheduledAt: timestamppb.New(now.Add(time.Hour)),
+					Effect:      &pb.Effect{Kind: pb.EffectKind_EFFECT_KIND_KEYFRAMES},
+				})
+				return err
+			},
+			mock:         func(serverMocks) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:  "create reminder",
+			token: fullToken,
+			call: func(ctx context.Context, clients clients) error {
+				response, err := clients.reminders.CreateReminder(ctx, &pb.CreateReminderRequest{
+					Msg:         "water the plants",
+					ScheduledAt: timestamppb.New(now.Add(time.Hour)),
+					Delivery:    pb.Delivery_DELIVERY_BOTH,
+					Rgb:         "#00FF00",
+				})
+				if err == nil && (response.GetRgb() != "#00FF00" || response.GetDelivery() != pb.Delivery_DELIVERY_BOTH) {
+					return errors.New("unexpected reminder")
+				}
+				return err
+			},
+			mock: func(mocks serverMocks) {
+				mocks.reminderUsecase.EXPECT().
+					CreateReminder(gomock.Any(), gomock.Cond(func(x any) bool {
+						reminder, ok := x.(domain.Reminder)
+						return ok && reminder.UserID == userID && reminder.Delivery == domain.LightAndMessage
+					})).
+					Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "create with a lamp of another user",
+			token: fullToken,
+			call: func(ctx context.Context, clients clients) error {
+				_, err := clients.reminders.CreateReminder(ctx, &pb.CreateReminderRequest{
+					Msg:         "water the plants",
+					ScheduledAt: timestamppb.New(now.Add(time.Hour)),
+					DeviceId:    kitchen.ID.String(),
+				})
+				return err
+			},
+			mock: func(mocks serverMocks) {
+				mocks.deviceUsecase.EXPECT().CheckDeviceOwner(gomock.Any(), gomock.Any()).Return(usecase.ErrUnknownDevice)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:  "get reminder of another user",
+			token: fullToken,
+			call: func(ctx context.Context, clients clients) error {
+				_, err := clients.reminders.GetReminder(ctx, &pb.GetReminderRequest{Id: reminderID.String()})
+				return err
+			},
+			mock: func(mocks serverMocks) {
+				mocks.reminderUsecase.EXPECT().GetOwnReminder(gomock.Any(), userID, reminderID).Return(nil, domain.ErrNotFound)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:  "update reminder",
+			token: fullToken,
+			call: func(ctx context.Context, clients clients) error {
+				msg := "feed the cat"
+				response, err := clients.reminders.UpdateReminder(ctx, &pb.UpdateReminderRequest{
+					Id:  reminderID.String(),
+					Msg: &msg,
+				})
+				if err == nil && (response.GetMsg() != msg || response.GetRgb() != ownReminder.RGB.Hex()) {
+					return errors.New("unexpected reminder")
+				}
+				return err
+			},
+			mock: func(mocks serverMocks) {
+				reminder := *ownReminder
+				mocks.reminderUsecase.EXPECT().GetOwnReminder(gomock.Any(), userID, reminderID).Return(&reminder, nil)
+				mocks.reminderUsecase.EXPECT().UpdateReminder(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "delete reminder",
+			token: fullToken,
+			call: func(ctx context.Context, clients clients) error {
+				_, err := clients.reminders.DeleteReminder(ctx, &pb.DeleteReminderRequest{Id: reminderID.String()})
+				return err
+			},
+			mock: func(mocks serverMocks) {
+				mocks.reminderUsecase.EXPECT().GetOwnReminder(gomock.Any(), userID, reminderID).Return(ownReminder, nil)
+				mocks.reminderUsecase.EXPECT().DeleteReminder(gomock.Any(), userID, reminderID).Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "list devices",
+			token: readOnlyToken,
+			call: func(ctx context.Context, clients clients) error {
+				response, err := clients.devices.ListDevices(ctx, &pb.ListDevicesRequest{})
+				if err == nil && (len(response.GetDevices()) != 1 || response.GetDevices()[0].GetName() != kitchen.Name) {
+					return errors.New("unexpected devices")
+				}
+				return err
+			},
+			mock: func(mocks serverMocks) {
+				mocks.deviceUsecase.EXPECT().GetDevices(gomock.Any(), userID).Return([]*domain.Device{kitchen}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "glow without trigger scope",
+			token: readOnlyToken,
+			call: func(ctx context.Context, clients clients) error {
+				_, err := clients.devices.Glow(ctx, &pb.GlowRequest{})
+				return err
+			},
+			mock:         func(serverMocks) {},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:  "glow",
+			token: fullToken,
+			call: func(ctx context.Context, clients clients) error {
+				response, err := clients.devices.Glow(ctx, &pb.GlowRequest{Rgb: "#0000FF"})
+				if err == nil && (len(response.GetDevices()) != 1 || response.GetDevices()[0] != kitchen.Name) {
+					return errors.New("unexpected devices")
+				}
+				return err
+			},
+			mock: func(mocks serverMocks) {
+				mocks.deviceRegistry.EXPECT().ReminderDevices(gomock.Any(), gomock.Any()).Return([]*domain.Device{kitchen}, nil)
+				mocks.deviceRegistry.EXPECT().Glow(kitchen, gomock.Any(), uuid.Nil).Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:  "glow with unreachable lamp",
+			token: fullToken,
+			call: func(ctx context.Context, clients clients) error {
+				_, err := clients.devices.Glow(ctx, &pb.GlowRequest{})
+				return err
+			},
+			mock: func(mocks serverMocks) {
+				mocks.deviceRegistry.EXPECT().ReminderDevices(gomock.Any(), gomock.Any()).Return([]*domain.Device{kitchen}, nil)
+				mocks.deviceRegistry.EXPECT().Glow(kitchen, gomock.Any(), uuid.Nil).Return(errors.New("connection refused"))
+			},
+			expectedCode: codes.Unavailable,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			clients, _ := serverHelper(t, testcase.mock)
+
+			err := testcase.call(withToken(context.Background(), testcase.token), clients)
+
+			assert.Equal(t, testcase.expectedCode, status.Code(err), err)
+		})
+	}
+}
+
+func TestWatchReminders(t *testing.T) {
+	t.Parallel()
+
+	clients, fireEvents := serverHelper(t, func(serverMocks) {})
+
+	ctx, cancel := context.WithCancel(withToken(context.Background(), readOnlyToken))
+	defer cancel()
+
+	stream, err := clients.reminders.WatchReminders(ctx, &pb.WatchRemindersRequest{})
+	require.NoError(t, err)
+
+	// The subscription is made once the stream reaches the server, so the events are published until one arrives.
+	events := make(chan *pb.FireEvent)
+	go func() {
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				close(events)
+				return
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	foreignEvent := domain.FireEvent{
+		Reminder: domain.Reminder{ID: uuid.New(), UserID: userID + 1, Msg: "not mine"},
+		FiredAt:  now,
+	}
+	ownEvent := domain.FireEvent{
+		Reminder: domain.Reminder{ID: uuid.New(), UserID: userID, Msg: "water the plants", Delivery: domain.MessageOnly},
+		FiredAt:  now,
+		Snoozed:  true,
+	}
+
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+
+	var event *pb.FireEvent
+	for event == nil {
+		select {
+		case <-ticker.C:
+			fireEvents.Publish(ctx, foreignEvent)
+			fireEvents.Publish(ctx, ownEvent)
+		case event = <-events:
+		case <-time.After(5 * time.Second):
+			t.Fatal("no fire event received")
+		}
+	}
+
+	assert.Equal(t, ownEvent.Reminder.ID.String(), event.GetReminder().GetId())
+	assert.Equal(t, "water the plants", event.GetReminder().GetMsg())
+	assert.Equal(t, pb.Delivery_DELIVERY_MESSAGE, event.GetReminder().GetDelivery())
+	assert.True(t, event.GetSnoozed())
+	assert.True(t, now.Equal(event.GetFiredAt().AsTime()))
+}
+
+func TestWatchRemindersUnauthenticated(t *testing.T) {
+	t.Parallel()
+
+	clients, _ := serverHelper(t, func(serverMocks) {})
+
+	stream, err := clients.reminders.WatchReminders(context.Background(), &pb.WatchRemindersRequest{})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestReflection(t *testing.T) {
+	t.Parallel()
+
+	clients, _ := serverHelper(t, func(serverMocks) {})
+
+	stream, err := reflectionpb.NewServerReflectionClient(clients.conn).ServerReflectionInfo(context.Background())
+	require.NoError(t, err)
+
+	err = stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	})
+	require.NoError(t, err)
+
+	response, err := stream.Recv()
+	require.NoError(t, err)
+
+	var services []string
+	for _, service := range response.GetListServicesResponse().GetService() {
+		services = append(services, service.GetName())
+	}
+
+	assert.Contains(t, services, "glowreminder.v1.ReminderService")
+	assert.Contains(t, services, "glowreminder.v1.DeviceService")
+}
@@ -0,0 +1,201 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/device"
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/leader"
+	"github.com/almostinf/glow-reminder/internal/notifier"
+	"github.com/almostinf/glow-reminder/internal/repository/pg"
+	"github.com/almostinf/glow-reminder/pkg/clock"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/google/uuid"
+	"gopkg.in/tomb.v2"
+)
+
+const (
+	lampOfflineMsg    = "🔴 Lamp %q went offline: %s"
+	lampBackOnlineMsg = "🟢 Lamp %q is back online"
+)
+
+// Checker probes the registered lamps periodically, records their statuses
+// and alerts the owners when a lamp goes offline or comes back.
+type Checker interface {
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+}
+
+type checker struct {
+	cfg              Config
+	deviceRepo       pg.DeviceRepo
+	deviceStatusRepo pg.DeviceStatusRepo
+	deviceRegistry   device.Registry
+	notifier         notifier.Notifier
+	elector          leader.Elector
+	clock            clock.Clock
+	logger           logger.Logger
+	tomb             tomb.Tomb
+}
+
+func New(
+	cfg Config,
+	deviceRepo pg.DeviceRepo,
+	deviceStatusRepo pg.DeviceStatusRepo,
+	deviceRegistry device.Registry,
+	notifier notifier.Notifier,
+	elector leader.Elector,
+	clock clock.Clock,
+	logger logger.Logger,
+) *checker {
+	return &checker{
+		cfg:              cfg,
+		deviceRepo:       deviceRepo,
+		deviceStatusRepo: deviceStatusRepo,
+		deviceRegistry:   deviceRegistry,
+		notifier:         notifier,
+		elector:          elector,
+		clock:            clock,
+		logger:           logger,
+		tomb:             tomb.Tomb{},
+	}
+}
+
+func (checker *checker) Start(ctx context.Context) error {
+	checker.logger.Debug("Start health checker", map[string]interface{}{})
+
+	ctx = context.WithoutCancel(ctx)
+
+	checker.tomb.Go(func() error {
+		ticker := time.NewTicker(checker.cfg.CycleDuration)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-checker.tomb.Dying():
+				return nil
+			case <-ticker.C:
+				// Only the leader checks the lamps, so that the owners are not alerted by every instance.
+				if !checker.elector.IsLeader() {
+					continue
+				}
+
+				if err := checker.checkDevices(ctx); err != nil {
+					checker.logger.Error("failed to check devices", map[string]interface{}{
+						"error": err.Error(),
+					})
+				}
+			}
+		}
+	})
+
+	return nil
+}
+
+// checkDevices probes all the registered lamps at once and records the results.
+func (checker *checker) checkDevices(ctx context.Context) error {
+	devices, err := checker.deviceRepo.GetDevices(ctx, 0)
+	if err != nil {
+		return fmt.Errorf("failed to GetDevices: %w", err)
+	}
+
+	if len(devices) == 0 {
+		return nil
+	}
+
+	deviceIDs := make([]uuid.UUID, 0, len(devices))
+	for _, device := range devices {
+		deviceIDs = append(deviceIDs, device.ID)
+	}
+
+	statuses, err := checker.deviceStatusRepo.GetDeviceStatuses(ctx, deviceIDs)
+	if err != nil {
+		return fmt.Errorf("failed to GetDeviceStatuses: %w", err)
+	}
+
+	statusByDevice := make(map[uuid.UUID]*domain.DeviceStatus, len(statuses))
+	for _, status := range statuses {
+		statusByDevice[status.DeviceID] = status
+	}
+
+	probeErrs := make([]error, len(devices))
+
+	var wg sync.WaitGroup
+	for i, device := range devices {
+		wg.Add(1)
+
+		go func(i int, device *domain.Device) {
+			defer wg.Done()
+
+			probeCtx, cancel := context.WithTimeout(ctx, checker.cfg.ProbeTimeout)
+			defer cancel()
+
+			probeErrs[i] = checker.deviceRegistry.Probe(probeCtx, device)
+		}(i, device)
+	}
+	wg.Wait()
+
+	now := checker.clock.NowUTC()
+	for i, device := range devices {
+		status, ok := statusByDevice[device.ID]
+		if !ok {
+			status = domain.NewDeviceStatus(device.ID, now)
+		}
+
+		if err = checker.recordStatus(ctx, device, status, now, probeErrs[i]); err != nil {
+			checker.logger.Error("failed to record device status", map[string]interface{}{
+				"device_id": device.ID,
+				"error":     err.Error(),
+			})
+		}
+	}
+
+	return nil
+}
+
+// recordStatus saves the result of the health check of the lamp and alerts the owner if the lamp went online or offline.
+func (checker *checker) recordStatus(
+	ctx context.Context,
+	device *domain.Device,
+	status *domain.DeviceStatus,
+	now time.Time,
+	probeErr error,
+) error {
+	changed := status.Observe(now, probeErr, checker.cfg.FailureThreshold)
+
+	if err := checker.deviceStatusRepo.UpsertDeviceStatus(ctx, *status); err != nil {
+		return fmt.Errorf("failed to UpsertDeviceStatus: %w", err)
+	}
+
+	if !changed {
+		return nil
+	}
+
+	checker.logger.Info("Device status changed", map[string]interface{}{
+		"device_id": device.ID,
+		"user_id":   device.UserID,
+		"online":    status.Online,
+	})
+
+	msg := fmt.Sprintf(lampBackOnlineMsg, device.Name)
+	if !status.Online {
+		msg = fmt.Sprintf(lampOfflineMsg, device.Name, status.LastError)
+	}
+
+	if err := checker.notifier.Notify(ctx, device.UserID, msg); err != nil {
+		return fmt.Errorf("failed to Notify: %w", err)
+	}
+
+	return nil
+}
+
+func (checker *checker) Stop(_ context.Context) error {
+	checker.logger.Debug("Stop health checker", map[string]interface{}{})
+
+	checker.tomb.Kill(nil)
+
+	return checker.tomb.Wait()
+}
@@ -0,0 +1,23 @@
+package healthcheck
+
+import (
+	"time"
+
+	"github.com/almostinf/glow-reminder/config"
+)
+
+type Config struct {
+	CycleDuration time.Duration
+	// ProbeTimeout bounds a single health check of a lamp.
+	ProbeTimeout time.Duration
+	// FailureThreshold is how many failed health checks in a row mark the lamp offline.
+	FailureThreshold int64
+}
+
+func FromAppConfig(appCfg *config.AppConfig) Config {
+	return Config{
+		CycleDuration:    appCfg.HealthCheck.CycleDuration,
+		ProbeTimeout:     appCfg.HealthCheck.ProbeTimeout,
+		FailureThreshold: appCfg.HealthCheck.FailureThreshold,
+	}
+}
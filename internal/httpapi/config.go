@@ -0,0 +1,18 @@
+package httpapi
+
+import (
+	"fmt"
+
+	"github.com/almostinf/glow-reminder/config"
+)
+
+type Config struct {
+	// Addr is the "host:port" the REST API listens on.
+	Addr string
+}
+
+func FromAppConfig(appCfg *config.AppConfig) Config {
+	return Config{
+		Addr: fmt.Sprintf("%s:%d", appCfg.HTTP.Host, appCfg.HTTP.Port),
+	}
+}
@@ -0,0 +1,39 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/google/uuid"
+)
+
+// handleAckDelivery is called back by the lamp once it is lit. It is not authenticated:
+// the random delivery id is only known to the lamp the reminder has been sent to.
+func (server *server) handleAckDelivery(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		server.writeError(w, http.StatusBadRequest, "invalid delivery id")
+		return
+	}
+
+	request := ackRequest{OK: true}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil && !errors.Is(err, io.EOF) {
+		server.writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	err = server.deliveryUsecase.AcknowledgeDelivery(r.Context(), id, request.OK, request.Error)
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		server.writeError(w, http.StatusNotFound, "delivery not found")
+	case errors.Is(err, domain.ErrInvalidDeliveryTransition):
+		server.writeError(w, http.StatusConflict, "delivery is already finished")
+	case err != nil:
+		server.writeInternalError(w, r, "failed to AcknowledgeDelivery", err)
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,77 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+func (server *server) handleGetDevices(w http.ResponseWriter, r *http.Request) {
+	devices, err := server.deviceUsecase.GetDevices(r.Context(), userIDFromContext(r.Context()))
+	if err != nil {
+		server.writeInternalError(w, r, "failed to GetDevices", err)
+		return
+	}
+
+	response := make([]deviceResponse, 0, len(devices))
+	for _, device := range devices {
+		response = append(response, newDeviceResponse(device))
+	}
+
+	server.writeJSON(w, http.StatusOK, response)
+}
+
+// handleGlow lights the lamps with a test reminder the same way a fired reminder does.
+func (server *server) handleGlow(w http.ResponseWriter, r *http.Request) {
+	var request glowRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		server.writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	reminder := server.reminderUsecase.NewDefaultReminder(userIDFromContext(r.Context()))
+	reminder.Msg = "Test glow"
+	reminder.DeviceGroup = request.DeviceGroup
+
+	reminderRequest := reminderRequest{
+		RGB:        request.RGB,
+		Brightness: request.Brightness,
+		Effect:     request.Effect,
+		DeviceID:   request.DeviceID,
+	}
+	if !server.applyReminderRequest(w, r, reminderRequest, &reminder) {
+		return
+	}
+
+	devices, err := server.deviceRegistry.ReminderDevices(r.Context(), &reminder)
+	if err != nil {
+		server.writeInternalError(w, r, "failed to get ReminderDevices", err)
+		return
+	}
+
+	response := glowResponse{
+		Devices: make([]string, 0, len(devices)),
+	}
+
+	var errs []error
+	for _, device := range devices {
+		if err = server.deviceRegistry.Glow(device, &reminder, uuid.Nil); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		response.Devices = append(response.Devices, device.Name)
+	}
+
+	if err = errors.Join(errs...); err != nil {
+		server.logger.Error("failed to glow test reminder", map[string]interface{}{
+			"user_id": reminder.UserID,
+			"error":   err.Error(),
+		})
+		server.writeError(w, http.StatusBadGateway, "failed to reach the lamps: "+err.Error())
+		return
+	}
+
+	server.writeJSON(w, http.StatusOK, response)
+}
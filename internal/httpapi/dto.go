@@ -0,0 +1,275 @@
+package httpapi
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/google/uuid"
+)
+
+var errInvalidRequest = errors.New("invalid request")
+
+var deliveryNames = map[domain.Delivery]string{
+	domain.LightOnly:       "light",
+	domain.MessageOnly:     "message",
+	domain.LightAndMessage: "both",
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+type keyframeDTO struct {
+	RGB        string `json:"rgb"`
+	Brightness int8   `json:"brightness"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+type effectDTO struct {
+	Kind       string        `json:"kind"`
+	PeriodMs   int64         `json:"period_ms,omitempty"`
+	DurationMs int64         `json:"duration_ms,omitempty"`
+	Repeat     int           `json:"repeat,omitempty"`
+	Keyframes  []keyframeDTO `json:"keyframes,omitempty"`
+}
+
+type reminderResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Msg         string     `json:"msg"`
+	ScheduledAt time.Time  `json:"scheduled_at"`
+	Recurrence  string     `json:"recurrence,omitempty"`
+	Delivery    string     `json:"delivery"`
+	RGB         string     `json:"rgb,omitempty"`
+	Brightness  int8       `json:"brightness,omitempty"`
+	Effect      *effectDTO `json:"effect,omitempty"`
+	DeviceID    *uuid.UUID `json:"device_id,omitempty"`
+	DeviceGroup string     `json:"device_group,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// reminderRequest creates a reminder or updates the given fields of an existing one.
+type reminderRequest struct {
+	Msg         *string    `json:"msg"`
+	ScheduledAt *time.Time `json:"scheduled_at"`
+	Recurrence  *string    `json:"recurrence"`
+	Delivery    *string    `json:"delivery"`
+	RGB         *string    `json:"rgb"`
+	Brightness  *int8      `json:"brightness"`
+	Effect      *effectDTO `json:"effect"`
+	DeviceID    *uuid.UUID `json:"device_id"`
+	DeviceGroup *string    `json:"device_group"`
+}
+
+type deviceResponse struct {
+	ID       uuid.UUID `json:"id"`
+	Name     string    `json:"name"`
+	Host     string    `json:"host"`
+	Protocol string    `json:"protocol"`
+	RGB      bool      `json:"rgb"`
+	Effects  bool      `json:"effects"`
+	Group    string    `json:"group,omitempty"`
+}
+
+// glowRequest lights the lamps with a test reminder. The lamps of the user are lit if the device is not set.
+type glowRequest struct {
+	DeviceID    *uuid.UUID `json:"device_id"`
+	DeviceGroup string     `json:"device_group"`
+	RGB         *string    `json:"rgb"`
+	Brightness  *int8      `json:"brightness"`
+	Effect      *effectDTO `json:"effect"`
+}
+
+type glowResponse struct {
+	Devices []string `json:"devices"`
+}
+
+// ackRequest confirms the delivery of a reminder to the lamp. An empty body confirms it too.
+type ackRequest struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+}
+
+func newReminderResponse(reminder *domain.Reminder) reminderResponse {
+	response := reminderResponse{
+		ID:          reminder.ID,
+		Msg:         reminder.Msg,
+		ScheduledAt: reminder.ScheduledAt,
+		Recurrence:  string(reminder.Recurrence),
+		Delivery:    deliveryNames[reminder.Delivery],
+		DeviceGroup: reminder.DeviceGroup,
+		CreatedAt:   reminder.CreatedAt,
+		UpdatedAt:   reminder.UpdatedAt,
+	}
+
+	if response.Delivery == "" {
+		response.Delivery = deliveryNames[domain.LightOnly]
+	}
+
+	if reminder.Delivery.HasLight() {
+		response.RGB = reminder.RGB.Hex()
+		response.Brightness = reminder.Brightness
+		if reminder.Effect.Kind != "" {
+			effect := newEffectDTO(reminder.Effect)
+			response.Effect = &effect
+		}
+	}
+
+	if reminder.DeviceID.Valid {
+		response.DeviceID = &reminder.DeviceID.UUID
+	}
+
+	return response
+}
+
+func newEffectDTO(effect domain.Effect) effectDTO {
+	dto := effectDTO{
+		Kind:       string(effect.Kind),
+		PeriodMs:   effect.Period.Milliseconds(),
+		DurationMs: effect.Duration.Milliseconds(),
+		Repeat:     effect.Repeat,
+	}
+
+	for _, keyframe := range effect.Keyframes {
+		dto.Keyframes = append(dto.Keyframes, keyframeDTO{
+			RGB:        keyframe.RGB.Hex(),
+			Brightness: keyframe.Brightness,
+			DurationMs: keyframe.Duration.Milliseconds(),
+		})
+	}
+
+	return dto
+}
+
+func newDeviceResponse(device *domain.Device) deviceResponse {
+	return deviceResponse{
+		ID:       device.ID,
+		Name:     device.Name,
+		Host:     device.Host,
+		Protocol: string(device.Protocol),
+		RGB:      device.Capabilities.Has(domain.RGBCapability),
+		Effects:  device.Capabilities.Has(domain.EffectsCapability),
+		Group:    device.Group,
+	}
+}
+
+// toDomain converts the effect and checks that the lamp can play it.
+func (dto effectDTO) toDomain() (domain.Effect, error) {
+	effect := domain.Effect{
+		Kind:     domain.EffectKind(dto.Kind),
+		Period:   time.Duration(dto.PeriodMs) * time.Millisecond,
+		Duration: time.Duration(dto.DurationMs) * time.Millisecond,
+		Repeat:   dto.Repeat,
+	}
+
+	for i, keyframe := range dto.Keyframes {
+		rgb, err := parseRGB(keyframe.RGB)
+		if err != nil {
+			return domain.Effect{}, fmt.Errorf("keyframe %d: %w", i+1, err)
+		}
+
+		effect.Keyframes = append(effect.Keyframes, domain.Keyframe{
+			RGB:        rgb,
+			Brightness: keyframe.Brightness,
+			Duration:   time.Duration(keyframe.DurationMs) * time.Millisecond,
+		})
+	}
+
+	if err := effect.Validate(); err != nil {
+		return domain.Effect{}, err
+	}
+
+	return effect, nil
+}
+
+// apply sets the given fields of the request to the reminder.
+func (request reminderRequest) apply(reminder *domain.Reminder, now time.Time) error {
+	if request.Msg != nil {
+		if *request.Msg == "" {
+			return fmt.Errorf("%w: msg must not be empty", errInvalidRequest)
+		}
+		reminder.Msg = *request.Msg
+	}
+
+	if request.ScheduledAt != nil {
+		if !request.ScheduledAt.After(now) {
+			return fmt.Errorf("%w: scheduled_at must be in the future", errInvalidRequest)
+		}
+		// The reminder tasks are queued by whole seconds.
+		reminder.ScheduledAt = request.ScheduledAt.UTC().Truncate(time.Second)
+	}
+
+	if request.Recurrence != nil {
+		recurrence := domain.Recurrence(*request.Recurrence)
+		if recurrence.IsRecurring() {
+			if _, err := recurrence.Parse(); err != nil {
+				return fmt.Errorf("%w: recurrence: %s", errInvalidRequest, err)
+			}
+		}
+		reminder.Recurrence = recurrence
+	}
+
+	if request.Delivery != nil {
+		delivery, err := parseDelivery(*request.Delivery)
+		if err != nil {
+			return err
+		}
+		reminder.Delivery = delivery
+	}
+
+	if request.RGB != nil {
+		rgb, err := parseRGB(*request.RGB)
+		if err != nil {
+			return err
+		}
+		reminder.RGB = rgb
+		reminder.Colour = rgb.LegacyColour()
+	}
+
+	if request.Brightness != nil {
+		if err := domain.ValidateBrightness(*request.Brightness); err != nil {
+			return fmt.Errorf("%w: %s", errInvalidRequest, err)
+		}
+		reminder.Brightness = *request.Brightness
+	}
+
+	if request.Effect != nil {
+		effect, err := request.Effect.toDomain()
+		if err != nil {
+			return fmt.Errorf("%w: effect: %s", errInvalidRequest, err)
+		}
+		reminder.Effect = effect
+		reminder.Mode = effect.LegacyMode()
+	}
+
+	if request.DeviceID != nil {
+		reminder.DeviceID = uuid.NullUUID{UUID: *request.DeviceID, Valid: *request.DeviceID != uuid.Nil}
+	}
+
+	if request.DeviceGroup != nil {
+		reminder.DeviceGroup = *request.DeviceGroup
+	}
+
+	return nil
+}
+
+func parseDelivery(s string) (domain.Delivery, error) {
+	for delivery, name := range deliveryNames {
+		if name == s {
+			return delivery, nil
+		}
+	}
+
+	return domain.UnknownDelivery, fmt.Errorf("%w: delivery must be light, message or both", errInvalidRequest)
+}
+
+func parseRGB(s string) (domain.RGB, error) {
+	rgb, err := domain.ParseRGB(s)
+	if err != nil {
+		return domain.NoRGB, fmt.Errorf("%w: %s", errInvalidRequest, err)
+	}
+
+	return rgb, nil
+}
@@ -0,0 +1,187 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/usecase"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+func (server *server) handleGetReminders(w http.ResponseWriter, r *http.Request) {
+	offset, err := queryUint(r, "offset", 0)
+	if err != nil {
+		server.writeError(w, http.StatusBadRequest, "invalid offset")
+		return
+	}
+
+	limit, err := queryUint(r, "limit", defaultLimit)
+	if err != nil || limit == 0 || limit > maxLimit {
+		server.writeError(w, http.StatusBadRequest, "limit must be from 1 to "+strconv.Itoa(maxLimit))
+		return
+	}
+
+	reminders, err := server.reminderUsecase.GetReminders(r.Context(), domain.GetRemindersParams{
+		UserID: userIDFromContext(r.Context()),
+		Offset: offset,
+		Limit:  limit,
+	})
+	if err != nil {
+		server.writeInternalError(w, r, "failed to GetReminders", err)
+		return
+	}
+
+	response := make([]reminderResponse, 0, len(reminders))
+	for _, reminder := range reminders {
+		response = append(response, newReminderResponse(reminder))
+	}
+
+	server.writeJSON(w, http.StatusOK, response)
+}
+
+func (server *server) handleGetReminder(w http.ResponseWriter, r *http.Request) {
+	reminder, ok := server.getOwnReminder(w, r)
+	if !ok {
+		return
+	}
+
+	server.writeJSON(w, http.StatusOK, newReminderResponse(reminder))
+}
+
+func (server *server) handleCreateReminder(w http.ResponseWriter, r *http.Request) {
+	var request reminderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		server.writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	if request.Msg == nil || request.ScheduledAt == nil {
+		server.writeError(w, http.StatusBadRequest, "msg and scheduled_at are required")
+		return
+	}
+
+	reminder := server.reminderUsecase.NewDefaultReminder(userIDFromContext(r.Context()))
+
+	if !server.applyReminderRequest(w, r, request, &reminder) {
+		return
+	}
+
+	if err := server.reminderUsecase.CreateReminder(r.Context(), reminder); err != nil {
+		server.writeReminderError(w, r, "failed to CreateReminder", err)
+		return
+	}
+
+	server.writeJSON(w, http.StatusCreated, newReminderResponse(&reminder))
+}
+
+func (server *server) handleUpdateReminder(w http.ResponseWriter, r *http.Request) {
+	reminder, ok := server.getOwnReminder(w, r)
+	if !ok {
+		return
+	}
+
+	var request reminderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		server.writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	if !server.applyReminderRequest(w, r, request, reminder) {
+		return
+	}
+
+	reminder.UpdatedAt = server.clock.NowUTC()
+
+	if err := server.reminderUsecase.UpdateReminder(r.Context(), *reminder); err != nil {
+		server.writeReminderError(w, r, "failed to UpdateReminder", err)
+		return
+	}
+
+	server.writeJSON(w, http.StatusOK, newReminderResponse(reminder))
+}
+
+func (server *server) handleDeleteReminder(w http.ResponseWriter, r *http.Request) {
+	reminder, ok := server.getOwnReminder(w, r)
+	if !ok {
+		return
+	}
+
+	if err := server.reminderUsecase.DeleteReminder(r.Context(), reminder.UserID, reminder.ID); err != nil {
+		server.writeInternalError(w, r, "failed to DeleteReminder", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getOwnReminder writes 404 if the reminder does not exist or belongs to another user.
+func (server *server) getOwnReminder(w http.ResponseWriter, r *http.Request) (*domain.Reminder, bool) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		server.writeError(w, http.StatusBadRequest, "invalid reminder id")
+		return nil, false
+	}
+
+	reminder, err := server.reminderUsecase.GetOwnReminder(r.Context(), userIDFromContext(r.Context()), id)
+	if errors.Is(err, domain.ErrNotFound) {
+		server.writeError(w, http.StatusNotFound, "reminder not found")
+		return nil, false
+	}
+	if err != nil {
+		server.writeInternalError(w, r, "failed to GetReminder", err)
+		return nil, false
+	}
+
+	return reminder, true
+}
+
+// applyReminderRequest writes 400 if the request is invalid or targets a lamp of another user.
+func (server *server) applyReminderRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+	request reminderRequest,
+	reminder *domain.Reminder,
+) bool {
+	if err := request.apply(reminder, server.clock.NowUTC()); err != nil {
+		server.writeError(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+
+	err := server.deviceUsecase.CheckDeviceOwner(r.Context(), reminder)
+	if errors.Is(err, usecase.ErrUnknownDevice) {
+		server.writeError(w, http.StatusBadRequest, "unknown device_id")
+		return false
+	}
+	if err != nil {
+		server.writeInternalError(w, r, "failed to CheckDeviceOwner", err)
+		return false
+	}
+
+	return true
+}
+
+func (server *server) writeReminderError(w http.ResponseWriter, r *http.Request, msg string, err error) {
+	if errors.Is(err, domain.ErrInvalidEffect) {
+		server.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	server.writeInternalError(w, r, msg, err)
+}
+
+func queryUint(r *http.Request, name string, defaultValue uint64) (uint64, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	return strconv.ParseUint(value, 10, 64)
+}
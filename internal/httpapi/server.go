@@ -0,0 +1,196 @@
+package httpapi
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/almostinf/glow-reminder/internal/device"
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/usecase"
+	"github.com/almostinf/glow-reminder/pkg/clock"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// bearerPrefix starts the Authorization header with a personal access token issued by the /token bot command.
+const bearerPrefix = "Bearer "
+
+//go:embed openapi.yaml
+var openAPISpec []byte
+
+var _ Server = (*server)(nil)
+
+// Server is the REST API for managing reminders without Telegram.
+type Server interface {
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+}
+
+type server struct {
+	cfg             Config
+	httpServer      *http.Server
+	logger          logger.Logger
+	reminderUsecase usecase.ReminderUsecase
+	deviceUsecase   usecase.DeviceUsecase
+	tokenUsecase    usecase.TokenUsecase
+	deliveryUsecase usecase.DeliveryUsecase
+	deviceRegistry  device.Registry
+	clock           clock.Clock
+}
+
+func New(
+	cfg Config,
+	logger logger.Logger,
+	reminderUsecase usecase.ReminderUsecase,
+	deviceUsecase usecase.DeviceUsecase,
+	tokenUsecase usecase.TokenUsecase,
+	deliveryUsecase usecase.DeliveryUsecase,
+	deviceRegistry device.Registry,
+	clock clock.Clock,
+) *server {
+	server := &server{
+		cfg:             cfg,
+		logger:          logger,
+		reminderUsecase: reminderUsecase,
+		deviceUsecase:   deviceUsecase,
+		tokenUsecase:    tokenUsecase,
+		deliveryUsecase: deliveryUsecase,
+		deviceRegistry:  deviceRegistry,
+		clock:           clock,
+	}
+
+	server.httpServer = &http.Server{
+		Addr:    cfg.Addr,
+		Handler: server.Handler(),
+	}
+
+	return server
+}
+
+// Handler returns the routes of the REST API.
+func (server *server) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /v1/openapi.yaml", server.handleOpenAPISpec)
+
+	mux.Handle("GET /v1/reminders", server.authenticate(domain.ReadScope, server.handleGetReminders))
+	mux.Handle("POST /v1/reminders", server.authenticate(domain.WriteScope, server.handleCreateReminder))
+	mux.Handle("GET /v1/reminders/{id}", server.authenticate(domain.ReadScope, server.handleGetReminder))
+	mux.Handle("PATCH /v1/reminders/{id}", server.authenticate(domain.WriteScope, server.handleUpdateReminder))
+	mux.Handle("DELETE /v1/reminders/{id}", server.authenticate(domain.WriteScope, server.handleDeleteReminder))
+
+	mux.Handle("GET /v1/devices", server.authenticate(domain.ReadScope, server.handleGetDevices))
+	mux.Handle("POST /v1/glow", server.authenticate(domain.TriggerScope, server.handleGlow))
+
+	mux.HandleFunc("POST /v1/deliveries/{id}/ack", server.handleAckDelivery)
+
+	mux.Handle("GET /metrics", promhttp.Handler())
+
+	return mux
+}
+
+func (server *server) Start(_ context.Context) error {
+	// The listener is opened synchronously, so a busy port fails the application start.
+	listener, err := net.Listen("tcp", server.cfg.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen %s: %w", server.cfg.Addr, err)
+	}
+
+	server.logger.Info("Start HTTP API server", map[string]interface{}{
+		"addr": server.cfg.Addr,
+	})
+
+	go func() {
+		if err := server.httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			server.logger.Error("failed to serve HTTP API", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+	}()
+
+	return nil
+}
+
+func (server *server) Stop(ctx context.Context) error {
+	if err := server.httpServer.Shutdown(ctx); err != nil {
+		return fmt.Errorf("failed to shutdown HTTP API server: %w", err)
+	}
+
+	return nil
+}
+
+type userIDKey struct{}
+
+// authenticate resolves the user of the request by the bearer token
+// and rejects the requests without a valid token having the scope.
+func (server *server) authenticate(scope domain.TokenScopes, next http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		secret, ok := strings.CutPrefix(r.Header.Get("Authorization"), bearerPrefix)
+		if !ok {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			server.writeError(w, http.StatusUnauthorized, "missing bearer token")
+			return
+		}
+
+		token, err := server.tokenUsecase.Authenticate(r.Context(), strings.TrimSpace(secret))
+		if errors.Is(err, domain.ErrInvalidToken) {
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			server.writeError(w, http.StatusUnauthorized, "invalid, revoked or expired token")
+			return
+		}
+		if err != nil {
+			server.writeInternalError(w, r, "failed to Authenticate", err)
+			return
+		}
+
+		if !token.Scopes.Has(scope) {
+			server.writeError(w, http.StatusForbidden, "the token lacks the "+scope.String()+" scope")
+			return
+		}
+
+		next(w, r.WithContext(context.WithValue(r.Context(), userIDKey{}, token.UserID)))
+	})
+}
+
+func userIDFromContext(ctx context.Context) int64 {
+	userID, _ := ctx.Value(userIDKey{}).(int64)
+	return userID
+}
+
+func (server *server) handleOpenAPISpec(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/yaml")
+	_, _ = w.Write(openAPISpec)
+}
+
+func (server *server) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		server.logger.Error("failed to encode response", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+}
+
+func (server *server) writeError(w http.ResponseWriter, status int, msg string) {
+	server.writeJSON(w, status, errorResponse{Error: msg})
+}
+
+// writeInternalError logs the error and hides its details from the client.
+func (server *server) writeInternalError(w http.ResponseWriter, r *http.Request, msg string, err error) {
+	server.logger.Error(msg, map[string]interface{}{
+		"user_id": userIDFromContext(r.Context()),
+		"method":  r.Method,
+		"path":    r.URL.Path,
+		"error":   err.Error(),
+	})
+
+	server.writeError(w, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError))
+}
@@ -0,0 +1,387 @@
+package httpapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	device_mocks "github.com/almostinf/glow-reminder/internal/device/mocks"
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/httpapi"
+	"github.com/almostinf/glow-reminder/internal/usecase"
+	usecase_mocks "github.com/almostinf/glow-reminder/internal/usecase/mocks"
+	clock_mocks "github.com/almostinf/glow-reminder/pkg/clock/mocks"
+	logger_mocks "github.com/almostinf/glow-reminder/pkg/logger/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	userID = int64(42)
+
+	fullToken     = "glr_full"
+	readOnlyToken = "glr_read"
+)
+
+type serverMocks struct {
+	reminderUsecase *usecase_mocks.MockReminderUsecase
+	deviceUsecase   *usecase_mocks.MockDeviceUsecase
+	tokenUsecase    *usecase_mocks.MockTokenUsecase
+	deliveryUsecase *usecase_mocks.MockDeliveryUsecase
+	deviceRegistry  *device_mocks.MockRegistry
+}
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 4, 15, 12, 0, 0, 0, time.UTC)
+	reminderID := uuid.New()
+	deliveryID := uuid.New()
+
+	ownReminder := &domain.Reminder{
+		ID:          reminderID,
+		UserID:      userID,
+		Msg:         "water the plants",
+		ScheduledAt: now.Add(time.Hour),
+		RGB:         domain.RedRGB,
+		Brightness:  domain.MaxBrightness,
+		Effect:      domain.DefaultEffect(domain.StaticEffect),
+		Delivery:    domain.LightOnly,
+	}
+
+	kitchen := &domain.Device{ID: uuid.New(), UserID: userID, Name: "kitchen"}
+
+	testcases := []struct {
+		name           string
+		method         string
+		path           string
+		token          string
+		body           string
+		mock           func(serverMocks)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "missing token",
+			method:         http.MethodGet,
+			path:           "/v1/reminders",
+			mock:           func(serverMocks) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   "bearer token",
+		},
+		{
+			name:           "revoked token",
+			method:         http.MethodGet,
+			path:           "/v1/reminders",
+			token:          "glr_revoked",
+			mock:           func(serverMocks) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   "revoked",
+		},
+		{
+			name:           "token without write scope",
+			method:         http.MethodDelete,
+			path:           "/v1/reminders/" + reminderID.String(),
+			token:          readOnlyToken,
+			mock:           func(serverMocks) {},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   "write",
+		},
+		{
+			name:           "token without trigger scope",
+			method:         http.MethodPost,
+			path:           "/v1/glow",
+			token:          readOnlyToken,
+			body:           `{}`,
+			mock:           func(serverMocks) {},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   "trigger",
+		},
+		{
+			name:   "list reminders",
+			method: http.MethodGet,
+			path:   "/v1/reminders?limit=5",
+			token:  fullToken,
+			mock: func(mocks serverMocks) {
+				mocks.reminderUsecase.EXPECT().
+					GetReminders(gomock.Any(), domain.GetRemindersParams{UserID: userID, Limit: 5}).
+					Return([]*domain.Reminder{ownReminder}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "water the plants",
+		},
+		{
+			name:           "limit out of range",
+			method:         http.MethodGet,
+			path:           "/v1/reminders?limit=1000",
+			token:          fullToken,
+			mock:           func(serverMocks) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "create without scheduled_at",
+			method:         http.MethodPost,
+			path:           "/v1/reminders",
+			token:          fullToken,
+			body:           `{"msg":"water the plants"}`,
+			mock:           func(serverMocks) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "scheduled_at",
+		},
+		{
+			name:           "create in the past",
+			method:         http.MethodPost,
+			path:           "/v1/reminders",
+			token:          fullToken,
+			body:           `{"msg":"water the plants","scheduled_at":"2025-04-15T11:00:00Z"}`,
+			mock:           func(serverMocks) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "create reminder",
+			method: http.MethodPost,
+			path:   "/v1/reminders",
+			token:  fullToken,
+			body:   `{"msg":"water the plants","scheduled_at":"2025-04-15T13:00:00Z","rgb":"#00FF00"}`,
+			mock: func(mocks serverMocks) {
+				mocks.reminderUsecase.EXPECT().
+					CreateReminder(gomock.Any(), gomock.Cond(func(x any) bool {
+						reminder, ok := x.(domain.Reminder)
+						return ok && reminder.UserID == userID && reminder.RGB == domain.RGB(0x00FF00)
+					})).
+					Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `"rgb":"#00FF00"`,
+		},
+		{
+			name:   "create with a lamp of another user",
+			method: http.MethodPost,
+			path:   "/v1/reminders",
+			token:  fullToken,
+			body:   `{"msg":"water the plants","scheduled_at":"2025-04-15T13:00:00Z","device_id":"` + kitchen.ID.String() + `"}`,
+			mock: func(mocks serverMocks) {
+				mocks.deviceUsecase.EXPECT().CheckDeviceOwner(gomock.Any(), gomock.Any()).Return(usecase.ErrUnknownDevice)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "device_id",
+		},
+		{
+			name:   "get reminder of another user",
+			method: http.MethodGet,
+			path:   "/v1/reminders/" + reminderID.String(),
+			token:  fullToken,
+			mock: func(mocks serverMocks) {
+				mocks.reminderUsecase.EXPECT().GetOwnReminder(gomock.Any(), userID, reminderID).Return(nil, domain.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:   "get missing reminder",
+			method: http.MethodGet,
+			path:   "/v1/reminders/" + reminderID.String(),
+			token:  fullToken,
+			mock: func(mocks serverMocks) {
+				mocks.reminderUsecase.EXPECT().GetOwnReminder(gomock.Any(), userID, reminderID).Return(nil, domain.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:   "update reminder",
+			method: http.MethodPatch,
+			path:   "/v1/reminders/" + reminderID.String(),
+			token:  fullToken,
+			body:   `{"msg":"feed the cat"}`,
+			mock: func(mocks serverMocks) {
+				reminder := *ownReminder
+				mocks.reminderUsecase.EXPECT().GetOwnReminder(gomock.Any(), userID, reminderID).Return(&reminder, nil)
+				mocks.reminderUsecase.EXPECT().UpdateReminder(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "feed the cat",
+		},
+		{
+			name:   "delete reminder",
+			method: http.MethodDelete,
+			path:   "/v1/reminders/" + reminderID.String(),
+			token:  fullToken,
+			mock: func(mocks serverMocks) {
+				mocks.reminderUsecase.EXPECT().GetOwnReminder(gomock.Any(), userID, reminderID).Return(ownReminder, nil)
+				mocks.reminderUsecase.EXPECT().DeleteReminder(gomock.Any(), userID, reminderID).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:   "list devices",
+			method: http.MethodGet,
+			path:   "/v1/devices",
+			token:  fullToken,
+			mock: func(mocks serverMocks) {
+				mocks.deviceUsecase.EXPECT().GetDevices(gomock.Any(), userID).Return([]*domain.Device{kitchen}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "kitchen",
+		},
+		{
+			name:   "glow",
+			method: http.MethodPost,
+			path:   "/v1/glow",
+			token:  fullToken,
+			body:   `{"rgb":"#0000FF"}`,
+			mock: func(mocks serverMocks) {
+				mocks.deviceRegistry.EXPECT().ReminderDevices(gomock.Any(), gomock.Any()).Return([]*domain.Device{kitchen}, nil)
+				mocks.deviceRegistry.EXPECT().Glow(kitchen, gomock.Any(), uuid.Nil).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"devices":["kitchen"]}`,
+		},
+		{
+			name:   "glow with unreachable lamp",
+			method: http.MethodPost,
+			path:   "/v1/glow",
+			token:  fullToken,
+			body:   `{}`,
+			mock: func(mocks serverMocks) {
+				mocks.deviceRegistry.EXPECT().ReminderDevices(gomock.Any(), gomock.Any()).Return([]*domain.Device{kitchen}, nil)
+				mocks.deviceRegistry.EXPECT().Glow(kitchen, gomock.Any(), uuid.Nil).Return(errors.New("connection refused"))
+			},
+			expectedStatus: http.StatusBadGateway,
+			expectedBody:   "connection refused",
+		},
+		{
+			name:   "ack delivery without token",
+			method: http.MethodPost,
+			path:   "/v1/deliveries/" + deliveryID.String() + "/ack",
+			body:   `{"ok":true}`,
+			mock: func(mocks serverMocks) {
+				mocks.deliveryUsecase.EXPECT().AcknowledgeDelivery(gomock.Any(), deliveryID, true, "").Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:   "ack delivery with empty body",
+			method: http.MethodPost,
+			path:   "/v1/deliveries/" + deliveryID.String() + "/ack",
+			mock: func(mocks serverMocks) {
+				mocks.deliveryUsecase.EXPECT().AcknowledgeDelivery(gomock.Any(), deliveryID, true, "").Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:   "lamp failed to light",
+			method: http.MethodPost,
+			path:   "/v1/deliveries/" + deliveryID.String() + "/ack",
+			body:   `{"ok":false,"error":"unknown effect"}`,
+			mock: func(mocks serverMocks) {
+				mocks.deliveryUsecase.EXPECT().
+					AcknowledgeDelivery(gomock.Any(), deliveryID, false, "unknown effect").
+					Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:   "ack finished delivery",
+			method: http.MethodPost,
+			path:   "/v1/deliveries/" + deliveryID.String() + "/ack",
+			mock: func(mocks serverMocks) {
+				mocks.deliveryUsecase.EXPECT().
+					AcknowledgeDelivery(gomock.Any(), deliveryID, true, "").
+					Return(domain.ErrInvalidDeliveryTransition)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:   "ack unknown delivery",
+			method: http.MethodPost,
+			path:   "/v1/deliveries/" + deliveryID.String() + "/ack",
+			mock: func(mocks serverMocks) {
+				mocks.deliveryUsecase.EXPECT().
+					AcknowledgeDelivery(gomock.Any(), deliveryID, true, "").
+					Return(domain.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			mocks := serverMocks{
+				reminderUsecase: usecase_mocks.NewMockReminderUsecase(mockCtrl),
+				deviceUsecase:   usecase_mocks.NewMockDeviceUsecase(mockCtrl),
+				tokenUsecase:    usecase_mocks.NewMockTokenUsecase(mockCtrl),
+				deliveryUsecase: usecase_mocks.NewMockDeliveryUsecase(mockCtrl),
+				deviceRegistry:  device_mocks.NewMockRegistry(mockCtrl),
+			}
+			testcase.mock(mocks)
+
+			// The usecases fill the defaults of a new reminder and check its lamp, the cases only set their failures.
+			mocks.reminderUsecase.EXPECT().NewDefaultReminder(userID).DoAndReturn(func(userID int64) domain.Reminder {
+				return domain.Reminder{
+					ID:         uuid.New(),
+					UserID:     userID,
+					Colour:     domain.Red,
+					RGB:        domain.RedRGB,
+					Brightness: domain.MaxBrightness,
+					Mode:       domain.Static,
+					Effect:     domain.DefaultEffect(domain.StaticEffect),
+					Delivery:   domain.LightOnly,
+					CreatedAt:  now,
+					UpdatedAt:  now,
+				}
+			}).AnyTimes()
+			mocks.deviceUsecase.EXPECT().CheckDeviceOwner(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			mocks.tokenUsecase.EXPECT().Authenticate(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, secret string) (*domain.Token, error) {
+					switch secret {
+					case fullToken:
+						return &domain.Token{UserID: userID, Scopes: domain.AllScopes}, nil
+					case readOnlyToken:
+						return &domain.Token{UserID: userID, Scopes: domain.ReadScope}, nil
+					default:
+						return nil, domain.ErrInvalidToken
+					}
+				}).
+				AnyTimes()
+
+			log := logger_mocks.NewMockLogger(mockCtrl)
+			log.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+			clk := clock_mocks.NewMockClock(mockCtrl)
+			clk.EXPECT().NowUTC().Return(now).AnyTimes()
+
+			server := httpapi.New(
+				httpapi.Config{},
+				log,
+				mocks.reminderUsecase,
+				mocks.deviceUsecase,
+				mocks.tokenUsecase,
+				mocks.deliveryUsecase,
+				mocks.deviceRegistry,
+				clk,
+			)
+
+			request := httptest.NewRequest(testcase.method, testcase.path, strings.NewReader(testcase.body))
+			if testcase.token != "" {
+				request.Header.Set("Authorization", "Bearer "+testcase.token)
+			}
+			recorder := httptest.NewRecorder()
+
+			server.Handler().ServeHTTP(recorder, request)
+
+			assert.Equal(t, testcase.expectedStatus, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), testcase.expectedBody)
+		})
+	}
+}
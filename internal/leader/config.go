@@ -0,0 +1,33 @@
+package leader
+
+import (
+	"os"
+	"time"
+
+	"github.com/almostinf/glow-reminder/config"
+	"github.com/google/uuid"
+)
+
+type Config struct {
+	// InstanceID tells the instances apart in the leader lock.
+	InstanceID string
+	// LockTTL is how long the lock outlives a leader that has stopped renewing it. The leader renews it every third of the TTL.
+	LockTTL time.Duration
+	// RetryInterval is how often a standby instance tries to take the lead.
+	RetryInterval time.Duration
+}
+
+func FromAppConfig(appCfg *config.AppConfig) Config {
+	instanceID := appCfg.Leader.InstanceID
+	if instanceID == "" {
+		instanceID, _ = os.Hostname()
+		// The random suffix keeps the instances apart even if they share the host name.
+		instanceID += "-" + uuid.NewString()[:8]
+	}
+
+	return Config{
+		InstanceID:    instanceID,
+		LockTTL:       appCfg.Leader.LockTTL,
+		RetryInterval: appCfg.Leader.RetryInterval,
+	}
+}
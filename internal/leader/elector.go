@@ -0,0 +1,202 @@
+package leader
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/repository/redis"
+	"github.com/almostinf/glow-reminder/pkg/clock"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"gopkg.in/tomb.v2"
+)
+
+//go:generate mockgen -package mocks -destination mocks/leader_mocks.go github.com/almostinf/glow-reminder/internal/leader Elector
+
+// Elector makes a single one of the running instances the leader, which runs the background workers,
+// while the other instances stand by and take over once the leader stops renewing its lock.
+type Elector interface {
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+	// IsLeader reports whether the instance leads now.
+	IsLeader() bool
+	// FencingToken returns the fencing token of the current term if the instance leads now.
+	FencingToken() (int64, bool)
+}
+
+type elector struct {
+	cfg            Config
+	leaderLockRepo redis.LeaderLockRepo
+	clock          clock.Clock
+	logger         logger.Logger
+	tomb           tomb.Tomb
+
+	m            sync.RWMutex
+	fencingToken int64
+	renewedAt    time.Time
+}
+
+func New(cfg Config, leaderLockRepo redis.LeaderLockRepo, clock clock.Clock, logger logger.Logger) *elector {
+	return &elector{
+		cfg:            cfg,
+		leaderLockRepo: leaderLockRepo,
+		clock:          clock,
+		logger:         logger,
+		tomb:           tomb.Tomb{},
+	}
+}
+
+func (elector *elector) Start(ctx context.Context) error {
+	elector.logger.Debug("Start leader elector", map[string]interface{}{
+		"instance_id": elector.cfg.InstanceID,
+	})
+
+	ctx = context.WithoutCancel(ctx)
+
+	elector.tomb.Go(func() error {
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-elector.tomb.Dying():
+				elector.release(ctx)
+				return nil
+			case <-timer.C:
+				elector.elect(ctx)
+				timer.Reset(elector.interval())
+			}
+		}
+	})
+
+	return nil
+}
+
+func (elector *elector) IsLeader() bool {
+	_, ok := elector.FencingToken()
+	return ok
+}
+
+func (elector *elector) FencingToken() (int64, bool) {
+	elector.m.RLock()
+	defer elector.m.RUnlock()
+
+	return elector.fencingToken, elector.fencingToken != 0
+}
+
+// interval is how long to wait before the next attempt to take or keep the lead.
+func (elector *elector) interval() time.Duration {
+	if elector.IsLeader() {
+		return elector.cfg.LockTTL / 3
+	}
+
+	return elector.cfg.RetryInterval
+}
+
+// elect renews the lock of the leader or tries to take the lead for a standby instance.
+func (elector *elector) elect(ctx context.Context) {
+	if elector.IsLeader() {
+		elector.renew(ctx)
+		return
+	}
+
+	token, ok, err := elector.leaderLockRepo.AcquireLeaderLock(ctx, elector.cfg.InstanceID, elector.cfg.LockTTL)
+	if err != nil {
+		elector.logger.Error("failed to AcquireLeaderLock", map[string]interface{}{
+			"instance_id": elector.cfg.InstanceID,
+			"error":       err.Error(),
+		})
+		return
+	}
+
+	if !ok {
+		return
+	}
+
+	elector.setFencingToken(token, elector.clock.NowUTC())
+
+	isLeaderGauge.Set(1)
+	fencingTokenGauge.Set(float64(token))
+	leadershipChangesCounter.WithLabelValues(acquiredEvent).Inc()
+
+	elector.logger.Info("Leadership acquired", map[string]interface{}{
+		"instance_id":   elector.cfg.InstanceID,
+		"fencing_token": token,
+	})
+}
+
+// renew extends the lock of the leader. The leader steps down once the lock is taken by another instance
+// or it has failed to renew the lock for the whole TTL, since the lock may have been taken by now.
+func (elector *elector) renew(ctx context.Context) {
+	now := elector.clock.NowUTC()
+
+	renewed, err := elector.leaderLockRepo.RenewLeaderLock(ctx, elector.cfg.InstanceID, elector.cfg.LockTTL)
+	if err != nil {
+		elector.logger.Error("failed to RenewLeaderLock", map[string]interface{}{
+			"instance_id": elector.cfg.InstanceID,
+			"error":       err.Error(),
+		})
+
+		elector.m.RLock()
+		expired := now.Sub(elector.renewedAt) >= elector.cfg.LockTTL
+		elector.m.RUnlock()
+
+		if expired {
+			elector.stepDown(lostEvent)
+		}
+		return
+	}
+
+	if !renewed {
+		elector.stepDown(lostEvent)
+		return
+	}
+
+	elector.m.Lock()
+	elector.renewedAt = now
+	elector.m.Unlock()
+}
+
+// release gives the lead away on shutdown, so that a standby instance takes over without waiting for the lock to expire.
+func (elector *elector) release(ctx context.Context) {
+	if !elector.IsLeader() {
+		return
+	}
+
+	elector.stepDown(releasedEvent)
+
+	if err := elector.leaderLockRepo.ReleaseLeaderLock(ctx, elector.cfg.InstanceID); err != nil {
+		elector.logger.Error("failed to ReleaseLeaderLock", map[string]interface{}{
+			"instance_id": elector.cfg.InstanceID,
+			"error":       err.Error(),
+		})
+	}
+}
+
+func (elector *elector) stepDown(event string) {
+	elector.setFencingToken(0, time.Time{})
+
+	isLeaderGauge.Set(0)
+	leadershipChangesCounter.WithLabelValues(event).Inc()
+
+	elector.logger.Warn("Step down from leadership", map[string]interface{}{
+		"instance_id": elector.cfg.InstanceID,
+		"event":       event,
+	})
+}
+
+func (elector *elector) setFencingToken(token int64, renewedAt time.Time) {
+	elector.m.Lock()
+	defer elector.m.Unlock()
+
+	elector.fencingToken = token
+	elector.renewedAt = renewedAt
+}
+
+func (elector *elector) Stop(_ context.Context) error {
+	elector.logger.Debug("Stop leader elector", map[string]interface{}{})
+
+	elector.tomb.Kill(nil)
+
+	return elector.tomb.Wait()
+}
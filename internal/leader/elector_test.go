@@ -0,0 +1,161 @@
+package leader_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/almostinf/glow-reminder/internal/leader"
+	"github.com/almostinf/glow-reminder/internal/repository/redis"
+	"github.com/almostinf/glow-reminder/pkg/clock"
+	logger_mocks "github.com/almostinf/glow-reminder/pkg/logger/mocks"
+	rediswrapper "github.com/almostinf/glow-reminder/pkg/redis"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	lockTTL       = 300 * time.Millisecond
+	retryInterval = 20 * time.Millisecond
+	waitFor       = 2 * time.Second
+	tick          = 10 * time.Millisecond
+)
+
+type testRedis struct {
+	server *miniredis.Miniredis
+	client *rediswrapper.Redis
+}
+
+func newTestRedis(t *testing.T) testRedis {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+
+	client := goredis.NewClient(&goredis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	return testRedis{
+		server: server,
+		client: &rediswrapper.Redis{Client: client},
+	}
+}
+
+func startElector(t *testing.T, testRedis testRedis, instanceID string) leader.Elector {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	log := logger_mocks.NewMockLogger(mockCtrl)
+	log.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	log.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	log.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	log.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	elector := leader.New(leader.Config{
+		InstanceID:    instanceID,
+		LockTTL:       lockTTL,
+		RetryInterval: retryInterval,
+	}, redis.NewLeaderLockRepo(testRedis.client, log), clock.New(), log)
+
+	require.NoError(t, elector.Start(context.Background()))
+	t.Cleanup(func() { _ = elector.Stop(context.Background()) })
+
+	return elector
+}
+
+func TestElector(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name string
+		// loseLead makes the first leader lose the lead.
+		loseLead func(t *testing.T, testRedis testRedis, first leader.Elector)
+	}{
+		{
+			name: "leader stops",
+			loseLead: func(t *testing.T, _ testRedis, first leader.Elector) {
+				require.NoError(t, first.Stop(context.Background()))
+			},
+		},
+		{
+			name: "lock expires",
+			loseLead: func(t *testing.T, testRedis testRedis, _ leader.Elector) {
+				testRedis.server.Del("leader-lock")
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testRedis := newTestRedis(t)
+
+			first := startElector(t, testRedis, "first")
+			require.Eventually(t, first.IsLeader, waitFor, tick)
+
+			firstToken, ok := first.FencingToken()
+			require.True(t, ok)
+
+			second := startElector(t, testRedis, "second")
+			assert.Never(t, second.IsLeader, 3*retryInterval, tick)
+
+			testcase.loseLead(t, testRedis, first)
+
+			require.Eventually(t, second.IsLeader, waitFor, tick)
+			require.Eventually(t, func() bool { return !first.IsLeader() }, waitFor, tick)
+
+			secondToken, ok := second.FencingToken()
+			require.True(t, ok)
+			assert.Greater(t, secondToken, firstToken)
+		})
+	}
+}
+
+func TestClaimReminderTasksFencing(t *testing.T) {
+	t.Parallel()
+
+	testRedis := newTestRedis(t)
+
+	mockCtrl := gomock.NewController(t)
+
+	log := logger_mocks.NewMockLogger(mockCtrl)
+
+	leaderLockRepo := redis.NewLeaderLockRepo(testRedis.client, log)
+	reminderTaskRepo := redis.NewReminderTaskRepo(testRedis.client, log)
+
+	ctx := context.Background()
+
+	formerToken, ok, err := leaderLockRepo.AcquireLeaderLock(ctx, "former", lockTTL)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, ok, err = leaderLockRepo.AcquireLeaderLock(ctx, "next", lockTTL)
+	require.NoError(t, err)
+	require.False(t, ok, "the lock is held by the former leader")
+
+	renewed, err := leaderLockRepo.RenewLeaderLock(ctx, "next", lockTTL)
+	require.NoError(t, err)
+	require.False(t, renewed, "only the holder renews the lock")
+
+	testRedis.server.FastForward(lockTTL)
+
+	nextToken, ok, err := leaderLockRepo.AcquireLeaderLock(ctx, "next", lockTTL)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Greater(t, nextToken, formerToken)
+
+	now := time.Now().Unix()
+
+	_, err = reminderTaskRepo.ClaimReminderTasks(ctx, now, time.Minute, formerToken)
+	assert.ErrorIs(t, err, redis.ErrStaleFencingToken)
+
+	reminderTasks, err := reminderTaskRepo.ClaimReminderTasks(ctx, now, time.Minute, nextToken)
+	assert.NoError(t, err)
+	assert.Empty(t, reminderTasks)
+}
@@ -0,0 +1,30 @@
+package leader
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	isLeaderGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "glow_reminder",
+		Name:      "leader",
+		Help:      "Whether the instance is the leader running the background workers.",
+	})
+	fencingTokenGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "glow_reminder",
+		Name:      "leader_fencing_token",
+		Help:      "The fencing token of the last term the instance has led.",
+	})
+	leadershipChangesCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "glow_reminder",
+		Name:      "leadership_changes_total",
+		Help:      "The number of times the instance has acquired or lost the leadership.",
+	}, []string{"event"})
+)
+
+const (
+	acquiredEvent = "acquired"
+	lostEvent     = "lost"
+	releasedEvent = "released"
+)
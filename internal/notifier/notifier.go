@@ -0,0 +1,28 @@
+package notifier
+
+import (
+	"context"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+)
+
+// The buttons attached to a fired reminder have callback data
+// "done_reminder:<id>" and "snooze_reminder:<id>:<minutes>".
+const (
+	DoneReminderUnique   = "done_reminder"
+	SnoozeReminderUnique = "snooze_reminder"
+)
+
+//go:generate mockgen -package mocks -destination mocks/notifier_mocks.go github.com/almostinf/glow-reminder/internal/notifier Notifier
+
+// SnoozeDurations are offered as snooze buttons of a fired reminder.
+var SnoozeDurations = []time.Duration{5 * time.Minute, 15 * time.Minute, time.Hour}
+
+// Notifier delivers messages to reminder owners.
+type Notifier interface {
+	// Notify sends a plain text message to the user.
+	Notify(ctx context.Context, userID int64, msg string) error
+	// NotifyReminder sends the text of a fired reminder to its owner with Done and Snooze buttons.
+	NotifyReminder(ctx context.Context, reminder *domain.Reminder) error
+}
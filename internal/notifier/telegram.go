@@ -0,0 +1,75 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	telebot "gopkg.in/telebot.v4"
+)
+
+const reminderMsg = "🔔 %s"
+
+var _ Notifier = (*telegramNotifier)(nil)
+
+// telegramNotifier sends messages through the bot, so the user gets them in the chat with the bot.
+type telegramNotifier struct {
+	bot    *telebot.Bot
+	logger logger.Logger
+}
+
+func NewTelegram(bot *telebot.Bot, logger logger.Logger) *telegramNotifier {
+	return &telegramNotifier{
+		bot:    bot,
+		logger: logger,
+	}
+}
+
+func (notifier *telegramNotifier) Notify(_ context.Context, userID int64, msg string) error {
+	// The chat with a user has the same id as the user.
+	if _, err := notifier.bot.Send(telebot.ChatID(userID), msg); err != nil {
+		return fmt.Errorf("failed to Send to user %d: %w", userID, err)
+	}
+
+	return nil
+}
+
+func (notifier *telegramNotifier) NotifyReminder(_ context.Context, reminder *domain.Reminder) error {
+	reminderMenu := &telebot.ReplyMarkup{}
+
+	btnDone := reminderMenu.Data("✅ Done", fmt.Sprintf("%s:%s", DoneReminderUnique, reminder.ID))
+
+	btnsSnooze := make([]telebot.Btn, 0, len(SnoozeDurations))
+	for _, duration := range SnoozeDurations {
+		btnsSnooze = append(btnsSnooze, reminderMenu.Data(
+			"😴 "+describeDuration(duration),
+			fmt.Sprintf("%s:%s:%d", SnoozeReminderUnique, reminder.ID, int(duration.Minutes())),
+		))
+	}
+
+	reminderMenu.Inline(
+		reminderMenu.Row(btnDone),
+		reminderMenu.Row(btnsSnooze...),
+	)
+
+	if _, err := notifier.bot.Send(telebot.ChatID(reminder.UserID), fmt.Sprintf(reminderMsg, reminder.Msg), reminderMenu); err != nil {
+		return fmt.Errorf("failed to Send reminder to user %d: %w", reminder.UserID, err)
+	}
+
+	notifier.logger.Info("Send reminder message", map[string]interface{}{
+		"reminder_id": reminder.ID,
+		"user_id":     reminder.UserID,
+	})
+
+	return nil
+}
+
+func describeDuration(duration time.Duration) string {
+	if duration < time.Hour {
+		return fmt.Sprintf("%dm", int(duration.Minutes()))
+	}
+
+	return fmt.Sprintf("%dh", int(duration.Hours()))
+}
@@ -0,0 +1,19 @@
+package relay
+
+import (
+	"time"
+
+	"github.com/almostinf/glow-reminder/config"
+)
+
+type Config struct {
+	CycleDuration time.Duration
+	BatchSize     uint64
+}
+
+func FromAppConfig(appCfg *config.AppConfig) Config {
+	return Config{
+		CycleDuration: appCfg.Outbox.CycleDuration,
+		BatchSize:     appCfg.Outbox.BatchSize,
+	}
+}
@@ -0,0 +1,7 @@
+package relay
+
+import "context"
+
+func (relay *outboxRelay) Reconcile(ctx context.Context) error {
+	return relay.reconcile(ctx)
+}
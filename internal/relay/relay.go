@@ -0,0 +1,190 @@
+package relay
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/leader"
+	"github.com/almostinf/glow-reminder/internal/repository/pg"
+	"github.com/almostinf/glow-reminder/internal/repository/redis"
+	"github.com/almostinf/glow-reminder/pkg/clock"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"gopkg.in/tomb.v2"
+)
+
+// OutboxRelay projects reminder outbox events from Postgres into the reminder tasks queue in Redis.
+type OutboxRelay interface {
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+}
+
+type outboxRelay struct {
+	cfg              Config
+	outboxRepo       pg.OutboxRepo
+	reminderRepo     pg.ReminderRepo
+	reminderTaskRepo redis.ReminderTaskRepo
+	trManager        trm.Manager
+	elector          leader.Elector
+	logger           logger.Logger
+	clock            clock.Clock
+	tomb             tomb.Tomb
+}
+
+func New(
+	cfg Config,
+	outboxRepo pg.OutboxRepo,
+	reminderRepo pg.ReminderRepo,
+	reminderTaskRepo redis.ReminderTaskRepo,
+	trManager trm.Manager,
+	elector leader.Elector,
+	logger logger.Logger,
+	clock clock.Clock,
+) *outboxRelay {
+	return &outboxRelay{
+		cfg:              cfg,
+		outboxRepo:       outboxRepo,
+		reminderRepo:     reminderRepo,
+		reminderTaskRepo: reminderTaskRepo,
+		trManager:        trManager,
+		elector:          elector,
+		logger:           logger,
+		clock:            clock,
+		tomb:             tomb.Tomb{},
+	}
+}
+
+func (relay *outboxRelay) Start(ctx context.Context) error {
+	relay.logger.Debug("Start outbox relay", map[string]interface{}{})
+
+	ctx = context.WithoutCancel(ctx)
+
+	relay.tomb.Go(func() error {
+		ticker := time.NewTicker(relay.cfg.CycleDuration)
+		defer ticker.Stop()
+
+		// The queue is reconciled every time the instance takes the lead, since the former leader may have left it behind.
+		// Reconciling keeps the snoozed, leased and retried tasks, so a failover does not lose or repeat them.
+		reconciled := false
+
+		for {
+			select {
+			case <-relay.tomb.Dying():
+				return nil
+			case <-ticker.C:
+				if !relay.elector.IsLeader() {
+					reconciled = false
+					continue
+				}
+
+				if !reconciled {
+					if err := relay.reconcile(ctx); err != nil {
+						relay.logger.Error("failed to reconcile reminder tasks", map[string]interface{}{
+							"error": err.Error(),
+						})
+						continue
+					}
+					reconciled = true
+				}
+
+				if err := relay.processEvents(ctx); err != nil {
+					relay.logger.Error("failed to process outbox events", map[string]interface{}{
+						"error": err.Error(),
+					})
+				}
+			}
+		}
+	})
+
+	return nil
+}
+
+// reconcile restores the tasks of the reminders lost by the queue and drops the orphan tasks.
+// The tasks being processed, snoozed or retried are kept as they are.
+func (relay *outboxRelay) reconcile(ctx context.Context) error {
+	reminders, err := relay.reminderRepo.GetReminders(ctx, domain.GetRemindersParams{})
+	if err != nil {
+		return fmt.Errorf("failed to GetReminders: %w", err)
+	}
+
+	reminderTasks := make([]*domain.ReminderTask, 0, len(reminders))
+	for _, reminder := range reminders {
+		reminderTasks = append(reminderTasks, &domain.ReminderTask{
+			ID:          reminder.ID,
+			ScheduledAt: reminder.ScheduledAt,
+		})
+	}
+
+	if err = relay.reminderTaskRepo.ReconcileReminderTasks(ctx, reminderTasks, relay.clock.NowUnix()); err != nil {
+		return fmt.Errorf("failed to ReconcileReminderTasks: %w", err)
+	}
+
+	relay.logger.Info("Reconciled reminder tasks", map[string]interface{}{
+		"count": len(reminderTasks),
+	})
+
+	return nil
+}
+
+// processEvents applies a batch of outbox events to Redis and deletes them in the same transaction.
+// Applying an event is idempotent, so a batch that failed to commit is safely applied again.
+func (relay *outboxRelay) processEvents(ctx context.Context) error {
+	return relay.trManager.Do(ctx, func(ctx context.Context) error {
+		events, err := relay.outboxRepo.GetEvents(ctx, relay.cfg.BatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to GetEvents: %w", err)
+		}
+
+		if len(events) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, 0, len(events))
+		for _, event := range events {
+			if err = relay.applyEvent(ctx, event); err != nil {
+				return fmt.Errorf("failed to apply outbox event %d: %w", event.ID, err)
+			}
+			ids = append(ids, event.ID)
+		}
+
+		if err = relay.outboxRepo.DeleteEvents(ctx, ids); err != nil {
+			return fmt.Errorf("failed to DeleteEvents: %w", err)
+		}
+
+		relay.logger.Debug("Processed outbox events", map[string]interface{}{
+			"count": len(ids),
+		})
+
+		return nil
+	})
+}
+
+func (relay *outboxRelay) applyEvent(ctx context.Context, event *domain.OutboxEvent) error {
+	reminderTask := &domain.ReminderTask{
+		ID:          event.ReminderID,
+		ScheduledAt: event.ScheduledAt,
+	}
+
+	switch event.Operation {
+	case domain.UpsertReminderTask:
+		return relay.reminderTaskRepo.AddReminderTask(ctx, reminderTask)
+	case domain.DeleteReminderTask:
+		return relay.reminderTaskRepo.DeleteReminderTask(ctx, reminderTask)
+	default:
+		relay.logger.Warn("Skip unknown outbox operation", map[string]interface{}{
+			"id":        event.ID,
+			"operation": event.Operation,
+		})
+		return nil
+	}
+}
+
+func (relay *outboxRelay) Stop(_ context.Context) error {
+	relay.logger.Debug("Stop outbox relay", map[string]interface{}{})
+
+	relay.tomb.Kill(nil)
+
+	return relay.tomb.Wait()
+}
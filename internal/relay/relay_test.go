@@ -0,0 +1,98 @@
+package relay_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/relay"
+	pg_mocks "github.com/almostinf/glow-reminder/internal/repository/pg/mocks"
+	"github.com/almostinf/glow-reminder/internal/repository/redis"
+	clock_mocks "github.com/almostinf/glow-reminder/pkg/clock/mocks"
+	logger_mocks "github.com/almostinf/glow-reminder/pkg/logger/mocks"
+	rediswrapper "github.com/almostinf/glow-reminder/pkg/redis"
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// TestReconcileOnFailover reconciles the queue the way every new leader does and checks
+// that the tasks living only in Redis survive it.
+func TestReconcileOnFailover(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	snoozed := &domain.Reminder{ID: uuid.New(), ScheduledAt: now.Add(24 * time.Hour)}
+	retried := &domain.Reminder{ID: uuid.New(), ScheduledAt: now.Add(-time.Minute)}
+	lost := &domain.Reminder{ID: uuid.New(), ScheduledAt: now.Add(time.Hour)}
+	deleted := uuid.New()
+
+	mockCtrl := gomock.NewController(t)
+
+	reminderRepo := pg_mocks.NewMockReminderRepo(mockCtrl)
+	reminderRepo.EXPECT().GetReminders(gomock.Any(), domain.GetRemindersParams{}).
+		Return([]*domain.Reminder{snoozed, retried, lost}, nil).AnyTimes()
+
+	log := logger_mocks.NewMockLogger(mockCtrl)
+	log.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	clk := clock_mocks.NewMockClock(mockCtrl)
+	clk.EXPECT().NowUnix().Return(now.Unix()).AnyTimes()
+
+	server := miniredis.RunT(t)
+
+	client := goredis.NewClient(&goredis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	reminderTaskRepo := redis.NewReminderTaskRepo(&rediswrapper.Redis{Client: client}, log)
+
+	snoozedTask := &domain.ReminderTask{ID: snoozed.ID, ScheduledAt: now.Add(5 * time.Minute), Snoozed: true}
+	retriedTask := &domain.ReminderTask{ID: retried.ID, ScheduledAt: retried.ScheduledAt}
+	retryAt := now.Add(30 * time.Second)
+
+	require.NoError(t, reminderTaskRepo.AddReminderTask(ctx, &domain.ReminderTask{
+		ID:          snoozed.ID,
+		ScheduledAt: snoozed.ScheduledAt,
+	}))
+	require.NoError(t, reminderTaskRepo.AddReminderTask(ctx, snoozedTask))
+	require.NoError(t, reminderTaskRepo.AddReminderTask(ctx, &domain.ReminderTask{ID: deleted, ScheduledAt: now}))
+	require.NoError(t, reminderTaskRepo.NackReminderTask(ctx, retriedTask, retryAt))
+	_, err := reminderTaskRepo.IncrReminderTaskAttempts(ctx, retriedTask)
+	require.NoError(t, err)
+
+	outboxRelay := relay.New(relay.Config{}, nil, reminderRepo, reminderTaskRepo, nil, nil, log, clk)
+
+	for term := 0; term < 2; term++ {
+		require.NoError(t, outboxRelay.Reconcile(ctx))
+	}
+
+	scores := make(map[domain.ReminderTask]int64)
+	members, err := server.ZMembers("reminder-tasks")
+	require.NoError(t, err)
+	for _, member := range members {
+		score, err := server.ZScore("reminder-tasks", member)
+		require.NoError(t, err)
+
+		var task domain.ReminderTask
+		require.NoError(t, json.Unmarshal([]byte(member), &task))
+		scores[task] = int64(score)
+	}
+
+	assert.Equal(t, map[domain.ReminderTask]int64{
+		{ID: snoozed.ID}:                snoozed.ScheduledAt.Unix(),
+		{ID: snoozed.ID, Snoozed: true}: snoozedTask.ScheduledAt.Unix(),
+		{ID: retried.ID}:                retryAt.Unix(),
+		{ID: lost.ID}:                   lost.ScheduledAt.Unix(),
+	}, scores)
+
+	attempts, err := reminderTaskRepo.IncrReminderTaskAttempts(ctx, retriedTask)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), attempts, "the attempts of a retried task are kept")
+}
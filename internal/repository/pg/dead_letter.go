@@ -0,0 +1,45 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/almostinf/glow-reminder/pkg/postgres"
+)
+
+var _ DeadLetterRepo = (*deadLetterRepo)(nil)
+
+type DeadLetterRepo interface {
+	CreateDeadLetter(ctx context.Context, deadLetter domain.DeadLetter) error
+}
+
+type deadLetterRepo struct {
+	pg     *postgres.Postgres
+	logger logger.Logger
+}
+
+func NewDeadLetterRepo(pg *postgres.Postgres, logger logger.Logger) *deadLetterRepo {
+	return &deadLetterRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func (repo *deadLetterRepo) CreateDeadLetter(ctx context.Context, deadLetter domain.DeadLetter) error {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := createDeadLetterQuery(deadLetter)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = conn.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec: %w", err)
+	}
+
+	return nil
+}
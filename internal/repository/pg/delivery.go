@@ -0,0 +1,195 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/almostinf/glow-reminder/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ DeliveryRepo = (*deliveryRepo)(nil)
+
+type DeliveryRepo interface {
+	GetDelivery(ctx context.Context, id uuid.UUID) (*domain.LampDelivery, error)
+	// GetQueuedDeliveries returns the deliveries of the reminder waiting for their lamps to be reached.
+	GetQueuedDeliveries(ctx context.Context, reminderID uuid.UUID) ([]*domain.LampDelivery, error)
+	// GetLastDeliveries returns the deliveries of the last firing of each of the reminders.
+	GetLastDeliveries(ctx context.Context, reminderIDs []uuid.UUID) ([]*domain.LampDelivery, error)
+	CreateDeliveries(ctx context.Context, deliveries []domain.LampDelivery) error
+	// UpdateDelivery returns domain.ErrInvalidDeliveryTransition if the delivery is missing
+	// or can not move to the new status from its current one.
+	UpdateDelivery(ctx context.Context, delivery domain.LampDelivery) error
+	// CompleteDeliveries completes the sent and acknowledged deliveries of the reminder.
+	CompleteDeliveries(ctx context.Context, reminderID uuid.UUID, updatedAt time.Time) error
+	// FailQueuedDeliveries fails the deliveries of the reminder whose lamps have not been reached.
+	FailQueuedDeliveries(ctx context.Context, reminderID uuid.UUID, lastError string, updatedAt time.Time) error
+}
+
+type deliveryRepo struct {
+	pg     *postgres.Postgres
+	logger logger.Logger
+}
+
+func NewDeliveryRepo(pg *postgres.Postgres, logger logger.Logger) *deliveryRepo {
+	return &deliveryRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func (repo *deliveryRepo) GetDelivery(ctx context.Context, id uuid.UUID) (*domain.LampDelivery, error) {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := getDeliveryQuery(id)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := conn.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	delivery, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[domain.LampDelivery])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("delivery %v: %w", id, domain.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to collect rows: %w", err)
+	}
+
+	return &delivery, nil
+}
+
+func (repo *deliveryRepo) GetQueuedDeliveries(ctx context.Context, reminderID uuid.UUID) ([]*domain.LampDelivery, error) {
+	return repo.getDeliveries(ctx, getQueuedDeliveriesQuery(reminderID))
+}
+
+func (repo *deliveryRepo) GetLastDeliveries(
+	ctx context.Context,
+	reminderIDs []uuid.UUID,
+) ([]*domain.LampDelivery, error) {
+	if len(reminderIDs) == 0 {
+		return nil, nil
+	}
+
+	return repo.getDeliveries(ctx, getLastDeliveriesQuery(reminderIDs))
+}
+
+func (repo *deliveryRepo) getDeliveries(ctx context.Context, query sq.Sqlizer) ([]*domain.LampDelivery, error) {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := conn.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	deliveries, err := pgx.CollectRows(rows, pgx.RowToStructByName[domain.LampDelivery])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect rows: %w", err)
+	}
+
+	deliveryPtrs := make([]*domain.LampDelivery, 0, len(deliveries))
+	for i := range deliveries {
+		deliveryPtrs = append(deliveryPtrs, &deliveries[i])
+	}
+
+	return deliveryPtrs, nil
+}
+
+func (repo *deliveryRepo) CreateDeliveries(ctx context.Context, deliveries []domain.LampDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := createDeliveriesQuery(deliveries)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = conn.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *deliveryRepo) UpdateDelivery(ctx context.Context, delivery domain.LampDelivery) error {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := updateDeliveryQuery(delivery)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	tag, err := conn.Exec(ctx, sqlQuery, args...)
+	if err != nil {
+		return fmt.Errorf("failed to Exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("delivery %v to %s: %w", delivery.ID, delivery.Status, domain.ErrInvalidDeliveryTransition)
+	}
+
+	return nil
+}
+
+func (repo *deliveryRepo) CompleteDeliveries(ctx context.Context, reminderID uuid.UUID, updatedAt time.Time) error {
+	return repo.updateReminderDeliveries(ctx, updateReminderDeliveriesQuery(
+		reminderID,
+		[]domain.DeliveryStatus{domain.DeliverySent, domain.DeliveryAcknowledged},
+		domain.DeliveryCompleted,
+		"",
+		updatedAt,
+	))
+}
+
+func (repo *deliveryRepo) FailQueuedDeliveries(
+	ctx context.Context,
+	reminderID uuid.UUID,
+	lastError string,
+	updatedAt time.Time,
+) error {
+	return repo.updateReminderDeliveries(ctx, updateReminderDeliveriesQuery(
+		reminderID,
+		[]domain.DeliveryStatus{domain.DeliveryQueued},
+		domain.DeliveryFailed,
+		lastError,
+		updatedAt,
+	))
+}
+
+func (repo *deliveryRepo) updateReminderDeliveries(ctx context.Context, query sq.Sqlizer) error {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = conn.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec: %w", err)
+	}
+
+	return nil
+}
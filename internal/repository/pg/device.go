@@ -0,0 +1,159 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/almostinf/glow-reminder/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// uniqueViolationCode is the Postgres error code of a unique constraint violation.
+const uniqueViolationCode = "23505"
+
+var _ DeviceRepo = (*deviceRepo)(nil)
+
+type DeviceRepo interface {
+	// GetDevices returns the devices of the user or the devices of all the users if userID is 0.
+	GetDevices(ctx context.Context, userID int64) ([]*domain.Device, error)
+	GetDevice(ctx context.Context, id uuid.UUID) (*domain.Device, error)
+	// CreateDevice returns domain.ErrAlreadyExists if the user already has a device with the same name.
+	CreateDevice(ctx context.Context, device domain.Device) error
+	// UpdateDevice returns domain.ErrAlreadyExists if the user already has a device with the same name.
+	UpdateDevice(ctx context.Context, device domain.Device) error
+	DeleteDevice(ctx context.Context, id uuid.UUID) error
+}
+
+type deviceRepo struct {
+	pg     *postgres.Postgres
+	logger logger.Logger
+}
+
+func NewDeviceRepo(pg *postgres.Postgres, logger logger.Logger) *deviceRepo {
+	return &deviceRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func (repo *deviceRepo) GetDevices(ctx context.Context, userID int64) ([]*domain.Device, error) {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := getDevicesQuery(userID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := conn.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	devices, err := pgx.CollectRows(rows, pgx.RowToStructByName[domain.Device])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect rows: %w", err)
+	}
+
+	devicePtrs := make([]*domain.Device, 0, len(devices))
+	for i := range devices {
+		devicePtrs = append(devicePtrs, &devices[i])
+	}
+
+	return devicePtrs, nil
+}
+
+func (repo *deviceRepo) GetDevice(ctx context.Context, id uuid.UUID) (*domain.Device, error) {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := getDeviceQuery(id)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := conn.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	device, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[domain.Device])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("device %s: %w", id, domain.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to collect rows: %w", err)
+	}
+
+	return &device, nil
+}
+
+func (repo *deviceRepo) CreateDevice(ctx context.Context, device domain.Device) error {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := createDeviceQuery(device)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = conn.Exec(ctx, sqlQuery, args...); err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("device %q: %w", device.Name, domain.ErrAlreadyExists)
+		}
+		return fmt.Errorf("failed to Exec: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *deviceRepo) UpdateDevice(ctx context.Context, device domain.Device) error {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := updateDeviceQuery(device)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = conn.Exec(ctx, sqlQuery, args...); err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("device %q: %w", device.Name, domain.ErrAlreadyExists)
+		}
+		return fmt.Errorf("failed to Exec: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *deviceRepo) DeleteDevice(ctx context.Context, id uuid.UUID) error {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := deleteDeviceQuery(id)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = conn.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec: %w", err)
+	}
+
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
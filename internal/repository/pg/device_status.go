@@ -0,0 +1,84 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/almostinf/glow-reminder/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ DeviceStatusRepo = (*deviceStatusRepo)(nil)
+
+type DeviceStatusRepo interface {
+	// GetDeviceStatuses returns the statuses of the checked devices, the devices not checked yet are skipped.
+	GetDeviceStatuses(ctx context.Context, deviceIDs []uuid.UUID) ([]*domain.DeviceStatus, error)
+	UpsertDeviceStatus(ctx context.Context, status domain.DeviceStatus) error
+}
+
+type deviceStatusRepo struct {
+	pg     *postgres.Postgres
+	logger logger.Logger
+}
+
+func NewDeviceStatusRepo(pg *postgres.Postgres, logger logger.Logger) *deviceStatusRepo {
+	return &deviceStatusRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func (repo *deviceStatusRepo) GetDeviceStatuses(
+	ctx context.Context,
+	deviceIDs []uuid.UUID,
+) ([]*domain.DeviceStatus, error) {
+	if len(deviceIDs) == 0 {
+		return nil, nil
+	}
+
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := getDeviceStatusesQuery(deviceIDs)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := conn.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	statuses, err := pgx.CollectRows(rows, pgx.RowToStructByName[domain.DeviceStatus])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect rows: %w", err)
+	}
+
+	statusPtrs := make([]*domain.DeviceStatus, 0, len(statuses))
+	for i := range statuses {
+		statusPtrs = append(statusPtrs, &statuses[i])
+	}
+
+	return statusPtrs, nil
+}
+
+func (repo *deviceStatusRepo) UpsertDeviceStatus(ctx context.Context, status domain.DeviceStatus) error {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := upsertDeviceStatusQuery(status)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = conn.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,526 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/almostinf/glow-reminder/internal/repository/pg (interfaces: ReminderRepo,UserRepo,OutboxRepo,DeadLetterRepo,DeliveryRepo,DeviceRepo,ReminderAckRepo)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/pg_mocks.go github.com/almostinf/glow-reminder/internal/repository/pg ReminderRepo,UserRepo,OutboxRepo,DeadLetterRepo,DeliveryRepo,DeviceRepo,ReminderAckRepo
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/almostinf/glow-reminder/internal/domain"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReminderRepo is a mock of ReminderRepo interface.
+type MockReminderRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderRepoMockRecorder
+}
+
+// MockReminderRepoMockRecorder is the mock recorder for MockReminderRepo.
+type MockReminderRepoMockRecorder struct {
+	mock *MockReminderRepo
+}
+
+// NewMockReminderRepo creates a new mock instance.
+func NewMockReminderRepo(ctrl *gomock.Controller) *MockReminderRepo {
+	mock := &MockReminderRepo{ctrl: ctrl}
+	mock.recorder = &MockReminderRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderRepo) EXPECT() *MockReminderRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateReminder mocks base method.
+func (m *MockReminderRepo) CreateReminder(arg0 context.Context, arg1 domain.Reminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReminder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReminder indicates an expected call of CreateReminder.
+func (mr *MockReminderRepoMockRecorder) CreateReminder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReminder", reflect.TypeOf((*MockReminderRepo)(nil).CreateReminder), arg0, arg1)
+}
+
+// DeleteReminder mocks base method.
+func (m *MockReminderRepo) DeleteReminder(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReminder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReminder indicates an expected call of DeleteReminder.
+func (mr *MockReminderRepoMockRecorder) DeleteReminder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReminder", reflect.TypeOf((*MockReminderRepo)(nil).DeleteReminder), arg0, arg1)
+}
+
+// GetReminder mocks base method.
+func (m *MockReminderRepo) GetReminder(arg0 context.Context, arg1 uuid.UUID) (*domain.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReminder", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReminder indicates an expected call of GetReminder.
+func (mr *MockReminderRepoMockRecorder) GetReminder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminder", reflect.TypeOf((*MockReminderRepo)(nil).GetReminder), arg0, arg1)
+}
+
+// GetReminders mocks base method.
+func (m *MockReminderRepo) GetReminders(arg0 context.Context, arg1 domain.GetRemindersParams) ([]*domain.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReminders", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReminders indicates an expected call of GetReminders.
+func (mr *MockReminderRepoMockRecorder) GetReminders(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminders", reflect.TypeOf((*MockReminderRepo)(nil).GetReminders), arg0, arg1)
+}
+
+// UpdateReminder mocks base method.
+func (m *MockReminderRepo) UpdateReminder(arg0 context.Context, arg1 domain.Reminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReminder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReminder indicates an expected call of UpdateReminder.
+func (mr *MockReminderRepoMockRecorder) UpdateReminder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReminder", reflect.TypeOf((*MockReminderRepo)(nil).UpdateReminder), arg0, arg1)
+}
+
+// MockUserRepo is a mock of UserRepo interface.
+type MockUserRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRepoMockRecorder
+}
+
+// MockUserRepoMockRecorder is the mock recorder for MockUserRepo.
+type MockUserRepoMockRecorder struct {
+	mock *MockUserRepo
+}
+
+// NewMockUserRepo creates a new mock instance.
+func NewMockUserRepo(ctrl *gomock.Controller) *MockUserRepo {
+	mock := &MockUserRepo{ctrl: ctrl}
+	mock.recorder = &MockUserRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRepo) EXPECT() *MockUserRepoMockRecorder {
+	return m.recorder
+}
+
+// GetUser mocks base method.
+func (m *MockUserRepo) GetUser(arg0 context.Context, arg1 int64) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockUserRepoMockRecorder) GetUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUserRepo)(nil).GetUser), arg0, arg1)
+}
+
+// UpsertUser mocks base method.
+func (m *MockUserRepo) UpsertUser(arg0 context.Context, arg1 domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertUser indicates an expected call of UpsertUser.
+func (mr *MockUserRepoMockRecorder) UpsertUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertUser", reflect.TypeOf((*MockUserRepo)(nil).UpsertUser), arg0, arg1)
+}
+
+// MockOutboxRepo is a mock of OutboxRepo interface.
+type MockOutboxRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRepoMockRecorder
+}
+
+// MockOutboxRepoMockRecorder is the mock recorder for MockOutboxRepo.
+type MockOutboxRepoMockRecorder struct {
+	mock *MockOutboxRepo
+}
+
+// NewMockOutboxRepo creates a new mock instance.
+func NewMockOutboxRepo(ctrl *gomock.Controller) *MockOutboxRepo {
+	mock := &MockOutboxRepo{ctrl: ctrl}
+	mock.recorder = &MockOutboxRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRepo) EXPECT() *MockOutboxRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateEvent mocks base method.
+func (m *MockOutboxRepo) CreateEvent(arg0 context.Context, arg1 domain.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEvent indicates an expected call of CreateEvent.
+func (mr *MockOutboxRepoMockRecorder) CreateEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockOutboxRepo)(nil).CreateEvent), arg0, arg1)
+}
+
+// DeleteEvents mocks base method.
+func (m *MockOutboxRepo) DeleteEvents(arg0 context.Context, arg1 []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEvents indicates an expected call of DeleteEvents.
+func (mr *MockOutboxRepoMockRecorder) DeleteEvents(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvents", reflect.TypeOf((*MockOutboxRepo)(nil).DeleteEvents), arg0, arg1)
+}
+
+// GetEvents mocks base method.
+func (m *MockOutboxRepo) GetEvents(arg0 context.Context, arg1 uint64) ([]*domain.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents.
+func (mr *MockOutboxRepoMockRecorder) GetEvents(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockOutboxRepo)(nil).GetEvents), arg0, arg1)
+}
+
+// MockDeadLetterRepo is a mock of DeadLetterRepo interface.
+type MockDeadLetterRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeadLetterRepoMockRecorder
+}
+
+// MockDeadLetterRepoMockRecorder is the mock recorder for MockDeadLetterRepo.
+type MockDeadLetterRepoMockRecorder struct {
+	mock *MockDeadLetterRepo
+}
+
+// NewMockDeadLetterRepo creates a new mock instance.
+func NewMockDeadLetterRepo(ctrl *gomock.Controller) *MockDeadLetterRepo {
+	mock := &MockDeadLetterRepo{ctrl: ctrl}
+	mock.recorder = &MockDeadLetterRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeadLetterRepo) EXPECT() *MockDeadLetterRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateDeadLetter mocks base method.
+func (m *MockDeadLetterRepo) CreateDeadLetter(arg0 context.Context, arg1 domain.DeadLetter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeadLetter", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDeadLetter indicates an expected call of CreateDeadLetter.
+func (mr *MockDeadLetterRepoMockRecorder) CreateDeadLetter(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeadLetter", reflect.TypeOf((*MockDeadLetterRepo)(nil).CreateDeadLetter), arg0, arg1)
+}
+
+// MockDeliveryRepo is a mock of DeliveryRepo interface.
+type MockDeliveryRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeliveryRepoMockRecorder
+}
+
+// MockDeliveryRepoMockRecorder is the mock recorder for MockDeliveryRepo.
+type MockDeliveryRepoMockRecorder struct {
+	mock *MockDeliveryRepo
+}
+
+// NewMockDeliveryRepo creates a new mock instance.
+func NewMockDeliveryRepo(ctrl *gomock.Controller) *MockDeliveryRepo {
+	mock := &MockDeliveryRepo{ctrl: ctrl}
+	mock.recorder = &MockDeliveryRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeliveryRepo) EXPECT() *MockDeliveryRepoMockRecorder {
+	return m.recorder
+}
+
+// CompleteDeliveries mocks base method.
+func (m *MockDeliveryRepo) CompleteDeliveries(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteDeliveries", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteDeliveries indicates an expected call of CompleteDeliveries.
+func (mr *MockDeliveryRepoMockRecorder) CompleteDeliveries(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteDeliveries", reflect.TypeOf((*MockDeliveryRepo)(nil).CompleteDeliveries), arg0, arg1, arg2)
+}
+
+// CreateDeliveries mocks base method.
+func (m *MockDeliveryRepo) CreateDeliveries(arg0 context.Context, arg1 []domain.LampDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeliveries", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDeliveries indicates an expected call of CreateDeliveries.
+func (mr *MockDeliveryRepoMockRecorder) CreateDeliveries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeliveries", reflect.TypeOf((*MockDeliveryRepo)(nil).CreateDeliveries), arg0, arg1)
+}
+
+// FailQueuedDeliveries mocks base method.
+func (m *MockDeliveryRepo) FailQueuedDeliveries(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailQueuedDeliveries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailQueuedDeliveries indicates an expected call of FailQueuedDeliveries.
+func (mr *MockDeliveryRepoMockRecorder) FailQueuedDeliveries(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailQueuedDeliveries", reflect.TypeOf((*MockDeliveryRepo)(nil).FailQueuedDeliveries), arg0, arg1, arg2, arg3)
+}
+
+// GetDelivery mocks base method.
+func (m *MockDeliveryRepo) GetDelivery(arg0 context.Context, arg1 uuid.UUID) (*domain.LampDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelivery", arg0, arg1)
+	ret0, _ := ret[0].(*domain.LampDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelivery indicates an expected call of GetDelivery.
+func (mr *MockDeliveryRepoMockRecorder) GetDelivery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelivery", reflect.TypeOf((*MockDeliveryRepo)(nil).GetDelivery), arg0, arg1)
+}
+
+// GetLastDeliveries mocks base method.
+func (m *MockDeliveryRepo) GetLastDeliveries(arg0 context.Context, arg1 []uuid.UUID) ([]*domain.LampDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastDeliveries", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.LampDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastDeliveries indicates an expected call of GetLastDeliveries.
+func (mr *MockDeliveryRepoMockRecorder) GetLastDeliveries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastDeliveries", reflect.TypeOf((*MockDeliveryRepo)(nil).GetLastDeliveries), arg0, arg1)
+}
+
+// GetQueuedDeliveries mocks base method.
+func (m *MockDeliveryRepo) GetQueuedDeliveries(arg0 context.Context, arg1 uuid.UUID) ([]*domain.LampDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueuedDeliveries", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.LampDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueuedDeliveries indicates an expected call of GetQueuedDeliveries.
+func (mr *MockDeliveryRepoMockRecorder) GetQueuedDeliveries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueuedDeliveries", reflect.TypeOf((*MockDeliveryRepo)(nil).GetQueuedDeliveries), arg0, arg1)
+}
+
+// UpdateDelivery mocks base method.
+func (m *MockDeliveryRepo) UpdateDelivery(arg0 context.Context, arg1 domain.LampDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDelivery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDelivery indicates an expected call of UpdateDelivery.
+func (mr *MockDeliveryRepoMockRecorder) UpdateDelivery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDelivery", reflect.TypeOf((*MockDeliveryRepo)(nil).UpdateDelivery), arg0, arg1)
+}
+
+// MockDeviceRepo is a mock of DeviceRepo interface.
+type MockDeviceRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeviceRepoMockRecorder
+}
+
+// MockDeviceRepoMockRecorder is the mock recorder for MockDeviceRepo.
+type MockDeviceRepoMockRecorder struct {
+	mock *MockDeviceRepo
+}
+
+// NewMockDeviceRepo creates a new mock instance.
+func NewMockDeviceRepo(ctrl *gomock.Controller) *MockDeviceRepo {
+	mock := &MockDeviceRepo{ctrl: ctrl}
+	mock.recorder = &MockDeviceRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeviceRepo) EXPECT() *MockDeviceRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateDevice mocks base method.
+func (m *MockDeviceRepo) CreateDevice(arg0 context.Context, arg1 domain.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDevice", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDevice indicates an expected call of CreateDevice.
+func (mr *MockDeviceRepoMockRecorder) CreateDevice(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDevice", reflect.TypeOf((*MockDeviceRepo)(nil).CreateDevice), arg0, arg1)
+}
+
+// DeleteDevice mocks base method.
+func (m *MockDeviceRepo) DeleteDevice(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDevice", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDevice indicates an expected call of DeleteDevice.
+func (mr *MockDeviceRepoMockRecorder) DeleteDevice(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevice", reflect.TypeOf((*MockDeviceRepo)(nil).DeleteDevice), arg0, arg1)
+}
+
+// GetDevice mocks base method.
+func (m *MockDeviceRepo) GetDevice(arg0 context.Context, arg1 uuid.UUID) (*domain.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevice", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevice indicates an expected call of GetDevice.
+func (mr *MockDeviceRepoMockRecorder) GetDevice(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevice", reflect.TypeOf((*MockDeviceRepo)(nil).GetDevice), arg0, arg1)
+}
+
+// GetDevices mocks base method.
+func (m *MockDeviceRepo) GetDevices(arg0 context.Context, arg1 int64) ([]*domain.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevices", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevices indicates an expected call of GetDevices.
+func (mr *MockDeviceRepoMockRecorder) GetDevices(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevices", reflect.TypeOf((*MockDeviceRepo)(nil).GetDevices), arg0, arg1)
+}
+
+// UpdateDevice mocks base method.
+func (m *MockDeviceRepo) UpdateDevice(arg0 context.Context, arg1 domain.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDevice", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDevice indicates an expected call of UpdateDevice.
+func (mr *MockDeviceRepoMockRecorder) UpdateDevice(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDevice", reflect.TypeOf((*MockDeviceRepo)(nil).UpdateDevice), arg0, arg1)
+}
+
+// MockReminderAckRepo is a mock of ReminderAckRepo interface.
+type MockReminderAckRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderAckRepoMockRecorder
+}
+
+// MockReminderAckRepoMockRecorder is the mock recorder for MockReminderAckRepo.
+type MockReminderAckRepoMockRecorder struct {
+	mock *MockReminderAckRepo
+}
+
+// NewMockReminderAckRepo creates a new mock instance.
+func NewMockReminderAckRepo(ctrl *gomock.Controller) *MockReminderAckRepo {
+	mock := &MockReminderAckRepo{ctrl: ctrl}
+	mock.recorder = &MockReminderAckRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderAckRepo) EXPECT() *MockReminderAckRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateAck mocks base method.
+func (m *MockReminderAckRepo) CreateAck(arg0 context.Context, arg1 domain.ReminderAck) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAck", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAck indicates an expected call of CreateAck.
+func (mr *MockReminderAckRepoMockRecorder) CreateAck(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAck", reflect.TypeOf((*MockReminderAckRepo)(nil).CreateAck), arg0, arg1)
+}
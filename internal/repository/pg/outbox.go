@@ -0,0 +1,94 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/almostinf/glow-reminder/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ OutboxRepo = (*outboxRepo)(nil)
+
+type OutboxRepo interface {
+	CreateEvent(ctx context.Context, event domain.OutboxEvent) error
+	// GetEvents locks the oldest events, so it must be called inside a transaction.
+	GetEvents(ctx context.Context, limit uint64) ([]*domain.OutboxEvent, error)
+	DeleteEvents(ctx context.Context, ids []int64) error
+}
+
+type outboxRepo struct {
+	pg     *postgres.Postgres
+	logger logger.Logger
+}
+
+func NewOutboxRepo(pg *postgres.Postgres, logger logger.Logger) *outboxRepo {
+	return &outboxRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func (repo *outboxRepo) CreateEvent(ctx context.Context, event domain.OutboxEvent) error {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := createOutboxEventQuery(event)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = conn.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *outboxRepo) GetEvents(ctx context.Context, limit uint64) ([]*domain.OutboxEvent, error) {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := getOutboxEventsQuery(limit)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := conn.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	events, err := pgx.CollectRows(rows, pgx.RowToStructByName[domain.OutboxEvent])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect rows: %w", err)
+	}
+
+	eventPtrs := make([]*domain.OutboxEvent, 0, len(events))
+	for i := range events {
+		eventPtrs = append(eventPtrs, &events[i])
+	}
+
+	return eventPtrs, nil
+}
+
+func (repo *outboxRepo) DeleteEvents(ctx context.Context, ids []int64) error {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := deleteOutboxEventsQuery(ids)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = conn.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec: %w", err)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package pg
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/almostinf/glow-reminder/internal/domain"
@@ -11,6 +12,8 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
+//go:generate mockgen -package mocks -destination mocks/pg_mocks.go github.com/almostinf/glow-reminder/internal/repository/pg ReminderRepo,UserRepo,OutboxRepo,DeadLetterRepo,DeliveryRepo,DeviceRepo,ReminderAckRepo
+
 var _ ReminderRepo = (*reminderRepo)(nil)
 
 type ReminderRepo interface {
@@ -78,6 +81,9 @@ func (repo *reminderRepo) GetReminder(ctx context.Context, id uuid.UUID) (*domai
 
 	reminder, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[domain.Reminder])
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("reminder %s: %w", id, domain.ErrNotFound)
+		}
 		return nil, fmt.Errorf("failed to collect rows: %w", err)
 	}
 
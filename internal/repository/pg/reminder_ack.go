@@ -0,0 +1,45 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/almostinf/glow-reminder/pkg/postgres"
+)
+
+var _ ReminderAckRepo = (*reminderAckRepo)(nil)
+
+type ReminderAckRepo interface {
+	CreateAck(ctx context.Context, ack domain.ReminderAck) error
+}
+
+type reminderAckRepo struct {
+	pg     *postgres.Postgres
+	logger logger.Logger
+}
+
+func NewReminderAckRepo(pg *postgres.Postgres, logger logger.Logger) *reminderAckRepo {
+	return &reminderAckRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func (repo *reminderAckRepo) CreateAck(ctx context.Context, ack domain.ReminderAck) error {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := createReminderAckQuery(ack)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = conn.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec: %w", err)
+	}
+
+	return nil
+}
@@ -18,8 +18,15 @@ func getRemindersQuery(params domain.GetRemindersParams) sq.SelectBuilder {
 		"user_id",
 		"msg",
 		"colour",
+		"rgb",
+		"brightness",
 		"mode",
+		"effect",
+		"delivery",
+		"device_id",
+		"device_group",
 		"scheduled_at",
+		"recurrence",
 		"created_at",
 		"updated_at",
 	).
@@ -50,8 +57,15 @@ func getReminderQuery(id uuid.UUID) sq.SelectBuilder {
 		"user_id",
 		"msg",
 		"colour",
+		"rgb",
+		"brightness",
 		"mode",
+		"effect",
+		"delivery",
+		"device_id",
+		"device_group",
 		"scheduled_at",
+		"recurrence",
 		"created_at",
 		"updated_at",
 	).
@@ -68,8 +82,15 @@ func createReminderQuery(reminder domain.Reminder) sq.InsertBuilder {
 			"user_id",
 			"msg",
 			"colour",
+			"rgb",
+			"brightness",
 			"mode",
+			"effect",
+			"delivery",
+			"device_id",
+			"device_group",
 			"scheduled_at",
+			"recurrence",
 			"created_at",
 			"updated_at",
 		).
@@ -78,8 +99,15 @@ func createReminderQuery(reminder domain.Reminder) sq.InsertBuilder {
 			reminder.UserID,
 			reminder.Msg,
 			reminder.Colour,
+			reminder.RGB,
+			reminder.Brightness,
 			reminder.Mode,
+			reminder.Effect,
+			reminder.Delivery,
+			reminder.DeviceID,
+			reminder.DeviceGroup,
 			reminder.ScheduledAt,
+			reminder.Recurrence,
 			reminder.CreatedAt,
 			reminder.UpdatedAt,
 		)
@@ -88,19 +116,44 @@ func createReminderQuery(reminder domain.Reminder) sq.InsertBuilder {
 func updateReminderQuery(reminder domain.Reminder) sq.UpdateBuilder {
 	query := psql.Update("reminders")
 
+	if reminder.Msg != "" {
+		query = query.Set("msg", reminder.Msg)
+	}
+
 	if reminder.Colour != domain.UnknownColour {
 		query = query.Set("colour", reminder.Colour)
 	}
 
+	if reminder.RGB != domain.NoRGB {
+		query = query.Set("rgb", reminder.RGB)
+	}
+
+	if reminder.Brightness != 0 {
+		query = query.Set("brightness", reminder.Brightness)
+	}
+
 	if domain.Colour(reminder.Mode) != domain.Colour(domain.UnknownMode) {
 		query = query.Set("mode", reminder.Mode)
 	}
 
+	if reminder.Effect.Kind != "" {
+		query = query.Set("effect", reminder.Effect)
+	}
+
+	if reminder.Delivery != domain.UnknownDelivery {
+		query = query.Set("delivery", reminder.Delivery)
+	}
+
 	if reminder.ScheduledAt != nilTime {
 		query = query.Set("scheduled_at", reminder.ScheduledAt)
 	}
 
+	// The recurrence is always set, since an empty one turns the reminder into a one-shot reminder.
+	// So is the target device, since an empty one makes the reminder light all the lamps of the user.
 	return query.
+		Set("recurrence", reminder.Recurrence).
+		Set("device_id", reminder.DeviceID).
+		Set("device_group", reminder.DeviceGroup).
 		Set("updated_at", reminder.UpdatedAt).
 		Where(sq.Eq{
 			"id": reminder.ID,
@@ -113,3 +166,484 @@ func deleteReminderQuery(id uuid.UUID) sq.DeleteBuilder {
 			"id": id,
 		})
 }
+
+func getUserQuery(id int64) sq.SelectBuilder {
+	return psql.Select(
+		"id",
+		"time_zone",
+		"quiet_hours",
+		"quiet_policy",
+		"dnd_until",
+		"created_at",
+		"updated_at",
+	).
+		From("users").
+		Where(sq.Eq{
+			"id": id,
+		})
+}
+
+func upsertUserQuery(user domain.User) sq.InsertBuilder {
+	return psql.Insert("users").
+		Columns(
+			"id",
+			"time_zone",
+			"quiet_hours",
+			"quiet_policy",
+			"dnd_until",
+			"created_at",
+			"updated_at",
+		).
+		Values(
+			user.ID,
+			user.TimeZone,
+			user.QuietHours,
+			user.QuietPolicy,
+			user.DNDUntil,
+			user.CreatedAt,
+			user.UpdatedAt,
+		).
+		Suffix("ON CONFLICT (id) DO UPDATE SET time_zone = EXCLUDED.time_zone, quiet_hours = EXCLUDED.quiet_hours, " +
+			"quiet_policy = EXCLUDED.quiet_policy, dnd_until = EXCLUDED.dnd_until, updated_at = EXCLUDED.updated_at")
+}
+
+func getUserSettingsQuery(userID int64) sq.SelectBuilder {
+	return psql.Select(
+		"user_id",
+		"default_rgb",
+		"default_effect",
+		"has_default_device",
+		"default_device_id",
+		"default_device_group",
+		"time_locale",
+		"page_size",
+		"created_at",
+		"updated_at",
+	).
+		From("user_settings").
+		Where(sq.Eq{
+			"user_id": userID,
+		})
+}
+
+func upsertUserSettingsQuery(settings domain.UserSettings) sq.InsertBuilder {
+	return psql.Insert("user_settings").
+		Columns(
+			"user_id",
+			"default_rgb",
+			"default_effect",
+			"has_default_device",
+			"default_device_id",
+			"default_device_group",
+			"time_locale",
+			"page_size",
+			"created_at",
+			"updated_at",
+		).
+		Values(
+			settings.UserID,
+			settings.DefaultRGB,
+			settings.DefaultEffect,
+			settings.HasDefaultDevice,
+			settings.DefaultDeviceID,
+			settings.DefaultDeviceGroup,
+			settings.TimeLocale,
+			settings.PageSize,
+			settings.CreatedAt,
+			settings.UpdatedAt,
+		).
+		Suffix("ON CONFLICT (user_id) DO UPDATE SET default_rgb = EXCLUDED.default_rgb, " +
+			"default_effect = EXCLUDED.default_effect, has_default_device = EXCLUDED.has_default_device, " +
+			"default_device_id = EXCLUDED.default_device_id, default_device_group = EXCLUDED.default_device_group, " +
+			"time_locale = EXCLUDED.time_locale, page_size = EXCLUDED.page_size, updated_at = EXCLUDED.updated_at")
+}
+
+func createOutboxEventQuery(event domain.OutboxEvent) sq.InsertBuilder {
+	return psql.Insert("reminder_outbox").
+		Columns(
+			"reminder_id",
+			"operation",
+			"scheduled_at",
+			"created_at",
+		).
+		Values(
+			event.ReminderID,
+			event.Operation,
+			event.ScheduledAt,
+			event.CreatedAt,
+		)
+}
+
+func getOutboxEventsQuery(limit uint64) sq.SelectBuilder {
+	return psql.Select(
+		"id",
+		"reminder_id",
+		"operation",
+		"scheduled_at",
+		"created_at",
+	).
+		From("reminder_outbox").
+		OrderBy("id").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+}
+
+func deleteOutboxEventsQuery(ids []int64) sq.DeleteBuilder {
+	return psql.Delete("reminder_outbox").
+		Where(sq.Eq{
+			"id": ids,
+		})
+}
+
+func createDeadLetterQuery(deadLetter domain.DeadLetter) sq.InsertBuilder {
+	return psql.Insert("reminder_dead_letters").
+		Columns(
+			"reminder_id",
+			"user_id",
+			"msg",
+			"colour",
+			"mode",
+			"recurrence",
+			"scheduled_at",
+			"attempts",
+			"last_error",
+			"created_at",
+		).
+		Values(
+			deadLetter.ReminderID,
+			deadLetter.UserID,
+			deadLetter.Msg,
+			deadLetter.Colour,
+			deadLetter.Mode,
+			deadLetter.Recurrence,
+			deadLetter.ScheduledAt,
+			deadLetter.Attempts,
+			deadLetter.LastError,
+			deadLetter.CreatedAt,
+		)
+}
+
+func createReminderAckQuery(ack domain.ReminderAck) sq.InsertBuilder {
+	return psql.Insert("reminder_acks").
+		Columns(
+			"reminder_id",
+			"user_id",
+			"scheduled_at",
+			"acked_at",
+		).
+		Values(
+			ack.ReminderID,
+			ack.UserID,
+			ack.ScheduledAt,
+			ack.AckedAt,
+		)
+}
+
+func getDevicesQuery(userID int64) sq.SelectBuilder {
+	query := psql.Select(
+		"id",
+		"user_id",
+		"name",
+		"host",
+		"protocol",
+		"capabilities",
+		"group_name",
+		"created_at",
+		"updated_at",
+	).
+		From("devices")
+
+	if userID != 0 {
+		query = query.
+			Where(sq.Eq{
+				"user_id": userID,
+			})
+	}
+
+	return query.
+		OrderBy("name")
+}
+
+func getDeviceQuery(id uuid.UUID) sq.SelectBuilder {
+	return psql.Select(
+		"id",
+		"user_id",
+		"name",
+		"host",
+		"protocol",
+		"capabilities",
+		"group_name",
+		"created_at",
+		"updated_at",
+	).
+		From("devices").
+		Where(sq.Eq{
+			"id": id,
+		})
+}
+
+func createDeviceQuery(device domain.Device) sq.InsertBuilder {
+	return psql.Insert("devices").
+		Columns(
+			"id",
+			"user_id",
+			"name",
+			"host",
+			"protocol",
+			"capabilities",
+			"group_name",
+			"created_at",
+			"updated_at",
+		).
+		Values(
+			device.ID,
+			device.UserID,
+			device.Name,
+			device.Host,
+			device.Protocol,
+			device.Capabilities,
+			device.Group,
+			device.CreatedAt,
+			device.UpdatedAt,
+		)
+}
+
+func updateDeviceQuery(device domain.Device) sq.UpdateBuilder {
+	query := psql.Update("devices")
+
+	if device.Name != "" {
+		query = query.Set("name", device.Name)
+	}
+
+	if device.Host != "" {
+		query = query.Set("host", device.Host)
+	}
+
+	if device.Protocol != "" {
+		query = query.Set("protocol", device.Protocol)
+	}
+
+	// The capabilities and the group are always set, since their zero values are meaningful.
+	return query.
+		Set("capabilities", device.Capabilities).
+		Set("group_name", device.Group).
+		Set("updated_at", device.UpdatedAt).
+		Where(sq.Eq{
+			"id": device.ID,
+		})
+}
+
+func deleteDeviceQuery(id uuid.UUID) sq.DeleteBuilder {
+	return psql.Delete("devices").
+		Where(sq.Eq{
+			"id": id,
+		})
+}
+
+func getTokensQuery(userID int64) sq.SelectBuilder {
+	return psql.Select(
+		"id",
+		"user_id",
+		"name",
+		"hash",
+		"scopes",
+		"expires_at",
+		"revoked_at",
+		"created_at",
+	).
+		From("tokens").
+		Where(sq.Eq{
+			"user_id":    userID,
+			"revoked_at": nil,
+		}).
+		OrderBy("name")
+}
+
+func getTokenByHashQuery(hash []byte) sq.SelectBuilder {
+	return psql.Select(
+		"id",
+		"user_id",
+		"name",
+		"hash",
+		"scopes",
+		"expires_at",
+		"revoked_at",
+		"created_at",
+	).
+		From("tokens").
+		Where(sq.Eq{
+			"hash": hash,
+		})
+}
+
+func createTokenQuery(token domain.Token) sq.InsertBuilder {
+	return psql.Insert("tokens").
+		Columns(
+			"id",
+			"user_id",
+			"name",
+			"hash",
+			"scopes",
+			"expires_at",
+			"created_at",
+		).
+		Values(
+			token.ID,
+			token.UserID,
+			token.Name,
+			token.Hash,
+			token.Scopes,
+			token.ExpiresAt,
+			token.CreatedAt,
+		)
+}
+
+func revokeTokenQuery(id uuid.UUID, revokedAt time.Time) sq.UpdateBuilder {
+	return psql.Update("tokens").
+		Set("revoked_at", revokedAt).
+		Where(sq.Eq{
+			"id": id,
+		})
+}
+
+var deliveryColumns = []string{
+	"id",
+	"reminder_id",
+	"user_id",
+	"device_id",
+	"device_name",
+	"fired_at",
+	"status",
+	"attempts",
+	"last_error",
+	"created_at",
+	"updated_at",
+}
+
+func getDeliveryQuery(id uuid.UUID) sq.SelectBuilder {
+	return psql.Select(deliveryColumns...).
+		From("deliveries").
+		Where(sq.Eq{
+			"id": id,
+		})
+}
+
+func getQueuedDeliveriesQuery(reminderID uuid.UUID) sq.SelectBuilder {
+	return psql.Select(deliveryColumns...).
+		From("deliveries").
+		Where(sq.Eq{
+			"reminder_id": reminderID,
+			"status":      domain.DeliveryQueued,
+		}).
+		OrderBy("device_name")
+}
+
+// getLastDeliveriesQuery selects the deliveries of the last firing of every reminder.
+func getLastDeliveriesQuery(reminderIDs []uuid.UUID) sq.SelectBuilder {
+	return psql.Select(deliveryColumns...).
+		From("deliveries").
+		Where(sq.Eq{
+			"reminder_id": reminderIDs,
+		}).
+		Where("fired_at = (SELECT MAX(last.fired_at) FROM deliveries last WHERE last.reminder_id = deliveries.reminder_id)").
+		OrderBy("reminder_id", "device_name")
+}
+
+func createDeliveriesQuery(deliveries []domain.LampDelivery) sq.InsertBuilder {
+	query := psql.Insert("deliveries").
+		Columns(deliveryColumns...)
+
+	for _, delivery := range deliveries {
+		query = query.Values(
+			delivery.ID,
+			delivery.ReminderID,
+			delivery.UserID,
+			delivery.DeviceID,
+			delivery.DeviceName,
+			delivery.FiredAt,
+			delivery.Status,
+			delivery.Attempts,
+			delivery.LastError,
+			delivery.CreatedAt,
+			delivery.UpdatedAt,
+		)
+	}
+
+	return query
+}
+
+// updateDeliveryQuery moves the delivery to its status only if the transition is allowed by its current status.
+func updateDeliveryQuery(delivery domain.LampDelivery) sq.UpdateBuilder {
+	return psql.Update("deliveries").
+		Set("status", delivery.Status).
+		Set("attempts", delivery.Attempts).
+		Set("last_error", delivery.LastError).
+		Set("updated_at", delivery.UpdatedAt).
+		Where(sq.Eq{
+			"id":     delivery.ID,
+			"status": delivery.Status.Sources(),
+		})
+}
+
+func updateReminderDeliveriesQuery(
+	reminderID uuid.UUID,
+	from []domain.DeliveryStatus,
+	to domain.DeliveryStatus,
+	lastError string,
+	updatedAt time.Time,
+) sq.UpdateBuilder {
+	query := psql.Update("deliveries").
+		Set("status", to).
+		Set("updated_at", updatedAt)
+
+	if lastError != "" {
+		query = query.Set("last_error", lastError)
+	}
+
+	return query.
+		Where(sq.Eq{
+			"reminder_id": reminderID,
+			"status":      from,
+		})
+}
+
+func getDeviceStatusesQuery(deviceIDs []uuid.UUID) sq.SelectBuilder {
+	return psql.Select(
+		"device_id",
+		"online",
+		"failures",
+		"last_error",
+		"last_seen",
+		"checked_at",
+		"changed_at",
+	).
+		From("device_statuses").
+		Where(sq.Eq{
+			"device_id": deviceIDs,
+		})
+}
+
+func upsertDeviceStatusQuery(status domain.DeviceStatus) sq.InsertBuilder {
+	return psql.Insert("device_statuses").
+		Columns(
+			"device_id",
+			"online",
+			"failures",
+			"last_error",
+			"last_seen",
+			"checked_at",
+			"changed_at",
+		).
+		Values(
+			status.DeviceID,
+			status.Online,
+			status.Failures,
+			status.LastError,
+			status.LastSeen,
+			status.CheckedAt,
+			status.ChangedAt,
+		).
+		Suffix("ON CONFLICT (device_id) DO UPDATE SET online = EXCLUDED.online, failures = EXCLUDED.failures, " +
+			"last_error = EXCLUDED.last_error, last_seen = EXCLUDED.last_seen, " +
+			"checked_at = EXCLUDED.checked_at, changed_at = EXCLUDED.changed_at")
+}
@@ -0,0 +1,128 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/almostinf/glow-reminder/pkg/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ TokenRepo = (*tokenRepo)(nil)
+
+type TokenRepo interface {
+	// GetTokens returns the tokens of the user that are not revoked, including the expired ones.
+	GetTokens(ctx context.Context, userID int64) ([]*domain.Token, error)
+	GetTokenByHash(ctx context.Context, hash []byte) (*domain.Token, error)
+	// CreateToken returns domain.ErrAlreadyExists if the user already has a token with the same name.
+	CreateToken(ctx context.Context, token domain.Token) error
+	RevokeToken(ctx context.Context, id uuid.UUID, revokedAt time.Time) error
+}
+
+type tokenRepo struct {
+	pg     *postgres.Postgres
+	logger logger.Logger
+}
+
+func NewTokenRepo(pg *postgres.Postgres, logger logger.Logger) *tokenRepo {
+	return &tokenRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func (repo *tokenRepo) GetTokens(ctx context.Context, userID int64) ([]*domain.Token, error) {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := getTokensQuery(userID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := conn.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	tokens, err := pgx.CollectRows(rows, pgx.RowToStructByName[domain.Token])
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect rows: %w", err)
+	}
+
+	tokenPtrs := make([]*domain.Token, 0, len(tokens))
+	for i := range tokens {
+		tokenPtrs = append(tokenPtrs, &tokens[i])
+	}
+
+	return tokenPtrs, nil
+}
+
+func (repo *tokenRepo) GetTokenByHash(ctx context.Context, hash []byte) (*domain.Token, error) {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := getTokenByHashQuery(hash)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := conn.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	token, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[domain.Token])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("token: %w", domain.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to collect rows: %w", err)
+	}
+
+	return &token, nil
+}
+
+func (repo *tokenRepo) CreateToken(ctx context.Context, token domain.Token) error {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := createTokenQuery(token)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = conn.Exec(ctx, sqlQuery, args...); err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("token %q: %w", token.Name, domain.ErrAlreadyExists)
+		}
+		return fmt.Errorf("failed to Exec: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *tokenRepo) RevokeToken(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := revokeTokenQuery(id, revokedAt)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = conn.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec: %w", err)
+	}
+
+	return nil
+}
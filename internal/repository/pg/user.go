@@ -0,0 +1,74 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/almostinf/glow-reminder/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ UserRepo = (*userRepo)(nil)
+
+type UserRepo interface {
+	GetUser(ctx context.Context, id int64) (*domain.User, error)
+	UpsertUser(ctx context.Context, user domain.User) error
+}
+
+type userRepo struct {
+	pg     *postgres.Postgres
+	logger logger.Logger
+}
+
+func NewUserRepo(pg *postgres.Postgres, logger logger.Logger) *userRepo {
+	return &userRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func (repo *userRepo) GetUser(ctx context.Context, id int64) (*domain.User, error) {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := getUserQuery(id)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := conn.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	user, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[domain.User])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("user %d: %w", id, domain.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to collect rows: %w", err)
+	}
+
+	return &user, nil
+}
+
+func (repo *userRepo) UpsertUser(ctx context.Context, user domain.User) error {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := upsertUserQuery(user)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = conn.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec: %w", err)
+	}
+
+	return nil
+}
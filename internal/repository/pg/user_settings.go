@@ -0,0 +1,74 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/almostinf/glow-reminder/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ UserSettingsRepo = (*userSettingsRepo)(nil)
+
+type UserSettingsRepo interface {
+	GetUserSettings(ctx context.Context, userID int64) (*domain.UserSettings, error)
+	UpsertUserSettings(ctx context.Context, settings domain.UserSettings) error
+}
+
+type userSettingsRepo struct {
+	pg     *postgres.Postgres
+	logger logger.Logger
+}
+
+func NewUserSettingsRepo(pg *postgres.Postgres, logger logger.Logger) *userSettingsRepo {
+	return &userSettingsRepo{
+		pg:     pg,
+		logger: logger,
+	}
+}
+
+func (repo *userSettingsRepo) GetUserSettings(ctx context.Context, userID int64) (*domain.UserSettings, error) {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := getUserSettingsQuery(userID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	rows, err := conn.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	settings, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[domain.UserSettings])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("user settings %d: %w", userID, domain.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to collect rows: %w", err)
+	}
+
+	return &settings, nil
+}
+
+func (repo *userSettingsRepo) UpsertUserSettings(ctx context.Context, settings domain.UserSettings) error {
+	conn := repo.pg.GetTransactionConn(ctx)
+
+	query := upsertUserSettingsQuery(settings)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get sql query: %w", err)
+	}
+
+	if _, err = conn.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec: %w", err)
+	}
+
+	return nil
+}
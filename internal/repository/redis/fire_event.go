@@ -0,0 +1,95 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	rediswrapper "github.com/almostinf/glow-reminder/pkg/redis"
+)
+
+const fireEventsChannel = "reminder-fire-events"
+
+var _ FireEventRepo = (*fireEventRepo)(nil)
+
+// FireEventRepo carries the fire events between the instances, so that every instance can stream
+// the events of the reminders fired by the leader.
+type FireEventRepo interface {
+	PublishFireEvent(ctx context.Context, event *domain.FireEvent) error
+	// SubscribeFireEvents returns the fire events published by any instance from now on.
+	// The channel is closed once the context is done.
+	SubscribeFireEvents(ctx context.Context) (<-chan *domain.FireEvent, error)
+}
+
+type fireEventRepo struct {
+	redis  *rediswrapper.Redis
+	logger logger.Logger
+}
+
+func NewFireEventRepo(redis *rediswrapper.Redis, logger logger.Logger) *fireEventRepo {
+	return &fireEventRepo{
+		redis:  redis,
+		logger: logger,
+	}
+}
+
+func (repo *fireEventRepo) PublishFireEvent(ctx context.Context, event *domain.FireEvent) error {
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal fire event: %w", err)
+	}
+
+	if err = repo.redis.Publish(ctx, fireEventsChannel, eventBytes).Err(); err != nil {
+		return fmt.Errorf("failed to Publish fire event %v: %w", event.Reminder.ID, err)
+	}
+
+	return nil
+}
+
+func (repo *fireEventRepo) SubscribeFireEvents(ctx context.Context) (<-chan *domain.FireEvent, error) {
+	pubsub := repo.redis.Subscribe(ctx, fireEventsChannel)
+
+	// The subscription is confirmed before returning, so no event published afterwards is missed.
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, fmt.Errorf("failed to Subscribe fire events: %w", err)
+	}
+
+	events := make(chan *domain.FireEvent)
+
+	go func() {
+		defer close(events)
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				event := &domain.FireEvent{}
+				if err := json.Unmarshal([]byte(message.Payload), event); err != nil {
+					repo.logger.Warn("Skip malformed fire event", map[string]interface{}{
+						"error": err.Error(),
+					})
+					continue
+				}
+
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
@@ -0,0 +1,81 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	rediswrapper "github.com/almostinf/glow-reminder/pkg/redis"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const firedReminderKeyPrefix = "fired-reminder:"
+
+var _ FiredReminderRepo = (*firedReminderRepo)(nil)
+
+// FiredReminderRepo keeps a copy of the last fired occurrence of a reminder for a while,
+// so that the owner can snooze or acknowledge it even after a one-off reminder is deleted.
+type FiredReminderRepo interface {
+	SaveFiredReminder(ctx context.Context, reminder *domain.Reminder, ttl time.Duration) error
+	GetFiredReminder(ctx context.Context, id uuid.UUID) (*domain.Reminder, error)
+	DeleteFiredReminder(ctx context.Context, id uuid.UUID) error
+}
+
+type firedReminderRepo struct {
+	redis  *rediswrapper.Redis
+	logger logger.Logger
+}
+
+func NewFiredReminderRepo(redis *rediswrapper.Redis, logger logger.Logger) *firedReminderRepo {
+	return &firedReminderRepo{
+		redis:  redis,
+		logger: logger,
+	}
+}
+
+func (repo *firedReminderRepo) SaveFiredReminder(ctx context.Context, reminder *domain.Reminder, ttl time.Duration) error {
+	reminderBytes, err := json.Marshal(reminder)
+	if err != nil {
+		return fmt.Errorf("failed to marshal fired reminder: %w", err)
+	}
+
+	if err = repo.redis.Set(ctx, firedReminderKey(reminder.ID), reminderBytes, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to Set fired reminder %v: %w", reminder.ID, err)
+	}
+
+	return nil
+}
+
+func (repo *firedReminderRepo) GetFiredReminder(ctx context.Context, id uuid.UUID) (*domain.Reminder, error) {
+	reminderBytes, err := repo.redis.Get(ctx, firedReminderKey(id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get fired reminder %v: %w", id, err)
+	}
+
+	reminder := &domain.Reminder{}
+	if err = json.Unmarshal(reminderBytes, reminder); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal fired reminder: %w", err)
+	}
+
+	return reminder, nil
+}
+
+func (repo *firedReminderRepo) DeleteFiredReminder(ctx context.Context, id uuid.UUID) error {
+	if err := repo.redis.Del(ctx, firedReminderKey(id)).Err(); err != nil {
+		return fmt.Errorf("failed to Del fired reminder %v: %w", id, err)
+	}
+
+	return nil
+}
+
+func firedReminderKey(id uuid.UUID) string {
+	return firedReminderKeyPrefix + id.String()
+}
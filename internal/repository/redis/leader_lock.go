@@ -0,0 +1,116 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	rediswrapper "github.com/almostinf/glow-reminder/pkg/redis"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// leaderLockKey holds the id of the instance leading the background workers.
+	leaderLockKey = "leader-lock"
+	// leaderFencingTokenKey holds the fencing token of the current leader, it grows with every new term.
+	leaderFencingTokenKey = "leader-fencing-token"
+)
+
+// acquireLeaderLockScript takes the free lock and starts a new term, returning its fencing token, or 0 if the lock is held.
+//
+// KEYS[1] - leader lock, KEYS[2] - leader fencing token,
+// ARGV[1] - instance id, ARGV[2] - lock ttl in milliseconds.
+var acquireLeaderLockScript = redis.NewScript(`
+if redis.call('SET', KEYS[1], ARGV[1], 'NX', 'PX', ARGV[2]) then
+	return redis.call('INCR', KEYS[2])
+end
+return 0
+`)
+
+// renewLeaderLockScript extends the lock if it is still held by the instance.
+//
+// KEYS[1] - leader lock, ARGV[1] - instance id, ARGV[2] - lock ttl in milliseconds.
+var renewLeaderLockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseLeaderLockScript deletes the lock if it is still held by the instance.
+//
+// KEYS[1] - leader lock, ARGV[1] - instance id.
+var releaseLeaderLockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+var _ LeaderLockRepo = (*leaderLockRepo)(nil)
+
+// LeaderLockRepo keeps a lease based lock on the leadership of the instances.
+// Every acquisition starts a new term with a greater fencing token, which the writes of the leader are checked against.
+type LeaderLockRepo interface {
+	// AcquireLeaderLock takes the lock for the ttl if it is free and returns the fencing token of the new term.
+	AcquireLeaderLock(ctx context.Context, instanceID string, ttl time.Duration) (int64, bool, error)
+	// RenewLeaderLock extends the lock for the ttl and reports whether the instance still holds it.
+	RenewLeaderLock(ctx context.Context, instanceID string, ttl time.Duration) (bool, error)
+	// ReleaseLeaderLock frees the lock held by the instance, so another instance takes over without waiting for the ttl.
+	ReleaseLeaderLock(ctx context.Context, instanceID string) error
+}
+
+type leaderLockRepo struct {
+	redis  *rediswrapper.Redis
+	logger logger.Logger
+}
+
+func NewLeaderLockRepo(redis *rediswrapper.Redis, logger logger.Logger) *leaderLockRepo {
+	return &leaderLockRepo{
+		redis:  redis,
+		logger: logger,
+	}
+}
+
+func (repo *leaderLockRepo) AcquireLeaderLock(
+	ctx context.Context,
+	instanceID string,
+	ttl time.Duration,
+) (int64, bool, error) {
+	token, err := acquireLeaderLockScript.Run(
+		ctx,
+		repo.redis,
+		[]string{leaderLockKey, leaderFencingTokenKey},
+		instanceID,
+		ttl.Milliseconds(),
+	).Int64()
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to run acquire leader lock script: %w", err)
+	}
+
+	return token, token != 0, nil
+}
+
+func (repo *leaderLockRepo) RenewLeaderLock(ctx context.Context, instanceID string, ttl time.Duration) (bool, error) {
+	renewed, err := renewLeaderLockScript.Run(
+		ctx,
+		repo.redis,
+		[]string{leaderLockKey},
+		instanceID,
+		ttl.Milliseconds(),
+	).Int64()
+	if err != nil {
+		return false, fmt.Errorf("failed to run renew leader lock script: %w", err)
+	}
+
+	return renewed == 1, nil
+}
+
+func (repo *leaderLockRepo) ReleaseLeaderLock(ctx context.Context, instanceID string) error {
+	if err := releaseLeaderLockScript.Run(ctx, repo.redis, []string{leaderLockKey}, instanceID).Err(); err != nil {
+		return fmt.Errorf("failed to run release leader lock script: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,261 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/almostinf/glow-reminder/internal/repository/redis (interfaces: ReminderTaskRepo,FiredReminderRepo,FireEventRepo)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/redis_mocks.go github.com/almostinf/glow-reminder/internal/repository/redis ReminderTaskRepo,FiredReminderRepo,FireEventRepo
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/almostinf/glow-reminder/internal/domain"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReminderTaskRepo is a mock of ReminderTaskRepo interface.
+type MockReminderTaskRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderTaskRepoMockRecorder
+}
+
+// MockReminderTaskRepoMockRecorder is the mock recorder for MockReminderTaskRepo.
+type MockReminderTaskRepoMockRecorder struct {
+	mock *MockReminderTaskRepo
+}
+
+// NewMockReminderTaskRepo creates a new mock instance.
+func NewMockReminderTaskRepo(ctrl *gomock.Controller) *MockReminderTaskRepo {
+	mock := &MockReminderTaskRepo{ctrl: ctrl}
+	mock.recorder = &MockReminderTaskRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderTaskRepo) EXPECT() *MockReminderTaskRepoMockRecorder {
+	return m.recorder
+}
+
+// AckReminderTask mocks base method.
+func (m *MockReminderTaskRepo) AckReminderTask(arg0 context.Context, arg1 *domain.ReminderTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AckReminderTask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AckReminderTask indicates an expected call of AckReminderTask.
+func (mr *MockReminderTaskRepoMockRecorder) AckReminderTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AckReminderTask", reflect.TypeOf((*MockReminderTaskRepo)(nil).AckReminderTask), arg0, arg1)
+}
+
+// AddReminderTask mocks base method.
+func (m *MockReminderTaskRepo) AddReminderTask(arg0 context.Context, arg1 *domain.ReminderTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReminderTask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReminderTask indicates an expected call of AddReminderTask.
+func (mr *MockReminderTaskRepoMockRecorder) AddReminderTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReminderTask", reflect.TypeOf((*MockReminderTaskRepo)(nil).AddReminderTask), arg0, arg1)
+}
+
+// ClaimReminderTasks mocks base method.
+func (m *MockReminderTaskRepo) ClaimReminderTasks(arg0 context.Context, arg1 int64, arg2 time.Duration, arg3 int64) ([]*domain.ReminderTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimReminderTasks", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.ReminderTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimReminderTasks indicates an expected call of ClaimReminderTasks.
+func (mr *MockReminderTaskRepoMockRecorder) ClaimReminderTasks(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimReminderTasks", reflect.TypeOf((*MockReminderTaskRepo)(nil).ClaimReminderTasks), arg0, arg1, arg2, arg3)
+}
+
+// DeleteReminderTask mocks base method.
+func (m *MockReminderTaskRepo) DeleteReminderTask(arg0 context.Context, arg1 *domain.ReminderTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReminderTask", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReminderTask indicates an expected call of DeleteReminderTask.
+func (mr *MockReminderTaskRepoMockRecorder) DeleteReminderTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReminderTask", reflect.TypeOf((*MockReminderTaskRepo)(nil).DeleteReminderTask), arg0, arg1)
+}
+
+// IncrReminderTaskAttempts mocks base method.
+func (m *MockReminderTaskRepo) IncrReminderTaskAttempts(arg0 context.Context, arg1 *domain.ReminderTask) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrReminderTaskAttempts", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrReminderTaskAttempts indicates an expected call of IncrReminderTaskAttempts.
+func (mr *MockReminderTaskRepoMockRecorder) IncrReminderTaskAttempts(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrReminderTaskAttempts", reflect.TypeOf((*MockReminderTaskRepo)(nil).IncrReminderTaskAttempts), arg0, arg1)
+}
+
+// NackReminderTask mocks base method.
+func (m *MockReminderTaskRepo) NackReminderTask(arg0 context.Context, arg1 *domain.ReminderTask, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NackReminderTask", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NackReminderTask indicates an expected call of NackReminderTask.
+func (mr *MockReminderTaskRepoMockRecorder) NackReminderTask(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NackReminderTask", reflect.TypeOf((*MockReminderTaskRepo)(nil).NackReminderTask), arg0, arg1, arg2)
+}
+
+// ReconcileReminderTasks mocks base method.
+func (m *MockReminderTaskRepo) ReconcileReminderTasks(arg0 context.Context, arg1 []*domain.ReminderTask, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileReminderTasks", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileReminderTasks indicates an expected call of ReconcileReminderTasks.
+func (mr *MockReminderTaskRepoMockRecorder) ReconcileReminderTasks(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileReminderTasks", reflect.TypeOf((*MockReminderTaskRepo)(nil).ReconcileReminderTasks), arg0, arg1, arg2)
+}
+
+// MockFiredReminderRepo is a mock of FiredReminderRepo interface.
+type MockFiredReminderRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockFiredReminderRepoMockRecorder
+}
+
+// MockFiredReminderRepoMockRecorder is the mock recorder for MockFiredReminderRepo.
+type MockFiredReminderRepoMockRecorder struct {
+	mock *MockFiredReminderRepo
+}
+
+// NewMockFiredReminderRepo creates a new mock instance.
+func NewMockFiredReminderRepo(ctrl *gomock.Controller) *MockFiredReminderRepo {
+	mock := &MockFiredReminderRepo{ctrl: ctrl}
+	mock.recorder = &MockFiredReminderRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFiredReminderRepo) EXPECT() *MockFiredReminderRepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteFiredReminder mocks base method.
+func (m *MockFiredReminderRepo) DeleteFiredReminder(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFiredReminder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFiredReminder indicates an expected call of DeleteFiredReminder.
+func (mr *MockFiredReminderRepoMockRecorder) DeleteFiredReminder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFiredReminder", reflect.TypeOf((*MockFiredReminderRepo)(nil).DeleteFiredReminder), arg0, arg1)
+}
+
+// GetFiredReminder mocks base method.
+func (m *MockFiredReminderRepo) GetFiredReminder(arg0 context.Context, arg1 uuid.UUID) (*domain.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFiredReminder", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFiredReminder indicates an expected call of GetFiredReminder.
+func (mr *MockFiredReminderRepoMockRecorder) GetFiredReminder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFiredReminder", reflect.TypeOf((*MockFiredReminderRepo)(nil).GetFiredReminder), arg0, arg1)
+}
+
+// SaveFiredReminder mocks base method.
+func (m *MockFiredReminderRepo) SaveFiredReminder(arg0 context.Context, arg1 *domain.Reminder, arg2 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFiredReminder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveFiredReminder indicates an expected call of SaveFiredReminder.
+func (mr *MockFiredReminderRepoMockRecorder) SaveFiredReminder(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFiredReminder", reflect.TypeOf((*MockFiredReminderRepo)(nil).SaveFiredReminder), arg0, arg1, arg2)
+}
+
+// MockFireEventRepo is a mock of FireEventRepo interface.
+type MockFireEventRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockFireEventRepoMockRecorder
+}
+
+// MockFireEventRepoMockRecorder is the mock recorder for MockFireEventRepo.
+type MockFireEventRepoMockRecorder struct {
+	mock *MockFireEventRepo
+}
+
+// NewMockFireEventRepo creates a new mock instance.
+func NewMockFireEventRepo(ctrl *gomock.Controller) *MockFireEventRepo {
+	mock := &MockFireEventRepo{ctrl: ctrl}
+	mock.recorder = &MockFireEventRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFireEventRepo) EXPECT() *MockFireEventRepoMockRecorder {
+	return m.recorder
+}
+
+// PublishFireEvent mocks base method.
+func (m *MockFireEventRepo) PublishFireEvent(arg0 context.Context, arg1 *domain.FireEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishFireEvent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishFireEvent indicates an expected call of PublishFireEvent.
+func (mr *MockFireEventRepoMockRecorder) PublishFireEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishFireEvent", reflect.TypeOf((*MockFireEventRepo)(nil).PublishFireEvent), arg0, arg1)
+}
+
+// SubscribeFireEvents mocks base method.
+func (m *MockFireEventRepo) SubscribeFireEvents(arg0 context.Context) (<-chan *domain.FireEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeFireEvents", arg0)
+	ret0, _ := ret[0].(<-chan *domain.FireEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeFireEvents indicates an expected call of SubscribeFireEvents.
+func (mr *MockFireEventRepoMockRecorder) SubscribeFireEvents(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeFireEvents", reflect.TypeOf((*MockFireEventRepo)(nil).SubscribeFireEvents), arg0)
+}
@@ -3,8 +3,9 @@ package redis
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"strconv"
+	"time"
 
 	"github.com/almostinf/glow-reminder/internal/domain"
 	"github.com/almostinf/glow-reminder/pkg/logger"
@@ -12,13 +13,120 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
-const reminderTasksKey = "reminder-tasks"
+const (
+	reminderTasksKey = "reminder-tasks"
+	// inFlightReminderTasksKey holds the claimed but not yet acknowledged tasks scored by their lease expiry.
+	inFlightReminderTasksKey = "reminder-tasks-in-flight"
+	// reminderTaskAttemptsKey holds the number of failed delivery attempts per reminder id.
+	reminderTaskAttemptsKey = "reminder-task-attempts"
+)
+
+// claimReminderTasksScript moves the due tasks into the in-flight set and extends the lease
+// of the in-flight tasks whose lease has expired, so they are delivered again.
+// It returns nil without claiming anything if the fencing token is not the one of the current leader.
+//
+// KEYS[1] - reminder tasks, KEYS[2] - in-flight reminder tasks, KEYS[3] - leader fencing token,
+// ARGV[1] - current unix time, ARGV[2] - lease expiry unix time, ARGV[3] - fencing token.
+var claimReminderTasksScript = redis.NewScript(`
+if redis.call('GET', KEYS[3]) ~= ARGV[3] then
+	return false
+end
+
+local claimed = {}
+local seen = {}
+
+local due = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1])
+for _, member in ipairs(due) do
+	redis.call('ZREM', KEYS[1], member)
+	redis.call('ZADD', KEYS[2], ARGV[2], member)
+	seen[member] = true
+	table.insert(claimed, member)
+end
+
+local expired = redis.call('ZRANGEBYSCORE', KEYS[2], '-inf', ARGV[1])
+for _, member in ipairs(expired) do
+	redis.call('ZADD', KEYS[2], ARGV[2], member)
+	if not seen[member] then
+		table.insert(claimed, member)
+	end
+end
+
+return claimed
+`)
+
+// reconcileReminderTasksScript adds the tasks missing in both the queue and the in-flight set and drops the orphan ones,
+// the tasks of the reminders that no longer exist. The snoozed tasks, the in-flight tasks with an active lease
+// and the attempts of the kept tasks are left as they are. A queued task only has its score moved later,
+// so a retried or deferred task keeps its time, while a stale earlier one is moved to the time of its reminder.
+// It returns the numbers of the added and the dropped tasks.
+//
+// KEYS[1] - reminder tasks, KEYS[2] - in-flight reminder tasks, KEYS[3] - reminder task attempts,
+// ARGV[1] - current unix time, ARGV[2], ARGV[3], ... - pairs of the expected task and its unix time.
+var reconcileReminderTasksScript = redis.NewScript(`
+local expected = {}
+for i = 2, #ARGV, 2 do
+	expected[ARGV[i]] = ARGV[i + 1]
+end
+
+local dropped = 0
+local function dropOrphan(key, member)
+	local task = cjson.decode(member)
+	if task.snoozed or expected[member] then
+		return
+	end
+	redis.call('ZREM', key, member)
+	redis.call('HDEL', KEYS[3], task.id)
+	dropped = dropped + 1
+end
+
+for _, member in ipairs(redis.call('ZRANGE', KEYS[1], 0, -1)) do
+	dropOrphan(KEYS[1], member)
+end
+
+for _, member in ipairs(redis.call('ZRANGEBYSCORE', KEYS[2], '-inf', ARGV[1])) do
+	dropOrphan(KEYS[2], member)
+end
+
+local added = 0
+for member, score in pairs(expected) do
+	if redis.call('ZSCORE', KEYS[1], member) then
+		redis.call('ZADD', KEYS[1], 'GT', score, member)
+	elseif not redis.call('ZSCORE', KEYS[2], member) then
+		redis.call('ZADD', KEYS[1], score, member)
+		added = added + 1
+	end
+end
+
+return {added, dropped}
+`)
+
+// ErrStaleFencingToken is returned to a former leader trying to claim the tasks after another instance has taken the lead.
+var ErrStaleFencingToken = errors.New("stale fencing token")
+
+//go:generate mockgen -package mocks -destination mocks/redis_mocks.go github.com/almostinf/glow-reminder/internal/repository/redis ReminderTaskRepo,FiredReminderRepo,FireEventRepo
 
 var _ ReminderTaskRepo = (*reminderTaskRepo)(nil)
 
 type ReminderTaskRepo interface {
 	AddReminderTask(ctx context.Context, reminderTask *domain.ReminderTask) error
-	GetReminderTasks(ctx context.Context, to int64) ([]*domain.ReminderTask, error)
+	// ClaimReminderTasks leases the tasks due by now. A claimed task must be acknowledged with AckReminderTask
+	// or returned with NackReminderTask, otherwise it is claimed again after the lease expires.
+	// It returns ErrStaleFencingToken if another instance has taken the lead since the fencing token was issued.
+	ClaimReminderTasks(
+		ctx context.Context,
+		now int64,
+		lease time.Duration,
+		fencingToken int64,
+	) ([]*domain.ReminderTask, error)
+	AckReminderTask(ctx context.Context, reminderTask *domain.ReminderTask) error
+	NackReminderTask(ctx context.Context, reminderTask *domain.ReminderTask, retryAt time.Time) error
+	// IncrReminderTaskAttempts counts a failed delivery attempt of the task and returns the number of attempts so far.
+	// The counter is reset when the task is acknowledged or deleted.
+	IncrReminderTaskAttempts(ctx context.Context, reminderTask *domain.ReminderTask) (int64, error)
+	DeleteReminderTask(ctx context.Context, reminderTask *domain.ReminderTask) error
+	// ReconcileReminderTasks atomically adds the given tasks missing in the queue and drops the tasks of the reminders
+	// not given. The snoozed tasks, the tasks leased by now and the delivery attempts of the kept tasks are preserved.
+	ReconcileReminderTasks(ctx context.Context, reminderTasks []*domain.ReminderTask, now int64) error
 }
 
 type reminderTaskRepo struct {
@@ -56,27 +164,27 @@ func (repo *reminderTaskRepo) AddReminderTask(ctx context.Context, reminderTask
 	return nil
 }
 
-func (repo *reminderTaskRepo) GetReminderTasks(ctx context.Context, to int64) ([]*domain.ReminderTask, error) {
-	pipe := repo.redis.TxPipeline()
-
-	min := "-inf"
-	max := strconv.FormatInt(to, 10)
+func (repo *reminderTaskRepo) ClaimReminderTasks(
+	ctx context.Context,
+	now int64,
+	lease time.Duration,
+	fencingToken int64,
+) ([]*domain.ReminderTask, error) {
+	leaseExpiry := now + int64(lease.Seconds())
 
-	pipe.ZRangeByScore(ctx, reminderTasksKey, &redis.ZRangeBy{
-		Min: min,
-		Max: max,
-	})
-
-	pipe.ZRemRangeByScore(ctx, reminderTasksKey, min, max)
-
-	resp, err := pipe.Exec(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to Exec GetReminderTasks: %w", err)
+	reminderTasksBytes, err := claimReminderTasksScript.Run(
+		ctx,
+		repo.redis,
+		[]string{reminderTasksKey, inFlightReminderTasksKey, leaderFencingTokenKey},
+		now,
+		leaseExpiry,
+		fencingToken,
+	).StringSlice()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrStaleFencingToken
 	}
-
-	reminderTasksBytes, err := resp[0].(*redis.StringSliceCmd).Result()
 	if err != nil {
-		return nil, fmt.Errorf("failed to read reminder tasks: %w", err)
+		return nil, fmt.Errorf("failed to run claim reminder tasks script: %w", err)
 	}
 
 	reminderTasks := make([]*domain.ReminderTask, 0, len(reminderTasksBytes))
@@ -91,17 +199,140 @@ func (repo *reminderTaskRepo) GetReminderTasks(ctx context.Context, to int64) ([
 	return reminderTasks, nil
 }
 
-func (repo *reminderTaskRepo) DeleteReminderTask(ctx context.Context, reminderTask *domain.ReminderTask) error {
+func (repo *reminderTaskRepo) AckReminderTask(ctx context.Context, reminderTask *domain.ReminderTask) error {
+	reminderTaskBytes, err := json.Marshal(reminderTask)
+	if err != nil {
+		return fmt.Errorf("failed to marshal reminder task: %w", err)
+	}
+
+	pipe := repo.redis.TxPipeline()
+
+	pipe.ZRem(ctx, inFlightReminderTasksKey, reminderTaskBytes)
+	pipe.HDel(ctx, reminderTaskAttemptsKey, attemptsField(reminderTask))
+
+	if _, err = pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to Exec AckReminderTask %v: %w", reminderTask.ID, err)
+	}
+
+	return nil
+}
+
+func (repo *reminderTaskRepo) NackReminderTask(
+	ctx context.Context,
+	reminderTask *domain.ReminderTask,
+	retryAt time.Time,
+) error {
+	reminderTaskBytes, err := json.Marshal(reminderTask)
+	if err != nil {
+		return fmt.Errorf("failed to marshal reminder task: %w", err)
+	}
+
 	pipe := repo.redis.TxPipeline()
 
+	pipe.ZRem(ctx, inFlightReminderTasksKey, reminderTaskBytes)
+	// LT keeps an earlier score if the task has already been rescheduled.
+	pipe.ZAddLT(ctx, reminderTasksKey, redis.Z{
+		Member: reminderTaskBytes,
+		Score:  float64(retryAt.Unix()),
+	})
+
+	if _, err = pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to Exec NackReminderTask %v: %w", reminderTask.ID, err)
+	}
+
+	repo.logger.Info("Nack reminder task", map[string]interface{}{
+		"id":       reminderTask.ID,
+		"retry_at": retryAt,
+	})
+
+	return nil
+}
+
+func (repo *reminderTaskRepo) IncrReminderTaskAttempts(
+	ctx context.Context,
+	reminderTask *domain.ReminderTask,
+) (int64, error) {
+	attempts, err := repo.redis.HIncrBy(ctx, reminderTaskAttemptsKey, attemptsField(reminderTask), 1).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to HIncrBy reminder task attempts %v: %w", reminderTask.ID, err)
+	}
+
+	return attempts, nil
+}
+
+func (repo *reminderTaskRepo) DeleteReminderTask(ctx context.Context, reminderTask *domain.ReminderTask) error {
 	reminderTaskBytes, err := json.Marshal(reminderTask)
 	if err != nil {
 		return fmt.Errorf("failed to marshal reminder task: %w", err)
 	}
 
-	if err = pipe.ZRem(ctx, reminderTasksKey, reminderTaskBytes).Err(); err != nil {
-		return fmt.Errorf("failed to ZRem reminder task %v: %w", reminderTask.ID, err)
+	snoozedTask := *reminderTask
+	snoozedTask.Snoozed = true
+
+	snoozedTaskBytes, err := json.Marshal(&snoozedTask)
+	if err != nil {
+		return fmt.Errorf("failed to marshal snoozed reminder task: %w", err)
 	}
 
+	pipe := repo.redis.TxPipeline()
+
+	// A deleted reminder must not fire after being snoozed either.
+	pipe.ZRem(ctx, reminderTasksKey, reminderTaskBytes, snoozedTaskBytes)
+	pipe.ZRem(ctx, inFlightReminderTasksKey, reminderTaskBytes, snoozedTaskBytes)
+	pipe.HDel(ctx, reminderTaskAttemptsKey, attemptsField(reminderTask), attemptsField(&snoozedTask))
+
+	if _, err = pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to Exec DeleteReminderTask %v: %w", reminderTask.ID, err)
+	}
+
+	repo.logger.Info("Delete reminder task", map[string]interface{}{
+		"id": reminderTask.ID,
+	})
+
+	return nil
+}
+
+func (repo *reminderTaskRepo) ReconcileReminderTasks(
+	ctx context.Context,
+	reminderTasks []*domain.ReminderTask,
+	now int64,
+) error {
+	args := make([]interface{}, 0, 1+2*len(reminderTasks))
+	args = append(args, now)
+
+	for _, reminderTask := range reminderTasks {
+		reminderTaskBytes, err := json.Marshal(reminderTask)
+		if err != nil {
+			return fmt.Errorf("failed to marshal reminder task: %w", err)
+		}
+
+		args = append(args, reminderTaskBytes, reminderTask.ScheduledAt.Unix())
+	}
+
+	counts, err := reconcileReminderTasksScript.Run(
+		ctx,
+		repo.redis,
+		[]string{reminderTasksKey, inFlightReminderTasksKey, reminderTaskAttemptsKey},
+		args...,
+	).Int64Slice()
+	if err != nil {
+		return fmt.Errorf("failed to run reconcile reminder tasks script: %w", err)
+	}
+
+	repo.logger.Info("Reconcile reminder tasks", map[string]interface{}{
+		"count":   len(reminderTasks),
+		"added":   counts[0],
+		"dropped": counts[1],
+	})
+
 	return nil
 }
+
+// attemptsField counts the attempts of a snoozed task apart from the regular task of the same reminder.
+func attemptsField(reminderTask *domain.ReminderTask) string {
+	if reminderTask.Snoozed {
+		return reminderTask.ID.String() + ":snoozed"
+	}
+
+	return reminderTask.ID.String()
+}
@@ -0,0 +1,195 @@
+package redis_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/repository/redis"
+	logger_mocks "github.com/almostinf/glow-reminder/pkg/logger/mocks"
+	rediswrapper "github.com/almostinf/glow-reminder/pkg/redis"
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	reminderTasksKey         = "reminder-tasks"
+	inFlightReminderTasksKey = "reminder-tasks-in-flight"
+	reminderTaskAttemptsKey  = "reminder-task-attempts"
+
+	now = int64(1_000)
+)
+
+func TestReconcileReminderTasks(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("6f0c1c7e-3f7b-4a52-9d2e-0b8f5d4c2a11")
+
+	task := member(t, domain.ReminderTask{ID: id})
+	snoozedTask := member(t, domain.ReminderTask{ID: id, Snoozed: true})
+
+	testcases := []struct {
+		name             string
+		queued           map[string]float64
+		inFlight         map[string]float64
+		attempts         map[string]string
+		reminderTasks    []*domain.ReminderTask
+		expectedQueued   map[string]float64
+		expectedInFlight map[string]float64
+		expectedAttempts map[string]string
+	}{
+		{
+			name:           "adds a lost task",
+			reminderTasks:  []*domain.ReminderTask{{ID: id, ScheduledAt: time.Unix(1_200, 0)}},
+			expectedQueued: map[string]float64{task: 1_200},
+		},
+		{
+			name:             "keeps the time and the attempts of a retried task",
+			queued:           map[string]float64{task: 1_500},
+			attempts:         map[string]string{id.String(): "2"},
+			reminderTasks:    []*domain.ReminderTask{{ID: id, ScheduledAt: time.Unix(900, 0)}},
+			expectedQueued:   map[string]float64{task: 1_500},
+			expectedAttempts: map[string]string{id.String(): "2"},
+		},
+		{
+			name:           "moves a stale task to the time of the reminder",
+			queued:         map[string]float64{task: 800},
+			reminderTasks:  []*domain.ReminderTask{{ID: id, ScheduledAt: time.Unix(2_000, 0)}},
+			expectedQueued: map[string]float64{task: 2_000},
+		},
+		{
+			name:             "keeps a leased task in flight",
+			inFlight:         map[string]float64{task: 1_100},
+			reminderTasks:    []*domain.ReminderTask{{ID: id, ScheduledAt: time.Unix(900, 0)}},
+			expectedInFlight: map[string]float64{task: 1_100},
+		},
+		{
+			name:             "keeps a task with an expired lease to be claimed again",
+			inFlight:         map[string]float64{task: 900},
+			reminderTasks:    []*domain.ReminderTask{{ID: id, ScheduledAt: time.Unix(800, 0)}},
+			expectedInFlight: map[string]float64{task: 900},
+		},
+		{
+			name:     "drops an orphan task with its attempts",
+			queued:   map[string]float64{task: 700},
+			attempts: map[string]string{id.String(): "2"},
+		},
+		{
+			name:     "drops an orphan task with an expired lease",
+			inFlight: map[string]float64{task: 900},
+		},
+		{
+			name:             "keeps an orphan task being processed",
+			inFlight:         map[string]float64{task: 1_100},
+			expectedInFlight: map[string]float64{task: 1_100},
+		},
+		{
+			name:             "keeps a snoozed task",
+			queued:           map[string]float64{snoozedTask: 1_300},
+			attempts:         map[string]string{id.String() + ":snoozed": "1"},
+			reminderTasks:    []*domain.ReminderTask{{ID: id, ScheduledAt: time.Unix(5_000, 0)}},
+			expectedQueued:   map[string]float64{snoozedTask: 1_300, task: 5_000},
+			expectedAttempts: map[string]string{id.String() + ":snoozed": "1"},
+		},
+		{
+			name:             "keeps a snoozed task of a deleted reminder",
+			inFlight:         map[string]float64{snoozedTask: 900},
+			expectedInFlight: map[string]float64{snoozedTask: 900},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := miniredis.RunT(t)
+
+			for member, score := range testcase.queued {
+				_, err := server.ZAdd(reminderTasksKey, score, member)
+				require.NoError(t, err)
+			}
+			for member, score := range testcase.inFlight {
+				_, err := server.ZAdd(inFlightReminderTasksKey, score, member)
+				require.NoError(t, err)
+			}
+			for field, value := range testcase.attempts {
+				server.HSet(reminderTaskAttemptsKey, field, value)
+			}
+
+			repo := newReminderTaskRepo(t, server)
+
+			require.NoError(t, repo.ReconcileReminderTasks(context.Background(), testcase.reminderTasks, now))
+
+			assert.Equal(t, orEmpty(testcase.expectedQueued), zset(t, server, reminderTasksKey))
+			assert.Equal(t, orEmpty(testcase.expectedInFlight), zset(t, server, inFlightReminderTasksKey))
+			assert.Equal(t, orEmpty(testcase.expectedAttempts), hash(t, server, reminderTaskAttemptsKey))
+		})
+	}
+}
+
+func newReminderTaskRepo(t *testing.T, server *miniredis.Miniredis) redis.ReminderTaskRepo {
+	client := goredis.NewClient(&goredis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	log := logger_mocks.NewMockLogger(gomock.NewController(t))
+	log.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	return redis.NewReminderTaskRepo(&rediswrapper.Redis{Client: client}, log)
+}
+
+func member(t *testing.T, reminderTask domain.ReminderTask) string {
+	reminderTaskBytes, err := json.Marshal(reminderTask)
+	require.NoError(t, err)
+
+	return string(reminderTaskBytes)
+}
+
+func zset(t *testing.T, server *miniredis.Miniredis, key string) map[string]float64 {
+	members := make(map[string]float64)
+	if !server.Exists(key) {
+		return members
+	}
+
+	names, err := server.ZMembers(key)
+	require.NoError(t, err)
+
+	for _, name := range names {
+		score, err := server.ZScore(key, name)
+		require.NoError(t, err)
+		members[name] = score
+	}
+
+	return members
+}
+
+func hash(t *testing.T, server *miniredis.Miniredis, key string) map[string]string {
+	fields := make(map[string]string)
+	if !server.Exists(key) {
+		return fields
+	}
+
+	names, err := server.HKeys(key)
+	require.NoError(t, err)
+
+	for _, field := range names {
+		fields[field] = server.HGet(key, field)
+	}
+
+	return fields
+}
+
+func orEmpty[V any](m map[string]V) map[string]V {
+	if m == nil {
+		return map[string]V{}
+	}
+
+	return m
+}
@@ -8,10 +8,31 @@ import (
 
 type Config struct {
 	CycleDuration time.Duration
+	// LeaseDuration is how long a claimed reminder task is hidden from other cycles.
+	// An unacknowledged task is delivered again after its lease expires.
+	LeaseDuration time.Duration
+	// RetryMaxAttempts is how many times the lamp is tried before the reminder occurrence is dead-lettered.
+	RetryMaxAttempts int64
+	// RetryInitialBackoff, RetryMaxBackoff, RetryMultiplier and RetryJitter shape the delays between attempts.
+	RetryInitialBackoff time.Duration
+	RetryMaxBackoff     time.Duration
+	RetryMultiplier     float64
+	RetryJitter         float64
+	// SnoozeWindow is how long the Done and Snooze buttons of a fired reminder keep working.
+	SnoozeWindow time.Duration
 }
 
 func FromAppConfig(appCfg *config.AppConfig) Config {
 	return Config{
 		CycleDuration: appCfg.Scheduler.CycleDuration,
+		LeaseDuration: appCfg.Scheduler.LeaseDuration,
+
+		RetryMaxAttempts:    appCfg.Scheduler.RetryMaxAttempts,
+		RetryInitialBackoff: appCfg.Scheduler.RetryInitialBackoff,
+		RetryMaxBackoff:     appCfg.Scheduler.RetryMaxBackoff,
+		RetryMultiplier:     appCfg.Scheduler.RetryMultiplier,
+		RetryJitter:         appCfg.Scheduler.RetryJitter,
+
+		SnoozeWindow: appCfg.Scheduler.SnoozeWindow,
 	}
 }
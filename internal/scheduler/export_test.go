@@ -0,0 +1,14 @@
+package scheduler
+
+import (
+	"context"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+)
+
+// Scheduler exposes the scheduler to the tests of processing the reminder tasks.
+type Scheduler = reminderScheduler
+
+func (scheduler *reminderScheduler) ProcessReminderTask(ctx context.Context, reminderTask *domain.ReminderTask) error {
+	return scheduler.processReminderTask(ctx, reminderTask)
+}
@@ -0,0 +1,123 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/repository/redis"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"gopkg.in/tomb.v2"
+)
+
+// fireEventsBuffer is the number of events a slow subscriber can lag behind before its events are dropped.
+const fireEventsBuffer = 16
+
+var _ FireEvents = (*fireEvents)(nil)
+
+// FireEvents fans out the fire events of the scheduler to the subscribers of every instance.
+// Only the leader fires the reminders, so the events go through Redis to the other instances.
+type FireEvents interface {
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+	// Publish never fails the scheduler, the event is dropped if it cannot be sent
+	// and for the subscribers that lag behind.
+	Publish(ctx context.Context, event domain.FireEvent)
+	// Subscribe returns the events of the user fired from now on.
+	// The channel is closed once the context is done.
+	Subscribe(ctx context.Context, userID int64) <-chan domain.FireEvent
+}
+
+type fireEvents struct {
+	fireEventRepo redis.FireEventRepo
+	logger        logger.Logger
+	tomb          tomb.Tomb
+
+	m           sync.Mutex
+	subscribers map[int64]map[chan domain.FireEvent]struct{}
+}
+
+func NewFireEvents(fireEventRepo redis.FireEventRepo, logger logger.Logger) *fireEvents {
+	return &fireEvents{
+		fireEventRepo: fireEventRepo,
+		logger:        logger,
+		tomb:          tomb.Tomb{},
+		subscribers:   make(map[int64]map[chan domain.FireEvent]struct{}),
+	}
+}
+
+// Start subscribes to the events published by every instance and passes them to the subscribers of this one.
+func (events *fireEvents) Start(ctx context.Context) error {
+	published, err := events.fireEventRepo.SubscribeFireEvents(events.tomb.Context(context.WithoutCancel(ctx)))
+	if err != nil {
+		return fmt.Errorf("failed to SubscribeFireEvents: %w", err)
+	}
+
+	events.tomb.Go(func() error {
+		for event := range published {
+			events.dispatch(*event)
+		}
+		return nil
+	})
+
+	return nil
+}
+
+func (events *fireEvents) Stop(_ context.Context) error {
+	events.tomb.Kill(nil)
+
+	return events.tomb.Wait()
+}
+
+func (events *fireEvents) Publish(ctx context.Context, event domain.FireEvent) {
+	if err := events.fireEventRepo.PublishFireEvent(ctx, &event); err != nil {
+		events.logger.Error("failed to PublishFireEvent", map[string]interface{}{
+			"user_id":     event.Reminder.UserID,
+			"reminder_id": event.Reminder.ID,
+			"error":       err.Error(),
+		})
+	}
+}
+
+func (events *fireEvents) dispatch(event domain.FireEvent) {
+	events.m.Lock()
+	defer events.m.Unlock()
+
+	for subscriber := range events.subscribers[event.Reminder.UserID] {
+		select {
+		case subscriber <- event:
+		default:
+			events.logger.Warn("Drop fire event for slow subscriber", map[string]interface{}{
+				"user_id":     event.Reminder.UserID,
+				"reminder_id": event.Reminder.ID,
+			})
+		}
+	}
+}
+
+func (events *fireEvents) Subscribe(ctx context.Context, userID int64) <-chan domain.FireEvent {
+	subscriber := make(chan domain.FireEvent, fireEventsBuffer)
+
+	events.m.Lock()
+	if events.subscribers[userID] == nil {
+		events.subscribers[userID] = make(map[chan domain.FireEvent]struct{})
+	}
+	events.subscribers[userID][subscriber] = struct{}{}
+	events.m.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		events.m.Lock()
+		defer events.m.Unlock()
+
+		delete(events.subscribers[userID], subscriber)
+		if len(events.subscribers[userID]) == 0 {
+			delete(events.subscribers, userID)
+		}
+		close(subscriber)
+	}()
+
+	return subscriber
+}
@@ -0,0 +1,70 @@
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/repository/redis"
+	"github.com/almostinf/glow-reminder/internal/scheduler"
+	logger_mocks "github.com/almostinf/glow-reminder/pkg/logger/mocks"
+	rediswrapper "github.com/almostinf/glow-reminder/pkg/redis"
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// TestFireEventsAcrossInstances checks that the events fired by the leader reach the subscribers of a standby instance.
+func TestFireEventsAcrossInstances(t *testing.T) {
+	t.Parallel()
+
+	server := miniredis.RunT(t)
+
+	log := logger_mocks.NewMockLogger(gomock.NewController(t))
+	log.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	newInstance := func() scheduler.FireEvents {
+		client := goredis.NewClient(&goredis.Options{Addr: server.Addr()})
+		t.Cleanup(func() { _ = client.Close() })
+
+		fireEvents := scheduler.NewFireEvents(redis.NewFireEventRepo(&rediswrapper.Redis{Client: client}, log), log)
+		require.NoError(t, fireEvents.Start(context.Background()))
+		t.Cleanup(func() { _ = fireEvents.Stop(context.Background()) })
+
+		return fireEvents
+	}
+
+	leader, standby := newInstance(), newInstance()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	userID := int64(42)
+	events := standby.Subscribe(ctx, userID)
+
+	event := domain.FireEvent{
+		Reminder: domain.Reminder{ID: uuid.New(), UserID: userID, Msg: "water the plants"},
+		FiredAt:  time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC),
+	}
+
+	leader.Publish(ctx, domain.FireEvent{Reminder: domain.Reminder{ID: uuid.New(), UserID: userID + 1}})
+	leader.Publish(ctx, event)
+
+	select {
+	case received := <-events:
+		assert.Equal(t, event.Reminder.ID, received.Reminder.ID)
+		assert.Equal(t, event.Reminder.Msg, received.Reminder.Msg)
+		assert.True(t, event.FiredAt.Equal(received.FiredAt))
+	case <-time.After(5 * time.Second):
+		t.Fatal("no fire event received")
+	}
+
+	cancel()
+
+	_, ok := <-events
+	assert.False(t, ok, "the channel is closed once the context is done")
+}
@@ -2,50 +2,99 @@ package scheduler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/almostinf/glow-reminder/internal/device"
 	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/leader"
+	"github.com/almostinf/glow-reminder/internal/notifier"
 	"github.com/almostinf/glow-reminder/internal/repository/pg"
 	"github.com/almostinf/glow-reminder/internal/repository/redis"
+	"github.com/almostinf/glow-reminder/pkg/backoff"
 	"github.com/almostinf/glow-reminder/pkg/clock"
-	"github.com/almostinf/glow-reminder/pkg/glow_reminder/client/operations"
-	"github.com/almostinf/glow-reminder/pkg/glow_reminder/models"
 	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/google/uuid"
 	"gopkg.in/tomb.v2"
 )
 
+const (
+	timeFormat           = "2006-01-02 15:04"
+	lampUnreachableMsg   = "⚠️ The lamp could not be reached after %d attempts, reminder %q at %s was not shown"
+	quietDeferredMsg     = "🌙 Quiet hours: reminder %q will glow at %s"
+	quietDimmedMsg       = "🌙 Quiet hours: reminder %q glowed dimmed"
+	quietSkippedMsg      = "🌙 Quiet hours: reminder %q was not lit"
+	invalidRecurrenceMsg = "⚠️ Reminder %q repeats by the rule %q that can no longer be read, " +
+		"so it has been removed. Please add it again with another repeat"
+)
+
 type ReminderScheduler interface {
 	Start(ctx context.Context) error
 	Stop(ctx context.Context) error
 }
 
 type reminderScheduler struct {
-	cfg                Config
-	reminderTaskRepo   redis.ReminderTaskRepo
-	reminderRepo       pg.ReminderRepo
-	logger             logger.Logger
-	tomb               tomb.Tomb
-	clock              clock.Clock
-	glowReminderClient operations.ClientService
+	cfg               Config
+	reminderTaskRepo  redis.ReminderTaskRepo
+	firedReminderRepo redis.FiredReminderRepo
+	reminderRepo      pg.ReminderRepo
+	userRepo          pg.UserRepo
+	outboxRepo        pg.OutboxRepo
+	deadLetterRepo    pg.DeadLetterRepo
+	deliveryRepo      pg.DeliveryRepo
+	trManager         trm.Manager
+	logger            logger.Logger
+	tomb              tomb.Tomb
+	clock             clock.Clock
+	deviceRegistry    device.Registry
+	notifier          notifier.Notifier
+	fireEvents        FireEvents
+	elector           leader.Elector
+	backoff           backoff.Backoff
 }
 
 func New(
 	cfg Config,
 	reminderTaskRepo redis.ReminderTaskRepo,
+	firedReminderRepo redis.FiredReminderRepo,
 	reminderRepo pg.ReminderRepo,
+	userRepo pg.UserRepo,
+	outboxRepo pg.OutboxRepo,
+	deadLetterRepo pg.DeadLetterRepo,
+	deliveryRepo pg.DeliveryRepo,
+	trManager trm.Manager,
 	logger logger.Logger,
 	clock clock.Clock,
-	glowReminderClient operations.ClientService,
+	deviceRegistry device.Registry,
+	notifier notifier.Notifier,
+	fireEvents FireEvents,
+	elector leader.Elector,
 ) *reminderScheduler {
 	return &reminderScheduler{
-		cfg:                cfg,
-		reminderTaskRepo:   reminderTaskRepo,
-		reminderRepo:       reminderRepo,
-		logger:             logger,
-		clock:              clock,
-		tomb:               tomb.Tomb{},
-		glowReminderClient: glowReminderClient,
+		cfg:               cfg,
+		reminderTaskRepo:  reminderTaskRepo,
+		firedReminderRepo: firedReminderRepo,
+		reminderRepo:      reminderRepo,
+		userRepo:          userRepo,
+		outboxRepo:        outboxRepo,
+		deadLetterRepo:    deadLetterRepo,
+		deliveryRepo:      deliveryRepo,
+		trManager:         trManager,
+		logger:            logger,
+		clock:             clock,
+		tomb:              tomb.Tomb{},
+		deviceRegistry:    deviceRegistry,
+		notifier:          notifier,
+		fireEvents:        fireEvents,
+		elector:           elector,
+		backoff: backoff.Backoff{
+			Initial:    cfg.RetryInitialBackoff,
+			Max:        cfg.RetryMaxBackoff,
+			Multiplier: cfg.RetryMultiplier,
+			Jitter:     cfg.RetryJitter,
+		},
 	}
 }
 
@@ -82,48 +131,590 @@ func (scheduler *reminderScheduler) Start(ctx context.Context) error {
 	return nil
 }
 
+// processReminderTasks fires the due reminders if the instance is the leader. The tasks are claimed with the fencing
+// token of the current term, so a former leader that has not noticed losing the lead yet does not fire them twice.
 func (scheduler *reminderScheduler) processReminderTasks(ctx context.Context) error {
+	fencingToken, ok := scheduler.elector.FencingToken()
+	if !ok {
+		return nil
+	}
+
 	scheduler.logger.Debug("Start process reminder tasks", map[string]interface{}{})
 
 	now := scheduler.clock.NowUnix()
 
-	reminderTasks, err := scheduler.reminderTaskRepo.GetReminderTasks(ctx, now)
+	reminderTasks, err := scheduler.reminderTaskRepo.ClaimReminderTasks(ctx, now, scheduler.cfg.LeaseDuration, fencingToken)
+	if errors.Is(err, redis.ErrStaleFencingToken) {
+		scheduler.logger.Warn("Skip reminder tasks of a former leader", map[string]interface{}{
+			"fencing_token": fencingToken,
+		})
+		return nil
+	}
 	if err != nil {
-		return fmt.Errorf("failed to GetReminderTasks: %w", err)
+		return fmt.Errorf("failed to ClaimReminderTasks: %w", err)
 	}
 
 	scheduler.logger.Info("Process reminder tasks", map[string]interface{}{
 		"reminder_tasks": reminderTasks,
 	})
 
-	reminders := make([]*domain.Reminder, 0, len(reminderTasks))
+	var errs []error
 	for _, reminderTask := range reminderTasks {
-		reminder, err := scheduler.reminderRepo.GetReminder(ctx, reminderTask.ID)
-		if err != nil {
-			return fmt.Errorf("failed to GetReminder %s: %w", reminderTask.ID, err)
+		if err = scheduler.processReminderTask(ctx, reminderTask); err != nil {
+			errs = append(errs, fmt.Errorf("failed to process reminder task %s: %w", reminderTask.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// processReminderTask fires the reminder of the task and settles the task. It is safe to process
+// the same task twice: a task of a deleted reminder is skipped and one of a rescheduled reminder waits for it.
+func (scheduler *reminderScheduler) processReminderTask(ctx context.Context, reminderTask *domain.ReminderTask) error {
+	reminder, err := scheduler.getTaskReminder(ctx, reminderTask)
+	if errors.Is(err, domain.ErrNotFound) {
+		scheduler.logger.Warn("Skip orphan reminder task", map[string]interface{}{
+			"reminder_id": reminderTask.ID,
+		})
+		scheduler.failQueuedDeliveries(ctx, reminderTask.ID, "reminder is deleted")
+		return scheduler.ackReminderTask(ctx, reminderTask)
+	}
+	if err != nil {
+		return scheduler.nackReminderTask(ctx, reminderTask, err)
+	}
+
+	// The tasks are claimed by their score in whole seconds, so the fraction of a second is not compared.
+	// A task claimed before its reminder is due, e.g. of a rescheduled reminder, is returned to wait for it.
+	if !reminderTask.Snoozed && reminder.ScheduledAt.Unix() > scheduler.clock.NowUnix() {
+		scheduler.logger.Warn("Requeue early reminder task", map[string]interface{}{
+			"reminder_id":  reminder.ID,
+			"scheduled_at": reminder.ScheduledAt,
+		})
+		scheduler.failQueuedDeliveries(ctx, reminder.ID, "reminder is rescheduled")
+		if err = scheduler.reminderTaskRepo.NackReminderTask(ctx, reminderTask, reminder.ScheduledAt); err != nil {
+			return fmt.Errorf("failed to NackReminderTask: %w", err)
+		}
+		return nil
+	}
+
+	if reminder.Delivery.HasLight() {
+		quietUntil, policy, quiet := scheduler.quietUntil(ctx, reminder.UserID)
+		switch {
+		case quiet && policy == domain.QuietDefer:
+			return scheduler.deferReminderTask(ctx, reminderTask, reminder, quietUntil)
+		case quiet && policy == domain.QuietSkip:
+			scheduler.failQueuedDeliveries(ctx, reminder.ID, "skipped during quiet hours")
+			scheduler.reportQuietDecision(ctx, reminder, policy, quietUntil)
+		case quiet && policy == domain.QuietDim:
+			if err = scheduler.glowReminder(ctx, reminder.Dimmed()); err != nil {
+				return scheduler.retryReminderTask(ctx, reminderTask, reminder, err)
+			}
+			scheduler.reportQuietDecision(ctx, reminder, policy, quietUntil)
+		default:
+			if err = scheduler.glowReminder(ctx, reminder); err != nil {
+				return scheduler.retryReminderTask(ctx, reminderTask, reminder, err)
+			}
+		}
+	}
+
+	if reminder.Delivery.HasMessage() {
+		if err = scheduler.notifyReminder(ctx, reminder); err != nil {
+			// The lamp has already been lit, so the reminder is not delivered again just for the message.
+			if !reminder.Delivery.HasLight() {
+				return scheduler.nackReminderTask(ctx, reminderTask, fmt.Errorf("failed to notifyReminder: %w", err))
+			}
+
+			scheduler.logger.Error("failed to send reminder message", map[string]interface{}{
+				"reminder_id": reminder.ID,
+				"error":       err.Error(),
+			})
 		}
-		reminders = append(reminders, reminder)
 	}
 
-	for _, reminder := range reminders {
-		_, err := scheduler.glowReminderClient.GlowReminder(&operations.GlowReminderParams{
-			Body: &models.GlowReminder{
-				Colour: int64(reminder.Colour),
-				Mode:   int64(reminder.Mode),
-			},
+	scheduler.fireEvents.Publish(ctx, domain.FireEvent{
+		Reminder: *reminder,
+		FiredAt:  scheduler.clock.NowUTC(),
+		Snoozed:  reminderTask.Snoozed,
+	})
+
+	return scheduler.completeReminderTask(ctx, reminderTask, reminder)
+}
+
+// quietUntil reports whether the lamps of the user must be kept quiet now and returns the quiet policy
+// of the user and when the quiet time ends. The lamps are lit as usual if the user cannot be loaded.
+func (scheduler *reminderScheduler) quietUntil(ctx context.Context, userID int64) (time.Time, domain.QuietPolicy, bool) {
+	user, err := scheduler.userRepo.GetUser(ctx, userID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return time.Time{}, "", false
+	}
+	if err != nil {
+		scheduler.logger.Error("failed to GetUser for quiet hours", map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
 		})
+		return time.Time{}, "", false
+	}
+
+	until, quiet := user.QuietUntil(scheduler.clock.NowUTC())
+
+	return until, user.QuietPolicy, quiet
+}
+
+// deferReminderTask returns the task to the queue until the quiet time of the owner ends.
+// The attempts are not counted, so the deferred reminder gets all of them once it fires.
+func (scheduler *reminderScheduler) deferReminderTask(
+	ctx context.Context,
+	reminderTask *domain.ReminderTask,
+	reminder *domain.Reminder,
+	until time.Time,
+) error {
+	if err := scheduler.reminderTaskRepo.NackReminderTask(ctx, reminderTask, until); err != nil {
+		return fmt.Errorf("failed to NackReminderTask: %w", err)
+	}
+
+	scheduler.reportQuietDecision(ctx, reminder, domain.QuietDefer, until)
+
+	return nil
+}
+
+// reportQuietDecision logs what has been done to the reminder fired during the quiet time and tells the owner.
+func (scheduler *reminderScheduler) reportQuietDecision(
+	ctx context.Context,
+	reminder *domain.Reminder,
+	policy domain.QuietPolicy,
+	until time.Time,
+) {
+	scheduler.logger.Info("Reminder fired during quiet hours", map[string]interface{}{
+		"reminder_id": reminder.ID,
+		"user_id":     reminder.UserID,
+		"policy":      policy,
+		"quiet_until": until,
+	})
+
+	var msg string
+	switch policy {
+	case domain.QuietDefer:
+		if location, err := scheduler.userLocation(ctx, reminder.UserID); err == nil {
+			until = until.In(location)
+		}
+		msg = fmt.Sprintf(quietDeferredMsg, reminder.Msg, until.Format(timeFormat))
+	case domain.QuietDim:
+		msg = fmt.Sprintf(quietDimmedMsg, reminder.Msg)
+	case domain.QuietSkip:
+		msg = fmt.Sprintf(quietSkippedMsg, reminder.Msg)
+	}
+
+	if err := scheduler.notifier.Notify(ctx, reminder.UserID, msg); err != nil {
+		scheduler.logger.Error("failed to notify reminder owner", map[string]interface{}{
+			"reminder_id": reminder.ID,
+			"user_id":     reminder.UserID,
+			"error":       err.Error(),
+		})
+	}
+}
+
+// glowReminder lights the lamps of the reminder and tracks the delivery to each of them.
+// If any of them fails, the reminder is retried on the lamps that have not been reached yet.
+func (scheduler *reminderScheduler) glowReminder(ctx context.Context, reminder *domain.Reminder) error {
+	devices, err := scheduler.deviceRegistry.ReminderDevices(ctx, reminder)
+	if err != nil {
+		return fmt.Errorf("failed to get ReminderDevices: %w", err)
+	}
+
+	deliveries, err := scheduler.queueDeliveries(ctx, reminder, devices)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, delivery := range deliveries {
+		delivery.UpdatedAt = scheduler.clock.NowUTC()
+
+		device := findDeliveryDevice(devices, delivery)
+		if device == nil {
+			delivery.Status = domain.DeliveryFailed
+			delivery.LastError = "lamp is removed"
+			scheduler.updateDelivery(ctx, delivery)
+			continue
+		}
+
+		delivery.Attempts++
+		if err = scheduler.deviceRegistry.Glow(device, reminder, delivery.ID); err != nil {
+			delivery.LastError = err.Error()
+			errs = append(errs, fmt.Errorf("device %q: %w", device.Name, err))
+		} else if device.AcknowledgesCommands() {
+			delivery.Status = domain.DeliveryAcknowledged
+		} else {
+			delivery.Status = domain.DeliverySent
+		}
+
+		scheduler.updateDelivery(ctx, delivery)
+	}
+
+	return errors.Join(errs...)
+}
+
+// queueDeliveries returns the deliveries left queued by the previous attempt to light the reminder
+// or queues a delivery to each of the lamps on the first attempt.
+func (scheduler *reminderScheduler) queueDeliveries(
+	ctx context.Context,
+	reminder *domain.Reminder,
+	devices []*domain.Device,
+) ([]*domain.LampDelivery, error) {
+	deliveries, err := scheduler.deliveryRepo.GetQueuedDeliveries(ctx, reminder.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to GetQueuedDeliveries: %w", err)
+	}
+
+	if len(deliveries) != 0 {
+		return deliveries, nil
+	}
+
+	now := scheduler.clock.NowUTC()
+
+	newDeliveries := make([]domain.LampDelivery, 0, len(devices))
+	for _, device := range devices {
+		newDeliveries = append(newDeliveries, domain.LampDelivery{
+			ID:         uuid.New(),
+			ReminderID: reminder.ID,
+			UserID:     reminder.UserID,
+			DeviceID:   uuid.NullUUID{UUID: device.ID, Valid: device.ID != uuid.Nil},
+			DeviceName: device.Name,
+			FiredAt:    now,
+			Status:     domain.DeliveryQueued,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		})
+	}
+
+	if err = scheduler.deliveryRepo.CreateDeliveries(ctx, newDeliveries); err != nil {
+		return nil, fmt.Errorf("failed to CreateDeliveries: %w", err)
+	}
+
+	for i := range newDeliveries {
+		deliveries = append(deliveries, &newDeliveries[i])
+	}
+
+	return deliveries, nil
+}
+
+// updateDelivery only logs a failure, since the lamp must not be lit again because of the delivery status.
+func (scheduler *reminderScheduler) updateDelivery(ctx context.Context, delivery *domain.LampDelivery) {
+	if err := scheduler.deliveryRepo.UpdateDelivery(ctx, *delivery); err != nil {
+		scheduler.logger.Error("failed to UpdateDelivery", map[string]interface{}{
+			"delivery_id": delivery.ID,
+			"status":      delivery.Status,
+			"error":       err.Error(),
+		})
+	}
+}
+
+func (scheduler *reminderScheduler) failQueuedDeliveries(ctx context.Context, reminderID uuid.UUID, reason string) {
+	if err := scheduler.deliveryRepo.FailQueuedDeliveries(ctx, reminderID, reason, scheduler.clock.NowUTC()); err != nil {
+		scheduler.logger.Error("failed to FailQueuedDeliveries", map[string]interface{}{
+			"reminder_id": reminderID,
+			"error":       err.Error(),
+		})
+	}
+}
+
+func findDeliveryDevice(devices []*domain.Device, delivery *domain.LampDelivery) *domain.Device {
+	for _, device := range devices {
+		if delivery.IsFor(device) {
+			return device
+		}
+	}
+
+	return nil
+}
+
+// getTaskReminder returns the reminder of a regular task or the saved copy of the fired reminder of a snoozed one.
+func (scheduler *reminderScheduler) getTaskReminder(
+	ctx context.Context,
+	reminderTask *domain.ReminderTask,
+) (*domain.Reminder, error) {
+	if reminderTask.Snoozed {
+		reminder, err := scheduler.firedReminderRepo.GetFiredReminder(ctx, reminderTask.ID)
 		if err != nil {
-			return fmt.Errorf("failed to GlowReminder %s: %w", reminder.ID, err)
+			return nil, fmt.Errorf("failed to GetFiredReminder: %w", err)
+		}
+		return reminder, nil
+	}
+
+	reminder, err := scheduler.reminderRepo.GetReminder(ctx, reminderTask.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to GetReminder: %w", err)
+	}
+
+	return reminder, nil
+}
+
+// notifyReminder saves a copy of the fired reminder for its Done and Snooze buttons and sends it to the owner.
+func (scheduler *reminderScheduler) notifyReminder(ctx context.Context, reminder *domain.Reminder) error {
+	if err := scheduler.firedReminderRepo.SaveFiredReminder(ctx, reminder, scheduler.cfg.SnoozeWindow); err != nil {
+		return fmt.Errorf("failed to SaveFiredReminder: %w", err)
+	}
+
+	if err := scheduler.notifier.NotifyReminder(ctx, reminder); err != nil {
+		return fmt.Errorf("failed to NotifyReminder: %w", err)
+	}
+
+	return nil
+}
+
+// completeReminderTask moves the reminder on to its next occurrence and acknowledges the task.
+// A snoozed task leaves the reminder as is, since it has already been rescheduled when it fired.
+func (scheduler *reminderScheduler) completeReminderTask(
+	ctx context.Context,
+	reminderTask *domain.ReminderTask,
+	reminder *domain.Reminder,
+) error {
+	if !reminderTask.Snoozed {
+		if err := scheduler.rescheduleReminder(ctx, reminder); err != nil {
+			return scheduler.nackReminderTask(ctx, reminderTask, fmt.Errorf("failed to rescheduleReminder: %w", err))
 		}
+	}
+
+	return scheduler.ackReminderTask(ctx, reminderTask)
+}
+
+func (scheduler *reminderScheduler) ackReminderTask(ctx context.Context, reminderTask *domain.ReminderTask) error {
+	if err := scheduler.reminderTaskRepo.AckReminderTask(ctx, reminderTask); err != nil {
+		return fmt.Errorf("failed to AckReminderTask: %w", err)
+	}
+
+	return nil
+}
+
+// nackReminderTask returns the task to the queue to be processed in the next cycle.
+func (scheduler *reminderScheduler) nackReminderTask(
+	ctx context.Context,
+	reminderTask *domain.ReminderTask,
+	cause error,
+) error {
+	retryAt := scheduler.clock.NowUTC().Add(scheduler.cfg.CycleDuration)
+	if err := scheduler.reminderTaskRepo.NackReminderTask(ctx, reminderTask, retryAt); err != nil {
+		return errors.Join(cause, fmt.Errorf("failed to NackReminderTask: %w", err))
+	}
+
+	return cause
+}
+
+// retryReminderTask schedules another delivery attempt with exponential backoff
+// or dead-letters the reminder occurrence once the attempts are exhausted.
+func (scheduler *reminderScheduler) retryReminderTask(
+	ctx context.Context,
+	reminderTask *domain.ReminderTask,
+	reminder *domain.Reminder,
+	cause error,
+) error {
+	attempts, err := scheduler.reminderTaskRepo.IncrReminderTaskAttempts(ctx, reminderTask)
+	if err != nil {
+		return scheduler.nackReminderTask(ctx, reminderTask,
+			errors.Join(cause, fmt.Errorf("failed to IncrReminderTaskAttempts: %w", err)))
+	}
+
+	if attempts >= scheduler.cfg.RetryMaxAttempts {
+		return scheduler.deadLetterReminder(ctx, reminderTask, reminder, attempts, cause)
+	}
+
+	retryAt := scheduler.clock.NowUTC().Add(scheduler.backoff.Delay(int(attempts)))
+
+	scheduler.logger.Warn("Retry reminder delivery", map[string]interface{}{
+		"reminder_id": reminder.ID,
+		"attempts":    attempts,
+		"retry_at":    retryAt,
+		"error":       cause.Error(),
+	})
+
+	if err = scheduler.reminderTaskRepo.NackReminderTask(ctx, reminderTask, retryAt); err != nil {
+		return errors.Join(cause, fmt.Errorf("failed to NackReminderTask: %w", err))
+	}
+
+	return nil
+}
+
+// deadLetterReminder stores the undelivered occurrence, tells the owner about it
+// and moves the reminder on as if the occurrence had fired.
+func (scheduler *reminderScheduler) deadLetterReminder(
+	ctx context.Context,
+	reminderTask *domain.ReminderTask,
+	reminder *domain.Reminder,
+	attempts int64,
+	cause error,
+) error {
+	if err := scheduler.deadLetterRepo.CreateDeadLetter(ctx, scheduler.newDeadLetter(reminder, attempts, cause)); err != nil {
+		return scheduler.nackReminderTask(ctx, reminderTask,
+			errors.Join(cause, fmt.Errorf("failed to CreateDeadLetter: %w", err)))
+	}
+
+	scheduler.logger.Error("Reminder delivery exhausted retries", map[string]interface{}{
+		"reminder_id": reminder.ID,
+		"attempts":    attempts,
+		"error":       cause.Error(),
+	})
+
+	scheduler.failQueuedDeliveries(ctx, reminder.ID, cause.Error())
+
+	scheduler.notifyUnreachableLamp(ctx, reminder, attempts)
+
+	return scheduler.completeReminderTask(ctx, reminderTask, reminder)
+}
+
+func (scheduler *reminderScheduler) newDeadLetter(reminder *domain.Reminder, attempts int64, cause error) domain.DeadLetter {
+	return domain.DeadLetter{
+		ReminderID:  reminder.ID,
+		UserID:      reminder.UserID,
+		Msg:         reminder.Msg,
+		Colour:      reminder.Colour,
+		Mode:        reminder.Mode,
+		Recurrence:  reminder.Recurrence,
+		ScheduledAt: reminder.ScheduledAt,
+		Attempts:    attempts,
+		LastError:   cause.Error(),
+		CreatedAt:   scheduler.clock.NowUTC(),
+	}
+}
 
+func (scheduler *reminderScheduler) notifyUnreachableLamp(ctx context.Context, reminder *domain.Reminder, attempts int64) {
+	scheduledAt := reminder.ScheduledAt
+	if location, err := scheduler.userLocation(ctx, reminder.UserID); err == nil {
+		scheduledAt = scheduledAt.In(location)
+	}
+
+	msg := fmt.Sprintf(lampUnreachableMsg, attempts, reminder.Msg, scheduledAt.Format(timeFormat))
+	if err := scheduler.notifier.Notify(ctx, reminder.UserID, msg); err != nil {
+		scheduler.logger.Error("failed to notify reminder owner", map[string]interface{}{
+			"reminder_id": reminder.ID,
+			"user_id":     reminder.UserID,
+			"error":       err.Error(),
+		})
+	}
+}
+
+// rescheduleReminder enqueues the next occurrence of a recurring reminder
+// or deletes the reminder if it has no occurrences left. A failure to load the owner time zone
+// is returned, so the task is retried instead of the reminder being deleted.
+func (scheduler *reminderScheduler) rescheduleReminder(ctx context.Context, reminder *domain.Reminder) error {
+	next, ok, err := scheduler.nextOccurrence(ctx, reminder)
+	if errors.Is(err, domain.ErrInvalidRecurrence) || errors.Is(err, domain.ErrUnsupportedRecurrence) {
+		return scheduler.deadLetterRecurrence(ctx, reminder, err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to compute next occurrence: %w", err)
+	}
+
+	if !ok {
 		if err = scheduler.reminderRepo.DeleteReminder(ctx, reminder.ID); err != nil {
 			return fmt.Errorf("failed to DeleteReminder %v: %w", reminder.ID, err)
 		}
+		return nil
+	}
+
+	updated := *reminder
+	updated.ScheduledAt = next
+	updated.UpdatedAt = scheduler.clock.NowUTC()
+
+	// The next occurrence gets to the reminder tasks queue through the outbox relay.
+	return scheduler.trManager.Do(ctx, func(ctx context.Context) error {
+		if err := scheduler.reminderRepo.UpdateReminder(ctx, updated); err != nil {
+			return fmt.Errorf("failed to UpdateReminder %v: %w", reminder.ID, err)
+		}
+
+		if err := scheduler.outboxRepo.CreateEvent(ctx, domain.OutboxEvent{
+			ReminderID:  reminder.ID,
+			Operation:   domain.UpsertReminderTask,
+			ScheduledAt: next,
+			CreatedAt:   updated.UpdatedAt,
+		}); err != nil {
+			return fmt.Errorf("failed to CreateEvent %v: %w", reminder.ID, err)
+		}
+
+		return nil
+	})
+}
+
+// deadLetterRecurrence gives up a recurring reminder whose rule cannot be parsed: the reminder is moved
+// to the dead letters with its rule and the owner is told to add it again.
+func (scheduler *reminderScheduler) deadLetterRecurrence(
+	ctx context.Context,
+	reminder *domain.Reminder,
+	cause error,
+) error {
+	scheduler.logger.Error("Dead-letter reminder with invalid recurrence", map[string]interface{}{
+		"reminder_id": reminder.ID,
+		"recurrence":  reminder.Recurrence,
+		"error":       cause.Error(),
+	})
+
+	if err := scheduler.trManager.Do(ctx, func(ctx context.Context) error {
+		if err := scheduler.deadLetterRepo.CreateDeadLetter(ctx, scheduler.newDeadLetter(reminder, 0, cause)); err != nil {
+			return fmt.Errorf("failed to CreateDeadLetter: %w", err)
+		}
+
+		if err := scheduler.reminderRepo.DeleteReminder(ctx, reminder.ID); err != nil {
+			return fmt.Errorf("failed to DeleteReminder %v: %w", reminder.ID, err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	msg := fmt.Sprintf(invalidRecurrenceMsg, reminder.Msg, reminder.Recurrence)
+	if err := scheduler.notifier.Notify(ctx, reminder.UserID, msg); err != nil {
+		scheduler.logger.Error("failed to notify reminder owner", map[string]interface{}{
+			"reminder_id": reminder.ID,
+			"user_id":     reminder.UserID,
+			"error":       err.Error(),
+		})
 	}
 
 	return nil
 }
 
+// nextOccurrence evaluates the reminder recurrence in the owner time zone,
+// so that daily and weekly rules keep their wall clock time.
+func (scheduler *reminderScheduler) nextOccurrence(ctx context.Context, reminder *domain.Reminder) (time.Time, bool, error) {
+	if !reminder.Recurrence.IsRecurring() {
+		return time.Time{}, false, nil
+	}
+
+	rule, err := reminder.Recurrence.Parse()
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to parse recurrence: %w", err)
+	}
+
+	location, err := scheduler.userLocation(ctx, reminder.UserID)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	next, ok := rule.Next(reminder.ScheduledAt.In(location), scheduler.clock.NowUTC())
+
+	return next.UTC(), ok, nil
+}
+
+func (scheduler *reminderScheduler) userLocation(ctx context.Context, userID int64) (*time.Location, error) {
+	user, err := scheduler.userRepo.GetUser(ctx, userID)
+	if errors.Is(err, domain.ErrNotFound) {
+		user = &domain.User{
+			ID:          userID,
+			TimeZone:    domain.DefaultTimeZone,
+			QuietPolicy: domain.DefaultQuietPolicy,
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to GetUser %d: %w", userID, err)
+	}
+
+	location, err := user.Location()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load user location: %w", err)
+	}
+
+	return location, nil
+}
+
 func (scheduler *reminderScheduler) Stop(_ context.Context) error {
 	scheduler.logger.Debug("Stop reminder scheduler", map[string]interface{}{})
 
@@ -0,0 +1,659 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	device_mocks "github.com/almostinf/glow-reminder/internal/device/mocks"
+	"github.com/almostinf/glow-reminder/internal/domain"
+	notifier_mocks "github.com/almostinf/glow-reminder/internal/notifier/mocks"
+	pg_mocks "github.com/almostinf/glow-reminder/internal/repository/pg/mocks"
+	redis_mocks "github.com/almostinf/glow-reminder/internal/repository/redis/mocks"
+	"github.com/almostinf/glow-reminder/internal/scheduler"
+	clock_mocks "github.com/almostinf/glow-reminder/pkg/clock/mocks"
+	logger_mocks "github.com/almostinf/glow-reminder/pkg/logger/mocks"
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	userID   = int64(42)
+	timeZone = "Europe/Moscow"
+)
+
+var (
+	now = time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	errLamp  = errors.New("lamp is unreachable")
+	errStore = errors.New("store is unavailable")
+
+	cfg = scheduler.Config{
+		CycleDuration:       5 * time.Second,
+		LeaseDuration:       time.Minute,
+		RetryMaxAttempts:    3,
+		RetryInitialBackoff: 10 * time.Second,
+		RetryMaxBackoff:     time.Minute,
+		RetryMultiplier:     2,
+		SnoozeWindow:        time.Hour,
+	}
+)
+
+type schedulerMocks struct {
+	reminderTaskRepo  *redis_mocks.MockReminderTaskRepo
+	firedReminderRepo *redis_mocks.MockFiredReminderRepo
+	reminderRepo      *pg_mocks.MockReminderRepo
+	userRepo          *pg_mocks.MockUserRepo
+	outboxRepo        *pg_mocks.MockOutboxRepo
+	deadLetterRepo    *pg_mocks.MockDeadLetterRepo
+	deliveryRepo      *pg_mocks.MockDeliveryRepo
+	deviceRegistry    *device_mocks.MockRegistry
+	notifier          *notifier_mocks.MockNotifier
+}
+
+// trManager runs the transactions of the scheduler without a database.
+type trManager struct{}
+
+func (trManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (trManager) DoWithSettings(ctx context.Context, _ trm.Settings, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// schedulerHelper creates the scheduler with the mocked dependencies at the given time.
+func schedulerHelper(t *testing.T, at time.Time, mock func(schedulerMocks)) *scheduler.Scheduler {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	mocks := schedulerMocks{
+		reminderTaskRepo:  redis_mocks.NewMockReminderTaskRepo(mockCtrl),
+		firedReminderRepo: redis_mocks.NewMockFiredReminderRepo(mockCtrl),
+		reminderRepo:      pg_mocks.NewMockReminderRepo(mockCtrl),
+		userRepo:          pg_mocks.NewMockUserRepo(mockCtrl),
+		outboxRepo:        pg_mocks.NewMockOutboxRepo(mockCtrl),
+		deadLetterRepo:    pg_mocks.NewMockDeadLetterRepo(mockCtrl),
+		deliveryRepo:      pg_mocks.NewMockDeliveryRepo(mockCtrl),
+		deviceRegistry:    device_mocks.NewMockRegistry(mockCtrl),
+		notifier:          notifier_mocks.NewMockNotifier(mockCtrl),
+	}
+	mock(mocks)
+
+	log := logger_mocks.NewMockLogger(mockCtrl)
+	log.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	log.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	log.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	log.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	clk := clock_mocks.NewMockClock(mockCtrl)
+	clk.EXPECT().NowUTC().Return(at).AnyTimes()
+	clk.EXPECT().NowUnix().Return(at.Unix()).AnyTimes()
+
+	fireEventRepo := redis_mocks.NewMockFireEventRepo(mockCtrl)
+	fireEventRepo.EXPECT().PublishFireEvent(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	return scheduler.New(
+		cfg,
+		mocks.reminderTaskRepo,
+		mocks.firedReminderRepo,
+		mocks.reminderRepo,
+		mocks.userRepo,
+		mocks.outboxRepo,
+		mocks.deadLetterRepo,
+		mocks.deliveryRepo,
+		trManager{},
+		log,
+		clk,
+		mocks.deviceRegistry,
+		mocks.notifier,
+		scheduler.NewFireEvents(fireEventRepo, log),
+		nil,
+	)
+}
+
+func newReminder(recurrence domain.Recurrence) *domain.Reminder {
+	return &domain.Reminder{
+		ID:          uuid.MustParse("3d1f6a52-8c1e-4f4a-9b7e-2a6c5d8e9f01"),
+		UserID:      userID,
+		Msg:         "Water the plants",
+		Colour:      domain.Green,
+		Mode:        domain.Blinking,
+		RGB:         domain.RGB(0x00FF00),
+		Brightness:  domain.MaxBrightness,
+		Effect:      domain.DefaultEffect(domain.BlinkingEffect),
+		Delivery:    domain.LightOnly,
+		ScheduledAt: now.Add(-time.Minute),
+		Recurrence:  recurrence,
+	}
+}
+
+// expectGlow expects the reminder to be loaded and lit on the lamp with the result of glowErr.
+func expectGlow(m schedulerMocks, reminder *domain.Reminder, device *domain.Device, glowErr error) {
+	m.reminderRepo.EXPECT().GetReminder(gomock.Any(), reminder.ID).Return(reminder, nil)
+	m.deviceRegistry.EXPECT().ReminderDevices(gomock.Any(), gomock.Any()).Return([]*domain.Device{device}, nil)
+	m.deliveryRepo.EXPECT().GetQueuedDeliveries(gomock.Any(), reminder.ID).Return(nil, nil)
+	m.deliveryRepo.EXPECT().CreateDeliveries(gomock.Any(), gomock.Any()).Return(nil)
+	m.deviceRegistry.EXPECT().Glow(device, gomock.Any(), gomock.Any()).Return(glowErr)
+	m.deliveryRepo.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any()).Return(nil)
+}
+
+func TestProcessReminderTaskRetry(t *testing.T) {
+	t.Parallel()
+
+	lamp := &domain.Device{ID: uuid.New(), UserID: userID, Name: "kitchen", Protocol: domain.HTTPProtocol}
+	lastError := `device "kitchen": lamp is unreachable`
+	user := &domain.User{ID: userID, TimeZone: timeZone}
+
+	deadLetter := func(reminder *domain.Reminder, attempts int64, lastError string) domain.DeadLetter {
+		return domain.DeadLetter{
+			ReminderID:  reminder.ID,
+			UserID:      reminder.UserID,
+			Msg:         reminder.Msg,
+			Colour:      reminder.Colour,
+			Mode:        reminder.Mode,
+			Recurrence:  reminder.Recurrence,
+			ScheduledAt: reminder.ScheduledAt,
+			Attempts:    attempts,
+			LastError:   lastError,
+			CreatedAt:   now,
+		}
+	}
+
+	testcases := []struct {
+		name        string
+		recurrence  domain.Recurrence
+		glowErr     error
+		userErr     error
+		mock        func(m schedulerMocks, reminder *domain.Reminder, task *domain.ReminderTask)
+		expectedErr error
+	}{
+		{
+			name:    "retries the first failure after the initial backoff",
+			glowErr: errLamp,
+			mock: func(m schedulerMocks, _ *domain.Reminder, task *domain.ReminderTask) {
+				m.reminderTaskRepo.EXPECT().IncrReminderTaskAttempts(gomock.Any(), task).Return(int64(1), nil)
+				m.reminderTaskRepo.EXPECT().NackReminderTask(gomock.Any(), task, now.Add(10*time.Second)).Return(nil)
+			},
+		},
+		{
+			name:    "grows the backoff with the attempts",
+			glowErr: errLamp,
+			mock: func(m schedulerMocks, _ *domain.Reminder, task *domain.ReminderTask) {
+				m.reminderTaskRepo.EXPECT().IncrReminderTaskAttempts(gomock.Any(), task).Return(int64(2), nil)
+				m.reminderTaskRepo.EXPECT().NackReminderTask(gomock.Any(), task, now.Add(20*time.Second)).Return(nil)
+			},
+		},
+		{
+			name:    "nacks the task if the attempts cannot be counted",
+			glowErr: errLamp,
+			mock: func(m schedulerMocks, _ *domain.Reminder, task *domain.ReminderTask) {
+				m.reminderTaskRepo.EXPECT().IncrReminderTaskAttempts(gomock.Any(), task).Return(int64(0), errStore)
+				m.reminderTaskRepo.EXPECT().NackReminderTask(gomock.Any(), task, now.Add(cfg.CycleDuration)).Return(nil)
+			},
+			expectedErr: errStore,
+		},
+		{
+			name:    "dead-letters a one-off reminder and tells the owner once the attempts are exhausted",
+			glowErr: errLamp,
+			mock: func(m schedulerMocks, reminder *domain.Reminder, task *domain.ReminderTask) {
+				m.reminderTaskRepo.EXPECT().IncrReminderTaskAttempts(gomock.Any(), task).Return(int64(3), nil)
+				m.deadLetterRepo.EXPECT().CreateDeadLetter(gomock.Any(), deadLetter(reminder, 3, lastError)).Return(nil)
+				m.deliveryRepo.EXPECT().FailQueuedDeliveries(gomock.Any(), reminder.ID, lastError, now).Return(nil)
+				m.notifier.EXPECT().Notify(gomock.Any(), userID,
+					`⚠️ The lamp could not be reached after 3 attempts, reminder "Water the plants" at 2025-05-10 14:59 was not shown`,
+				).Return(nil)
+				m.reminderRepo.EXPECT().DeleteReminder(gomock.Any(), reminder.ID).Return(nil)
+				m.reminderTaskRepo.EXPECT().AckReminderTask(gomock.Any(), task).Return(nil)
+			},
+		},
+		{
+			name:       "moves a dead-lettered recurring reminder on to its next occurrence",
+			recurrence: "FREQ=DAILY",
+			glowErr:    errLamp,
+			mock: func(m schedulerMocks, reminder *domain.Reminder, task *domain.ReminderTask) {
+				next := reminder.ScheduledAt.AddDate(0, 0, 1)
+
+				updated := *reminder
+				updated.ScheduledAt = next
+				updated.UpdatedAt = now
+
+				m.reminderTaskRepo.EXPECT().IncrReminderTaskAttempts(gomock.Any(), task).Return(int64(3), nil)
+				m.deadLetterRepo.EXPECT().CreateDeadLetter(gomock.Any(), deadLetter(reminder, 3, lastError)).Return(nil)
+				m.deliveryRepo.EXPECT().FailQueuedDeliveries(gomock.Any(), reminder.ID, lastError, now).Return(nil)
+				m.notifier.EXPECT().Notify(gomock.Any(), userID, gomock.Any()).Return(nil)
+				m.reminderRepo.EXPECT().UpdateReminder(gomock.Any(), updated).Return(nil)
+				m.outboxRepo.EXPECT().CreateEvent(gomock.Any(), domain.OutboxEvent{
+					ReminderID:  reminder.ID,
+					Operation:   domain.UpsertReminderTask,
+					ScheduledAt: next,
+					CreatedAt:   now,
+				}).Return(nil)
+				m.reminderTaskRepo.EXPECT().AckReminderTask(gomock.Any(), task).Return(nil)
+			},
+		},
+		{
+			name:    "keeps retrying if the dead letter cannot be saved",
+			glowErr: errLamp,
+			mock: func(m schedulerMocks, reminder *domain.Reminder, task *domain.ReminderTask) {
+				m.reminderTaskRepo.EXPECT().IncrReminderTaskAttempts(gomock.Any(), task).Return(int64(3), nil)
+				m.deadLetterRepo.EXPECT().CreateDeadLetter(gomock.Any(), deadLetter(reminder, 3, lastError)).Return(errStore)
+				m.reminderTaskRepo.EXPECT().NackReminderTask(gomock.Any(), task, now.Add(cfg.CycleDuration)).Return(nil)
+			},
+			expectedErr: errStore,
+		},
+		{
+			name:       "retries a recurring reminder if the time zone of the owner cannot be loaded",
+			recurrence: "FREQ=DAILY",
+			userErr:    errStore,
+			mock: func(m schedulerMocks, _ *domain.Reminder, task *domain.ReminderTask) {
+				m.reminderTaskRepo.EXPECT().NackReminderTask(gomock.Any(), task, now.Add(cfg.CycleDuration)).Return(nil)
+			},
+			expectedErr: errStore,
+		},
+		{
+			name:       "dead-letters a recurring reminder with a rule that cannot be parsed",
+			recurrence: "FREQ=YEARLY",
+			mock: func(m schedulerMocks, reminder *domain.Reminder, task *domain.ReminderTask) {
+				m.deadLetterRepo.EXPECT().CreateDeadLetter(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, deadLetter domain.DeadLetter) error {
+						assert.Equal(t, reminder.Recurrence, deadLetter.Recurrence)
+						assert.Contains(t, deadLetter.LastError, "YEARLY")
+						return nil
+					})
+				m.reminderRepo.EXPECT().DeleteReminder(gomock.Any(), reminder.ID).Return(nil)
+				m.notifier.EXPECT().Notify(gomock.Any(), userID,
+					`⚠️ Reminder "Water the plants" repeats by the rule "FREQ=YEARLY" that can no longer be read, `+
+						"so it has been removed. Please add it again with another repeat",
+				).Return(nil)
+				m.reminderTaskRepo.EXPECT().AckReminderTask(gomock.Any(), task).Return(nil)
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			reminder := newReminder(testcase.recurrence)
+			task := &domain.ReminderTask{ID: reminder.ID}
+
+			s := schedulerHelper(t, now, func(m schedulerMocks) {
+				if testcase.userErr != nil {
+					m.userRepo.EXPECT().GetUser(gomock.Any(), userID).Return(nil, testcase.userErr).AnyTimes()
+				} else {
+					m.userRepo.EXPECT().GetUser(gomock.Any(), userID).Return(user, nil).AnyTimes()
+				}
+
+				expectGlow(m, reminder, lamp, testcase.glowErr)
+				testcase.mock(m, reminder, task)
+			})
+
+			err := s.ProcessReminderTask(context.Background(), task)
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestProcessReminderTaskQuietHours(t *testing.T) {
+	t.Parallel()
+
+	moscow, err := time.LoadLocation(timeZone)
+	require.NoError(t, err)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	at := func(location *time.Location, day, hour, minute int) time.Time {
+		return time.Date(2025, 5, day, hour, minute, 0, 0, location).UTC()
+	}
+	dndUntil := func(t time.Time) *time.Time {
+		return &t
+	}
+
+	lamp := &domain.Device{ID: uuid.New(), UserID: userID, Name: "bedroom", Protocol: domain.HTTPProtocol}
+
+	expectDeferred := func(until time.Time, shownAt string) func(m schedulerMocks, task *domain.ReminderTask) {
+		return func(m schedulerMocks, task *domain.ReminderTask) {
+			m.reminderTaskRepo.EXPECT().NackReminderTask(gomock.Any(), task, until).Return(nil)
+			m.notifier.EXPECT().Notify(gomock.Any(), userID,
+				`🌙 Quiet hours: reminder "Water the plants" will glow at `+shownAt,
+			).Return(nil)
+		}
+	}
+
+	testcases := []struct {
+		name         string
+		now          time.Time
+		user         domain.User
+		expectedGlow func(t *testing.T, glowed *domain.Reminder)
+		mock         func(m schedulerMocks, task *domain.ReminderTask)
+	}{
+		{
+			name: "defers a reminder fired before midnight to the end of the quiet hours next morning",
+			now:  at(moscow, 10, 23, 30),
+			user: domain.User{TimeZone: timeZone, QuietHours: "22:00-07:00", QuietPolicy: domain.QuietDefer},
+			mock: expectDeferred(at(moscow, 11, 7, 0), "2025-05-11 07:00"),
+		},
+		{
+			name: "defers a reminder fired after midnight to the end of the quiet hours the same morning",
+			now:  at(moscow, 11, 2, 0),
+			user: domain.User{TimeZone: timeZone, QuietHours: "22:00-07:00", QuietPolicy: domain.QuietDefer},
+			mock: expectDeferred(at(moscow, 11, 7, 0), "2025-05-11 07:00"),
+		},
+		{
+			name: "keeps the quiet hours by the clock of the user rather than UTC",
+			now:  time.Date(2025, 5, 11, 8, 0, 0, 0, time.UTC),
+			user: domain.User{TimeZone: "America/New_York", QuietHours: "22:00-07:00", QuietPolicy: domain.QuietDefer},
+			mock: expectDeferred(at(newYork, 11, 7, 0), "2025-05-11 07:00"),
+		},
+		{
+			name: "lights a reminder after the quiet hours are over",
+			now:  at(moscow, 11, 7, 30),
+			user: domain.User{TimeZone: timeZone, QuietHours: "22:00-07:00", QuietPolicy: domain.QuietDefer},
+			expectedGlow: func(t *testing.T, glowed *domain.Reminder) {
+				assert.Equal(t, domain.Blinking, glowed.Mode)
+				assert.Equal(t, domain.MaxBrightness, glowed.Brightness)
+			},
+		},
+		{
+			name: "lights a dim static glow under the dim policy",
+			now:  at(moscow, 10, 23, 30),
+			user: domain.User{TimeZone: timeZone, QuietHours: "22:00-07:00", QuietPolicy: domain.QuietDim},
+			expectedGlow: func(t *testing.T, glowed *domain.Reminder) {
+				assert.Equal(t, domain.Static, glowed.Mode)
+				assert.Equal(t, domain.DefaultEffect(domain.StaticEffect), glowed.Effect)
+				assert.Equal(t, domain.QuietBrightness, glowed.Brightness)
+			},
+			mock: func(m schedulerMocks, _ *domain.ReminderTask) {
+				m.notifier.EXPECT().Notify(gomock.Any(), userID,
+					`🌙 Quiet hours: reminder "Water the plants" glowed dimmed`,
+				).Return(nil)
+			},
+		},
+		{
+			name: "does not light the lamp under the skip policy",
+			now:  at(moscow, 11, 2, 0),
+			user: domain.User{TimeZone: timeZone, QuietHours: "22:00-07:00", QuietPolicy: domain.QuietSkip},
+			mock: func(m schedulerMocks, _ *domain.ReminderTask) {
+				m.deliveryRepo.EXPECT().FailQueuedDeliveries(gomock.Any(), gomock.Any(),
+					"skipped during quiet hours", at(moscow, 11, 2, 0),
+				).Return(nil)
+				m.notifier.EXPECT().Notify(gomock.Any(), userID,
+					`🌙 Quiet hours: reminder "Water the plants" was not lit`,
+				).Return(nil)
+			},
+		},
+		{
+			name: "defers a reminder until do not disturb is turned off",
+			now:  at(moscow, 10, 13, 0),
+			user: domain.User{TimeZone: timeZone, QuietPolicy: domain.QuietDefer, DNDUntil: dndUntil(at(moscow, 10, 15, 0))},
+			mock: expectDeferred(at(moscow, 10, 15, 0), "2025-05-10 15:00"),
+		},
+		{
+			name: "lights a reminder once do not disturb is over",
+			now:  at(moscow, 10, 13, 0),
+			user: domain.User{TimeZone: timeZone, QuietPolicy: domain.QuietDefer, DNDUntil: dndUntil(at(moscow, 10, 12, 0))},
+			expectedGlow: func(t *testing.T, glowed *domain.Reminder) {
+				assert.Equal(t, domain.Blinking, glowed.Mode)
+			},
+		},
+		{
+			name: "defers a reminder to the end of the quiet hours do not disturb runs into",
+			now:  at(moscow, 10, 21, 0),
+			user: domain.User{
+				TimeZone:    timeZone,
+				QuietHours:  "22:00-07:00",
+				QuietPolicy: domain.QuietDefer,
+				DNDUntil:    dndUntil(at(moscow, 10, 23, 0)),
+			},
+			mock: expectDeferred(at(moscow, 11, 7, 0), "2025-05-11 07:00"),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			reminder := newReminder("")
+			reminder.ScheduledAt = testcase.now.Add(-time.Minute)
+			task := &domain.ReminderTask{ID: reminder.ID}
+
+			user := testcase.user
+			user.ID = userID
+
+			s := schedulerHelper(t, testcase.now, func(m schedulerMocks) {
+				m.reminderRepo.EXPECT().GetReminder(gomock.Any(), reminder.ID).Return(reminder, nil)
+				m.userRepo.EXPECT().GetUser(gomock.Any(), userID).Return(&user, nil).AnyTimes()
+
+				if testcase.expectedGlow != nil {
+					m.deviceRegistry.EXPECT().ReminderDevices(gomock.Any(), gomock.Any()).Return([]*domain.Device{lamp}, nil)
+					m.deliveryRepo.EXPECT().GetQueuedDeliveries(gomock.Any(), reminder.ID).Return(nil, nil)
+					m.deliveryRepo.EXPECT().CreateDeliveries(gomock.Any(), gomock.Any()).Return(nil)
+					m.deviceRegistry.EXPECT().Glow(lamp, gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ *domain.Device, glowed *domain.Reminder, _ uuid.UUID) error {
+							testcase.expectedGlow(t, glowed)
+							return nil
+						})
+					m.deliveryRepo.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any()).Return(nil)
+				}
+
+				if testcase.mock != nil {
+					testcase.mock(m, task)
+				}
+
+				// A deferred task is neither completed nor acknowledged until it fires again.
+				if user.QuietPolicy != domain.QuietDefer || testcase.expectedGlow != nil {
+					m.reminderRepo.EXPECT().DeleteReminder(gomock.Any(), reminder.ID).Return(nil)
+					m.reminderTaskRepo.EXPECT().AckReminderTask(gomock.Any(), task).Return(nil)
+				}
+			})
+
+			assert.NoError(t, s.ProcessReminderTask(context.Background(), task))
+		})
+	}
+}
+
+func TestProcessReminderTaskDeliveries(t *testing.T) {
+	t.Parallel()
+
+	httpLamp := &domain.Device{ID: uuid.New(), UserID: userID, Name: "kitchen", Protocol: domain.HTTPProtocol}
+	mqttLamp := &domain.Device{ID: uuid.New(), UserID: userID, Name: "bedroom", Protocol: domain.MQTTProtocol}
+	removedLamp := &domain.Device{ID: uuid.New(), UserID: userID, Name: "hall", Protocol: domain.HTTPProtocol}
+
+	queued := func(device *domain.Device, attempts int64) *domain.LampDelivery {
+		return &domain.LampDelivery{
+			ID:         uuid.New(),
+			UserID:     userID,
+			DeviceID:   uuid.NullUUID{UUID: device.ID, Valid: true},
+			DeviceName: device.Name,
+			Status:     domain.DeliveryQueued,
+			Attempts:   attempts,
+		}
+	}
+
+	type update struct {
+		device   string
+		status   domain.DeliveryStatus
+		attempts int64
+		err      string
+	}
+
+	testcases := []struct {
+		name            string
+		devices         []*domain.Device
+		queued          []*domain.LampDelivery
+		glowErrs        map[string]error
+		mock            func(m schedulerMocks, task *domain.ReminderTask)
+		expectedUpdates []update
+	}{
+		{
+			name:    "moves a delivery to a lamp over HTTP from queued to sent",
+			devices: []*domain.Device{httpLamp},
+			expectedUpdates: []update{
+				{device: "kitchen", status: domain.DeliverySent, attempts: 1},
+			},
+		},
+		{
+			name:    "moves a delivery to a lamp over MQTT from queued to acknowledged",
+			devices: []*domain.Device{mqttLamp},
+			expectedUpdates: []update{
+				{device: "bedroom", status: domain.DeliveryAcknowledged, attempts: 1},
+			},
+		},
+		{
+			name:     "keeps a delivery to an unreachable lamp queued for the retry",
+			devices:  []*domain.Device{httpLamp, mqttLamp},
+			glowErrs: map[string]error{"kitchen": errLamp},
+			mock: func(m schedulerMocks, task *domain.ReminderTask) {
+				m.reminderTaskRepo.EXPECT().IncrReminderTaskAttempts(gomock.Any(), task).Return(int64(1), nil)
+				m.reminderTaskRepo.EXPECT().NackReminderTask(gomock.Any(), task, now.Add(10*time.Second)).Return(nil)
+			},
+			expectedUpdates: []update{
+				{device: "kitchen", status: domain.DeliveryQueued, attempts: 1, err: "lamp is unreachable"},
+				{device: "bedroom", status: domain.DeliveryAcknowledged, attempts: 1},
+			},
+		},
+		{
+			name:    "retries only the deliveries left queued",
+			devices: []*domain.Device{httpLamp, mqttLamp},
+			queued:  []*domain.LampDelivery{queued(httpLamp, 1)},
+			expectedUpdates: []update{
+				{device: "kitchen", status: domain.DeliverySent, attempts: 2},
+			},
+		},
+		{
+			name:    "fails a queued delivery to a removed lamp",
+			devices: []*domain.Device{httpLamp},
+			queued:  []*domain.LampDelivery{queued(httpLamp, 1), queued(removedLamp, 1)},
+			expectedUpdates: []update{
+				{device: "kitchen", status: domain.DeliverySent, attempts: 2},
+				{device: "hall", status: domain.DeliveryFailed, attempts: 1, err: "lamp is removed"},
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			reminder := newReminder("")
+			task := &domain.ReminderTask{ID: reminder.ID}
+
+			var updates []update
+
+			s := schedulerHelper(t, now, func(m schedulerMocks) {
+				m.reminderRepo.EXPECT().GetReminder(gomock.Any(), reminder.ID).Return(reminder, nil)
+				m.userRepo.EXPECT().GetUser(gomock.Any(), userID).Return(&domain.User{ID: userID}, nil).AnyTimes()
+				m.deviceRegistry.EXPECT().ReminderDevices(gomock.Any(), reminder).Return(testcase.devices, nil)
+				m.deliveryRepo.EXPECT().GetQueuedDeliveries(gomock.Any(), reminder.ID).Return(testcase.queued, nil)
+
+				// A retry reuses the queued deliveries instead of queuing the lamps that are already lit again.
+				if len(testcase.queued) == 0 {
+					m.deliveryRepo.EXPECT().CreateDeliveries(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, deliveries []domain.LampDelivery) error {
+							assert.Len(t, deliveries, len(testcase.devices))
+							for _, delivery := range deliveries {
+								assert.Equal(t, domain.DeliveryQueued, delivery.Status)
+								assert.Equal(t, now, delivery.FiredAt)
+							}
+							return nil
+						})
+				}
+
+				m.deviceRegistry.EXPECT().Glow(gomock.Any(), reminder, gomock.Any()).
+					DoAndReturn(func(device *domain.Device, _ *domain.Reminder, _ uuid.UUID) error {
+						return testcase.glowErrs[device.Name]
+					}).AnyTimes()
+				m.deliveryRepo.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, delivery domain.LampDelivery) error {
+						updates = append(updates, update{
+							device:   delivery.DeviceName,
+							status:   delivery.Status,
+							attempts: delivery.Attempts,
+							err:      delivery.LastError,
+						})
+						return nil
+					}).Times(len(testcase.expectedUpdates))
+
+				if testcase.mock != nil {
+					testcase.mock(m, task)
+				} else {
+					m.reminderRepo.EXPECT().DeleteReminder(gomock.Any(), reminder.ID).Return(nil)
+					m.reminderTaskRepo.EXPECT().AckReminderTask(gomock.Any(), task).Return(nil)
+				}
+			})
+
+			assert.NoError(t, s.ProcessReminderTask(context.Background(), task))
+			assert.Equal(t, testcase.expectedUpdates, updates)
+		})
+	}
+}
+
+func TestProcessReminderTaskDueTime(t *testing.T) {
+	t.Parallel()
+
+	claimedAt := time.Date(2025, 5, 10, 12, 20, 0, 300_000_000, time.UTC)
+	lamp := &domain.Device{ID: uuid.New(), UserID: userID, Name: "kitchen", Protocol: domain.HTTPProtocol}
+
+	testcases := []struct {
+		name        string
+		scheduledAt time.Time
+		mock        func(m schedulerMocks, reminder *domain.Reminder, task *domain.ReminderTask)
+	}{
+		{
+			name:        "fires a reminder claimed earlier in the second it is due",
+			scheduledAt: time.Date(2025, 5, 10, 12, 20, 0, 700_000_000, time.UTC),
+			mock: func(m schedulerMocks, reminder *domain.Reminder, task *domain.ReminderTask) {
+				m.userRepo.EXPECT().GetUser(gomock.Any(), userID).Return(&domain.User{ID: userID}, nil).AnyTimes()
+				m.deviceRegistry.EXPECT().ReminderDevices(gomock.Any(), reminder).Return([]*domain.Device{lamp}, nil)
+				m.deliveryRepo.EXPECT().GetQueuedDeliveries(gomock.Any(), reminder.ID).Return(nil, nil)
+				m.deliveryRepo.EXPECT().CreateDeliveries(gomock.Any(), gomock.Any()).Return(nil)
+				m.deviceRegistry.EXPECT().Glow(lamp, reminder, gomock.Any()).Return(nil)
+				m.deliveryRepo.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any()).Return(nil)
+				m.reminderRepo.EXPECT().DeleteReminder(gomock.Any(), reminder.ID).Return(nil)
+				m.reminderTaskRepo.EXPECT().AckReminderTask(gomock.Any(), task).Return(nil)
+			},
+		},
+		{
+			name:        "returns the task of a reminder rescheduled later to wait for it",
+			scheduledAt: time.Date(2025, 5, 10, 12, 20, 1, 0, time.UTC),
+			mock: func(m schedulerMocks, reminder *domain.Reminder, task *domain.ReminderTask) {
+				m.deliveryRepo.EXPECT().FailQueuedDeliveries(gomock.Any(), reminder.ID, "reminder is rescheduled", claimedAt).
+					Return(nil)
+				m.reminderTaskRepo.EXPECT().NackReminderTask(gomock.Any(), task, reminder.ScheduledAt).Return(nil)
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			reminder := newReminder("")
+			reminder.ScheduledAt = testcase.scheduledAt
+			task := &domain.ReminderTask{ID: reminder.ID}
+
+			s := schedulerHelper(t, claimedAt, func(m schedulerMocks) {
+				m.reminderRepo.EXPECT().GetReminder(gomock.Any(), reminder.ID).Return(reminder, nil)
+				testcase.mock(m, reminder, task)
+			})
+
+			assert.NoError(t, s.ProcessReminderTask(context.Background(), task))
+		})
+	}
+}
@@ -0,0 +1,387 @@
+package timeparse
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/almostinf/glow-reminder/pkg/clock"
+)
+
+// defaultHour is used when only a day is given, e.g. "tomorrow" or "next friday".
+const defaultHour = 9
+
+var ErrUnrecognizedTime = errors.New("unrecognized time")
+
+var isoLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+var (
+	relativePrefixes = []string{"in", "через"}
+	nextWords        = []string{"next", "следующий", "следующую", "следующее", "следующая"}
+	fillerWords      = []string{"at", "on", "в", "во", "and", "и"}
+)
+
+var units = map[string]time.Duration{
+	"m":       time.Minute,
+	"min":     time.Minute,
+	"mins":    time.Minute,
+	"minute":  time.Minute,
+	"minutes": time.Minute,
+	"мин":     time.Minute,
+	"минута":  time.Minute,
+	"минуту":  time.Minute,
+	"минуты":  time.Minute,
+	"минут":   time.Minute,
+	"h":       time.Hour,
+	"hr":      time.Hour,
+	"hrs":     time.Hour,
+	"hour":    time.Hour,
+	"hours":   time.Hour,
+	"ч":       time.Hour,
+	"час":     time.Hour,
+	"часа":    time.Hour,
+	"часов":   time.Hour,
+}
+
+// dayUnits are applied with AddDate to keep the wall clock time across DST changes.
+var dayUnits = map[string]int{
+	"d":      1,
+	"day":    1,
+	"days":   1,
+	"день":   1,
+	"дня":    1,
+	"дней":   1,
+	"w":      7,
+	"week":   7,
+	"weeks":  7,
+	"неделю": 7,
+	"недели": 7,
+	"недель": 7,
+}
+
+var oneWords = []string{"a", "an", "one", "одну", "один", "одна"}
+
+var dayWords = map[string]int{
+	"today":            0,
+	"tomorrow":         1,
+	"dayaftertomorrow": 2,
+	"сегодня":          0,
+	"завтра":           1,
+	"послезавтра":      2,
+}
+
+var weekdays = map[string]time.Weekday{
+	"monday":      time.Monday,
+	"mon":         time.Monday,
+	"tuesday":     time.Tuesday,
+	"tue":         time.Tuesday,
+	"wednesday":   time.Wednesday,
+	"wed":         time.Wednesday,
+	"thursday":    time.Thursday,
+	"thu":         time.Thursday,
+	"friday":      time.Friday,
+	"fri":         time.Friday,
+	"saturday":    time.Saturday,
+	"sat":         time.Saturday,
+	"sunday":      time.Sunday,
+	"sun":         time.Sunday,
+	"понедельник": time.Monday,
+	"вторник":     time.Tuesday,
+	"среда":       time.Wednesday,
+	"среду":       time.Wednesday,
+	"четверг":     time.Thursday,
+	"пятница":     time.Friday,
+	"пятницу":     time.Friday,
+	"суббота":     time.Saturday,
+	"субботу":     time.Saturday,
+	"воскресенье": time.Sunday,
+}
+
+var (
+	amWords = []string{"am", "утра", "ночи"}
+	pmWords = []string{"pm", "вечера", "дня"}
+)
+
+var (
+	clockRe    = regexp.MustCompile(`^(\d{1,2})(?:[:.](\d{2}))?(am|pm)?$`)
+	quantityRe = regexp.MustCompile(`^(\d+)([a-zа-я]+)$`)
+)
+
+// Parser defines the interface for parsing human-entered reminder times.
+type Parser interface {
+	Parse(input string, location *time.Location) (time.Time, error)
+}
+
+type parser struct {
+	clock clock.Clock
+}
+
+// New creates a new instance of Parser resolving relative times against the given clock.
+func New(clock clock.Clock) Parser {
+	return &parser{
+		clock: clock,
+	}
+}
+
+// Parse converts input to an absolute time in the given location. It understands
+// ISO-8601 ("2025-03-10 15:04", "2025-03-10T15:04:05+01:00"), relative times
+// ("in 20 minutes", "in 1h30m", "через 2 часа") and day with time of day
+// ("tomorrow 9:00", "next friday at 18", "в пятницу в 6 вечера").
+func (p *parser) Parse(input string, location *time.Location) (time.Time, error) {
+	input = strings.TrimSpace(input)
+
+	for _, layout := range isoLayouts {
+		if t, err := time.ParseInLocation(layout, input, location); err == nil {
+			return t.In(location).Truncate(time.Second), nil
+		}
+	}
+
+	tokens := tokenize(input)
+	if len(tokens) == 0 {
+		return time.Time{}, fmt.Errorf("%w: empty input", ErrUnrecognizedTime)
+	}
+
+	// The times are whole seconds, as the reminder tasks are claimed by the second they are due.
+	now := p.clock.NowUTC().Truncate(time.Second).In(location)
+
+	if contains(relativePrefixes, tokens[0]) {
+		return parseRelative(tokens[1:], now)
+	}
+
+	return parseAbsolute(tokens, now)
+}
+
+func tokenize(input string) []string {
+	input = strings.ToLower(input)
+	input = strings.ReplaceAll(input, "day after tomorrow", "dayaftertomorrow")
+	input = strings.ReplaceAll(input, ",", " ")
+
+	tokens := strings.Fields(input)
+
+	// Glue "6 pm" into "6pm" to have a single clock token.
+	glued := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if (token == "am" || token == "pm") && len(glued) != 0 && clockRe.MatchString(glued[len(glued)-1]) {
+			glued[len(glued)-1] += token
+			continue
+		}
+		glued = append(glued, token)
+	}
+
+	return glued
+}
+
+func parseRelative(tokens []string, now time.Time) (time.Time, error) {
+	if len(tokens) == 0 {
+		return time.Time{}, fmt.Errorf("%w: missing duration", ErrUnrecognizedTime)
+	}
+
+	var (
+		result   = now
+		quantity = 1
+		pending  bool
+		applied  bool
+	)
+
+	for _, token := range tokens {
+		if d, err := time.ParseDuration(token); err == nil && d > 0 {
+			result = result.Add(d)
+			applied = true
+			continue
+		}
+
+		if matches := quantityRe.FindStringSubmatch(token); matches != nil {
+			n, _ := strconv.Atoi(matches[1])
+			if !applyUnit(&result, matches[2], n) {
+				return time.Time{}, fmt.Errorf("%w: unknown unit %q", ErrUnrecognizedTime, matches[2])
+			}
+			applied = true
+			continue
+		}
+
+		if n, err := strconv.Atoi(token); err == nil {
+			if pending || n <= 0 {
+				return time.Time{}, fmt.Errorf("%w: unexpected number %q", ErrUnrecognizedTime, token)
+			}
+			quantity, pending = n, true
+			continue
+		}
+
+		if contains(oneWords, token) {
+			quantity, pending = 1, true
+			continue
+		}
+
+		if contains(fillerWords, token) {
+			continue
+		}
+
+		if !applyUnit(&result, token, quantity) {
+			return time.Time{}, fmt.Errorf("%w: unknown unit %q", ErrUnrecognizedTime, token)
+		}
+
+		quantity, pending, applied = 1, false, true
+	}
+
+	if pending || !applied {
+		return time.Time{}, fmt.Errorf("%w: missing unit", ErrUnrecognizedTime)
+	}
+
+	return result, nil
+}
+
+func applyUnit(t *time.Time, unit string, quantity int) bool {
+	if d, ok := units[unit]; ok {
+		*t = t.Add(time.Duration(quantity) * d)
+		return true
+	}
+
+	if days, ok := dayUnits[unit]; ok {
+		*t = t.AddDate(0, 0, quantity*days)
+		return true
+	}
+
+	return false
+}
+
+func parseAbsolute(tokens []string, now time.Time) (time.Time, error) {
+	var (
+		dayOffset = -1
+		weekday   = time.Weekday(-1)
+		next      bool
+		hour      = -1
+		minute    int
+	)
+
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+
+		switch {
+		case contains(fillerWords, token):
+		case contains(nextWords, token):
+			next = true
+		case isDayWord(token):
+			dayOffset = dayWords[token]
+		case isWeekday(token):
+			weekday = weekdays[token]
+		case clockRe.MatchString(token):
+			if hour != -1 {
+				return time.Time{}, fmt.Errorf("%w: time of day is given twice", ErrUnrecognizedTime)
+			}
+
+			var err error
+			hour, minute, err = parseClock(token)
+			if err != nil {
+				return time.Time{}, err
+			}
+
+			// "6 вечера", "7 утра".
+			if i+1 < len(tokens) && (contains(amWords, tokens[i+1]) || contains(pmWords, tokens[i+1])) {
+				hour = adjustHalfDay(hour, contains(pmWords, tokens[i+1]))
+				i++
+			}
+		default:
+			return time.Time{}, fmt.Errorf("%w: unexpected %q", ErrUnrecognizedTime, token)
+		}
+	}
+
+	if dayOffset == -1 && weekday == -1 && hour == -1 {
+		return time.Time{}, fmt.Errorf("%w: neither day nor time is given", ErrUnrecognizedTime)
+	}
+
+	if next && weekday == -1 {
+		return time.Time{}, fmt.Errorf("%w: 'next' must be followed by a weekday", ErrUnrecognizedTime)
+	}
+
+	if hour == -1 {
+		hour, minute = defaultHour, 0
+	}
+
+	at := func(days int) time.Time {
+		return time.Date(now.Year(), now.Month(), now.Day()+days, hour, minute, 0, 0, now.Location())
+	}
+
+	switch {
+	case weekday != -1:
+		days := (int(weekday) - int(now.Weekday()) + 7) % 7
+		if next && days == 0 {
+			days = 7
+		}
+		if days == 0 && !at(0).After(now) {
+			days = 7
+		}
+		return at(days), nil
+	case dayOffset != -1:
+		return at(dayOffset), nil
+	default:
+		// Only a time of day is given: the nearest one in the future.
+		if t := at(0); t.After(now) {
+			return t, nil
+		}
+		return at(1), nil
+	}
+}
+
+func parseClock(token string) (int, int, error) {
+	matches := clockRe.FindStringSubmatch(token)
+
+	hour, _ := strconv.Atoi(matches[1])
+
+	var minute int
+	if matches[2] != "" {
+		minute, _ = strconv.Atoi(matches[2])
+	}
+
+	if matches[3] != "" {
+		if hour < 1 || hour > 12 {
+			return 0, 0, fmt.Errorf("%w: invalid hour %q", ErrUnrecognizedTime, token)
+		}
+		hour = adjustHalfDay(hour, matches[3] == "pm")
+	}
+
+	if hour > 23 || minute > 59 {
+		return 0, 0, fmt.Errorf("%w: invalid time of day %q", ErrUnrecognizedTime, token)
+	}
+
+	return hour, minute, nil
+}
+
+func adjustHalfDay(hour int, pm bool) int {
+	switch {
+	case pm && hour < 12:
+		return hour + 12
+	case !pm && hour == 12:
+		return 0
+	default:
+		return hour
+	}
+}
+
+func isDayWord(token string) bool {
+	_, ok := dayWords[token]
+	return ok
+}
+
+func isWeekday(token string) bool {
+	_, ok := weekdays[token]
+	return ok
+}
+
+func contains(words []string, token string) bool {
+	for _, word := range words {
+		if word == token {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,247 @@
+package timeparse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/timeparse"
+	clock_mocks "github.com/almostinf/glow-reminder/pkg/clock/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func parserHelper(t *testing.T, now time.Time) timeparse.Parser {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	mockClock := clock_mocks.NewMockClock(mockCtrl)
+	mockClock.EXPECT().NowUTC().Return(now.UTC()).AnyTimes()
+
+	return timeparse.New(mockClock)
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	// Wednesday.
+	now := time.Date(2025, 3, 12, 14, 20, 0, 0, berlin)
+
+	testcases := []struct {
+		name         string
+		input        string
+		expectedTime time.Time
+		expectedErr  error
+	}{
+		{
+			name:         "legacy format",
+			input:        "2025-03-20 15:04",
+			expectedTime: time.Date(2025, 3, 20, 15, 4, 0, 0, berlin),
+		},
+		{
+			name:         "iso-8601 with offset",
+			input:        "2025-03-20T15:04:00Z",
+			expectedTime: time.Date(2025, 3, 20, 16, 4, 0, 0, berlin),
+		},
+		{
+			name:         "iso-8601 without offset",
+			input:        "2025-03-20T15:04",
+			expectedTime: time.Date(2025, 3, 20, 15, 4, 0, 0, berlin),
+		},
+		{
+			name:         "in minutes",
+			input:        "in 20 minutes",
+			expectedTime: now.Add(20 * time.Minute),
+		},
+		{
+			name:         "in an hour",
+			input:        "In an hour",
+			expectedTime: now.Add(time.Hour),
+		},
+		{
+			name:         "in compound duration",
+			input:        "in 1h30m",
+			expectedTime: now.Add(90 * time.Minute),
+		},
+		{
+			name:         "in hours and minutes",
+			input:        "in 2 hours and 15 min",
+			expectedTime: now.Add(135 * time.Minute),
+		},
+		{
+			name:         "in days",
+			input:        "in 3d",
+			expectedTime: time.Date(2025, 3, 15, 14, 20, 0, 0, berlin),
+		},
+		{
+			name:         "russian in hours",
+			input:        "через 2 часа",
+			expectedTime: now.Add(2 * time.Hour),
+		},
+		{
+			name:         "russian in an hour",
+			input:        "через час",
+			expectedTime: now.Add(time.Hour),
+		},
+		{
+			name:         "russian in a week",
+			input:        "через неделю",
+			expectedTime: time.Date(2025, 3, 19, 14, 20, 0, 0, berlin),
+		},
+		{
+			name:         "tomorrow with time",
+			input:        "tomorrow 9:00",
+			expectedTime: time.Date(2025, 3, 13, 9, 0, 0, 0, berlin),
+		},
+		{
+			name:         "tomorrow without time",
+			input:        "tomorrow",
+			expectedTime: time.Date(2025, 3, 13, 9, 0, 0, 0, berlin),
+		},
+		{
+			name:         "day after tomorrow with pm",
+			input:        "day after tomorrow at 6 pm",
+			expectedTime: time.Date(2025, 3, 14, 18, 0, 0, 0, berlin),
+		},
+		{
+			name:         "next friday at hour",
+			input:        "next friday at 18",
+			expectedTime: time.Date(2025, 3, 14, 18, 0, 0, 0, berlin),
+		},
+		{
+			name:         "next wednesday is a week later",
+			input:        "next wednesday 8:15",
+			expectedTime: time.Date(2025, 3, 19, 8, 15, 0, 0, berlin),
+		},
+		{
+			name:         "wednesday later today",
+			input:        "wednesday 20:00",
+			expectedTime: time.Date(2025, 3, 12, 20, 0, 0, 0, berlin),
+		},
+		{
+			name:         "wednesday already passed today",
+			input:        "wed 10am",
+			expectedTime: time.Date(2025, 3, 19, 10, 0, 0, 0, berlin),
+		},
+		{
+			name:         "time only later today",
+			input:        "at 18:30",
+			expectedTime: time.Date(2025, 3, 12, 18, 30, 0, 0, berlin),
+		},
+		{
+			name:         "time only already passed",
+			input:        "9.45",
+			expectedTime: time.Date(2025, 3, 13, 9, 45, 0, 0, berlin),
+		},
+		{
+			name:         "russian tomorrow",
+			input:        "завтра в 9:00",
+			expectedTime: time.Date(2025, 3, 13, 9, 0, 0, 0, berlin),
+		},
+		{
+			name:         "russian next friday in the evening",
+			input:        "в следующую пятницу в 6 вечера",
+			expectedTime: time.Date(2025, 3, 14, 18, 0, 0, 0, berlin),
+		},
+		{
+			name:         "midnight",
+			input:        "tomorrow 12am",
+			expectedTime: time.Date(2025, 3, 13, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:        "empty input",
+			input:       "  ",
+			expectedErr: timeparse.ErrUnrecognizedTime,
+		},
+		{
+			name:        "unknown word",
+			input:       "someday",
+			expectedErr: timeparse.ErrUnrecognizedTime,
+		},
+		{
+			name:        "missing unit",
+			input:       "in 20",
+			expectedErr: timeparse.ErrUnrecognizedTime,
+		},
+		{
+			name:        "unknown unit",
+			input:       "in 20 parsecs",
+			expectedErr: timeparse.ErrUnrecognizedTime,
+		},
+		{
+			name:        "invalid hour",
+			input:       "tomorrow 25:00",
+			expectedErr: timeparse.ErrUnrecognizedTime,
+		},
+		{
+			name:        "next without weekday",
+			input:       "next 9:00",
+			expectedErr: timeparse.ErrUnrecognizedTime,
+		},
+		{
+			name:        "time given twice",
+			input:       "tomorrow 9:00 10:00",
+			expectedErr: timeparse.ErrUnrecognizedTime,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			parser := parserHelper(t, now)
+
+			parsed, err := parser.Parse(testcase.input, berlin)
+
+			if testcase.expectedErr != nil {
+				assert.ErrorIs(t, err, testcase.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.True(t, testcase.expectedTime.Equal(parsed), "expected %s, got %s", testcase.expectedTime, parsed)
+				assert.Equal(t, berlin, parsed.Location())
+			}
+		})
+	}
+}
+
+func TestParseWholeSeconds(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 3, 12, 14, 20, 5, 700_000_000, time.UTC)
+
+	testcases := []struct {
+		name         string
+		input        string
+		expectedTime time.Time
+	}{
+		{
+			name:         "relative time",
+			input:        "in 20 minutes",
+			expectedTime: time.Date(2025, 3, 12, 14, 40, 5, 0, time.UTC),
+		},
+		{
+			name:         "iso-8601 with fraction",
+			input:        "2025-03-20T15:04:05.250Z",
+			expectedTime: time.Date(2025, 3, 20, 15, 4, 5, 0, time.UTC),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			parsedTime, err := parserHelper(t, now).Parse(testcase.input, time.UTC)
+
+			require.NoError(t, err)
+			assert.True(t, testcase.expectedTime.Equal(parsedTime), "expected %s, got %s", testcase.expectedTime, parsedTime)
+		})
+	}
+}
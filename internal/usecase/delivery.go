@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/repository/pg"
+	"github.com/almostinf/glow-reminder/pkg/clock"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// DeliveryUsecase tracks whether the lamps have really been lit by the fired reminders.
+type DeliveryUsecase interface {
+	// AcknowledgeDelivery records the confirmation of the lamp: the lamp is lit if ok is set, otherwise
+	// the delivery fails with the reason. It returns domain.ErrInvalidDeliveryTransition for a finished delivery.
+	AcknowledgeDelivery(ctx context.Context, id uuid.UUID, ok bool, reason string) error
+	// GetLastDeliveries returns the deliveries of the last firing of each of the reminders by the reminder id.
+	GetLastDeliveries(ctx context.Context, reminderIDs []uuid.UUID) (map[uuid.UUID][]*domain.LampDelivery, error)
+}
+
+type deliveryUsecase struct {
+	deliveryRepo pg.DeliveryRepo
+	clock        clock.Clock
+	logger       logger.Logger
+}
+
+func NewDelivery(deliveryRepo pg.DeliveryRepo, clock clock.Clock, logger logger.Logger) *deliveryUsecase {
+	return &deliveryUsecase{
+		deliveryRepo: deliveryRepo,
+		clock:        clock,
+		logger:       logger,
+	}
+}
+
+func (usecase *deliveryUsecase) AcknowledgeDelivery(ctx context.Context, id uuid.UUID, ok bool, reason string) error {
+	delivery, err := usecase.deliveryRepo.GetDelivery(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to GetDelivery: %w", err)
+	}
+
+	status := domain.DeliveryAcknowledged
+	if !ok {
+		status = domain.DeliveryFailed
+		delivery.LastError = reason
+	}
+
+	if !delivery.Status.CanMoveTo(status) {
+		return fmt.Errorf("delivery %v from %s to %s: %w", id, delivery.Status, status, domain.ErrInvalidDeliveryTransition)
+	}
+
+	delivery.Status = status
+	delivery.UpdatedAt = usecase.clock.NowUTC()
+
+	if err = usecase.deliveryRepo.UpdateDelivery(ctx, *delivery); err != nil {
+		return fmt.Errorf("failed to UpdateDelivery: %w", err)
+	}
+
+	return nil
+}
+
+func (usecase *deliveryUsecase) GetLastDeliveries(
+	ctx context.Context,
+	reminderIDs []uuid.UUID,
+) (map[uuid.UUID][]*domain.LampDelivery, error) {
+	deliveries, err := usecase.deliveryRepo.GetLastDeliveries(ctx, reminderIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to GetLastDeliveries: %w", err)
+	}
+
+	reminderDeliveries := make(map[uuid.UUID][]*domain.LampDelivery, len(reminderIDs))
+	for _, delivery := range deliveries {
+		reminderDeliveries[delivery.ReminderID] = append(reminderDeliveries[delivery.ReminderID], delivery)
+	}
+
+	return reminderDeliveries, nil
+}
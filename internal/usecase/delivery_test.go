@@ -0,0 +1,130 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	pg_mocks "github.com/almostinf/glow-reminder/internal/repository/pg/mocks"
+	"github.com/almostinf/glow-reminder/internal/usecase"
+	clock_mocks "github.com/almostinf/glow-reminder/pkg/clock/mocks"
+	logger_mocks "github.com/almostinf/glow-reminder/pkg/logger/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAcknowledgeDelivery(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("9b2e4c1a-7d3f-4e8a-a1c5-6f0d2b8e3c47")
+	now := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+	errStore := errors.New("store is unavailable")
+
+	testcases := []struct {
+		name           string
+		status         domain.DeliveryStatus
+		ok             bool
+		reason         string
+		getErr         error
+		expectedUpdate *domain.LampDelivery
+		expectedErr    error
+	}{
+		{
+			name:           "acknowledges a queued delivery",
+			status:         domain.DeliveryQueued,
+			ok:             true,
+			expectedUpdate: &domain.LampDelivery{ID: id, Status: domain.DeliveryAcknowledged, UpdatedAt: now},
+		},
+		{
+			name:           "acknowledges a sent delivery",
+			status:         domain.DeliverySent,
+			ok:             true,
+			expectedUpdate: &domain.LampDelivery{ID: id, Status: domain.DeliveryAcknowledged, UpdatedAt: now},
+		},
+		{
+			name:           "accepts a duplicate ack",
+			status:         domain.DeliveryAcknowledged,
+			ok:             true,
+			expectedUpdate: &domain.LampDelivery{ID: id, Status: domain.DeliveryAcknowledged, UpdatedAt: now},
+		},
+		{
+			name:   "fails a sent delivery with the reason of the lamp",
+			status: domain.DeliverySent,
+			reason: "led strip is off",
+			expectedUpdate: &domain.LampDelivery{
+				ID:        id,
+				Status:    domain.DeliveryFailed,
+				LastError: "led strip is off",
+				UpdatedAt: now,
+			},
+		},
+		{
+			name:   "fails a queued delivery with the reason of the lamp",
+			status: domain.DeliveryQueued,
+			reason: "unknown effect",
+			expectedUpdate: &domain.LampDelivery{
+				ID:        id,
+				Status:    domain.DeliveryFailed,
+				LastError: "unknown effect",
+				UpdatedAt: now,
+			},
+		},
+		{
+			name:        "rejects a failure of an acknowledged delivery",
+			status:      domain.DeliveryAcknowledged,
+			reason:      "late error",
+			expectedErr: domain.ErrInvalidDeliveryTransition,
+		},
+		{
+			name:        "rejects an ack of a completed delivery",
+			status:      domain.DeliveryCompleted,
+			ok:          true,
+			expectedErr: domain.ErrInvalidDeliveryTransition,
+		},
+		{
+			name:        "rejects an ack of a failed delivery",
+			status:      domain.DeliveryFailed,
+			ok:          true,
+			expectedErr: domain.ErrInvalidDeliveryTransition,
+		},
+		{
+			name:        "returns the error of the store",
+			getErr:      errStore,
+			ok:          true,
+			expectedErr: errStore,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			deliveryRepo := pg_mocks.NewMockDeliveryRepo(mockCtrl)
+			if testcase.getErr != nil {
+				deliveryRepo.EXPECT().GetDelivery(gomock.Any(), id).Return(nil, testcase.getErr)
+			} else {
+				deliveryRepo.EXPECT().GetDelivery(gomock.Any(), id).
+					Return(&domain.LampDelivery{ID: id, Status: testcase.status}, nil)
+			}
+			if testcase.expectedUpdate != nil {
+				deliveryRepo.EXPECT().UpdateDelivery(gomock.Any(), *testcase.expectedUpdate).Return(nil)
+			}
+
+			clk := clock_mocks.NewMockClock(mockCtrl)
+			clk.EXPECT().NowUTC().Return(now).AnyTimes()
+
+			deliveryUsecase := usecase.NewDelivery(deliveryRepo, clk, logger_mocks.NewMockLogger(mockCtrl))
+
+			err := deliveryUsecase.AcknowledgeDelivery(context.Background(), id, testcase.ok, testcase.reason)
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
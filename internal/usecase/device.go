@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/repository/pg"
+	"github.com/almostinf/glow-reminder/pkg/clock"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidDeviceHost = errors.New("invalid device host")
+	ErrUnknownDevice     = errors.New("unknown device")
+
+	// mqttDeviceIDRegexp keeps the id of an MQTT lamp a single topic level without wildcards.
+	mqttDeviceIDRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+)
+
+// DeviceUsecase manages the lamps of a user. The bot refers to the lamps by their names.
+type DeviceUsecase interface {
+	GetDevices(ctx context.Context, userID int64) ([]*domain.Device, error)
+	GetDevice(ctx context.Context, id uuid.UUID) (*domain.Device, error)
+	// CheckDeviceOwner returns ErrUnknownDevice if the reminder targets a lamp of another user.
+	CheckDeviceOwner(ctx context.Context, reminder *domain.Reminder) error
+	CreateDevice(ctx context.Context, device domain.Device) error
+	RenameDevice(ctx context.Context, userID int64, name, newName string) error
+	DeleteDevice(ctx context.Context, userID int64, name string) error
+	// GetDeviceStatuses returns the health check statuses by the device id, the lamps not checked yet are missing.
+	GetDeviceStatuses(ctx context.Context, deviceIDs []uuid.UUID) (map[uuid.UUID]*domain.DeviceStatus, error)
+}
+
+type deviceUsecase struct {
+	deviceRepo       pg.DeviceRepo
+	deviceStatusRepo pg.DeviceStatusRepo
+	clock            clock.Clock
+	logger           logger.Logger
+}
+
+func NewDevice(
+	deviceRepo pg.DeviceRepo,
+	deviceStatusRepo pg.DeviceStatusRepo,
+	clock clock.Clock,
+	logger logger.Logger,
+) *deviceUsecase {
+	return &deviceUsecase{
+		deviceRepo:       deviceRepo,
+		deviceStatusRepo: deviceStatusRepo,
+		clock:            clock,
+		logger:           logger,
+	}
+}
+
+func (usecase *deviceUsecase) GetDevices(ctx context.Context, userID int64) ([]*domain.Device, error) {
+	return usecase.deviceRepo.GetDevices(ctx, userID)
+}
+
+func (usecase *deviceUsecase) GetDevice(ctx context.Context, id uuid.UUID) (*domain.Device, error) {
+	return usecase.deviceRepo.GetDevice(ctx, id)
+}
+
+func (usecase *deviceUsecase) CheckDeviceOwner(ctx context.Context, reminder *domain.Reminder) error {
+	if !reminder.DeviceID.Valid {
+		return nil
+	}
+
+	device, err := usecase.deviceRepo.GetDevice(ctx, reminder.DeviceID.UUID)
+	if errors.Is(err, domain.ErrNotFound) || (err == nil && device.UserID != reminder.UserID) {
+		return fmt.Errorf("%w: %v", ErrUnknownDevice, reminder.DeviceID.UUID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to GetDevice: %w", err)
+	}
+
+	return nil
+}
+
+func (usecase *deviceUsecase) CreateDevice(ctx context.Context, device domain.Device) error {
+	if err := domain.ValidateDeviceName(device.Name); err != nil {
+		return err
+	}
+
+	if device.Group != "" {
+		if err := domain.ValidateDeviceName(device.Group); err != nil {
+			return err
+		}
+	}
+
+	if device.Protocol == "" {
+		device.Protocol = domain.HTTPProtocol
+	}
+
+	if err := validateDeviceHost(device.Protocol, device.Host); err != nil {
+		return err
+	}
+
+	now := usecase.clock.NowUTC()
+
+	device.ID = uuid.New()
+	device.CreatedAt = now
+	device.UpdatedAt = now
+
+	if err := usecase.deviceRepo.CreateDevice(ctx, device); err != nil {
+		return fmt.Errorf("failed to CreateDevice: %w", err)
+	}
+
+	return nil
+}
+
+func (usecase *deviceUsecase) RenameDevice(ctx context.Context, userID int64, name, newName string) error {
+	if err := domain.ValidateDeviceName(newName); err != nil {
+		return err
+	}
+
+	device, err := usecase.getDeviceByName(ctx, userID, name)
+	if err != nil {
+		return err
+	}
+
+	device.Name = newName
+	device.UpdatedAt = usecase.clock.NowUTC()
+
+	if err = usecase.deviceRepo.UpdateDevice(ctx, *device); err != nil {
+		return fmt.Errorf("failed to UpdateDevice: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteDevice removes the lamp. The reminders targeting it light all the lamps of the user from now on.
+func (usecase *deviceUsecase) DeleteDevice(ctx context.Context, userID int64, name string) error {
+	device, err := usecase.getDeviceByName(ctx, userID, name)
+	if err != nil {
+		return err
+	}
+
+	if err = usecase.deviceRepo.DeleteDevice(ctx, device.ID); err != nil {
+		return fmt.Errorf("failed to DeleteDevice: %w", err)
+	}
+
+	return nil
+}
+
+func (usecase *deviceUsecase) getDeviceByName(ctx context.Context, userID int64, name string) (*domain.Device, error) {
+	devices, err := usecase.deviceRepo.GetDevices(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to GetDevices: %w", err)
+	}
+
+	for _, device := range devices {
+		if device.Name == name {
+			return device, nil
+		}
+	}
+
+	return nil, fmt.Errorf("device %q: %w", name, domain.ErrNotFound)
+}
+
+// validateDeviceHost checks that the host of an HTTP lamp is a bare "host[:port]" without a scheme or a path
+// and that the host of an MQTT lamp is an id usable in the topics.
+func validateDeviceHost(protocol domain.DeviceProtocol, host string) error {
+	switch protocol {
+	case domain.HTTPProtocol:
+	case domain.MQTTProtocol:
+		if !mqttDeviceIDRegexp.MatchString(host) {
+			return fmt.Errorf("%w: %q must be up to 64 latin letters, digits, '_' or '-'", ErrInvalidDeviceHost, host)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: unsupported protocol %q", ErrInvalidDeviceHost, protocol)
+	}
+
+	parsed, err := url.Parse("http://" + host)
+	if err != nil || host == "" || parsed.Host != host {
+		return fmt.Errorf("%w: %q", ErrInvalidDeviceHost, host)
+	}
+
+	return nil
+}
+
+func (usecase *deviceUsecase) GetDeviceStatuses(
+	ctx context.Context,
+	deviceIDs []uuid.UUID,
+) (map[uuid.UUID]*domain.DeviceStatus, error) {
+	statuses, err := usecase.deviceStatusRepo.GetDeviceStatuses(ctx, deviceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to GetDeviceStatuses: %w", err)
+	}
+
+	deviceStatuses := make(map[uuid.UUID]*domain.DeviceStatus, len(statuses))
+	for _, status := range statuses {
+		deviceStatuses[status.DeviceID] = status
+	}
+
+	return deviceStatuses, nil
+}
@@ -0,0 +1,94 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	pg_mocks "github.com/almostinf/glow-reminder/internal/repository/pg/mocks"
+	"github.com/almostinf/glow-reminder/internal/usecase"
+	clock_mocks "github.com/almostinf/glow-reminder/pkg/clock/mocks"
+	logger_mocks "github.com/almostinf/glow-reminder/pkg/logger/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCheckDeviceOwner(t *testing.T) {
+	t.Parallel()
+
+	deviceID := uuid.MustParse("2c4e6a8b-1d3f-4b5a-9c7e-0f2a4b6c8d0e")
+	errStore := errors.New("store is unavailable")
+
+	testcases := []struct {
+		name        string
+		deviceID    uuid.NullUUID
+		mock        func(deviceRepo *pg_mocks.MockDeviceRepo)
+		expectedErr error
+	}{
+		{
+			name:     "accepts all the lamps of the user",
+			deviceID: uuid.NullUUID{},
+			mock:     func(*pg_mocks.MockDeviceRepo) {},
+		},
+		{
+			name:     "accepts a lamp of the user",
+			deviceID: uuid.NullUUID{UUID: deviceID, Valid: true},
+			mock: func(deviceRepo *pg_mocks.MockDeviceRepo) {
+				deviceRepo.EXPECT().GetDevice(gomock.Any(), deviceID).Return(&domain.Device{ID: deviceID, UserID: userID}, nil)
+			},
+		},
+		{
+			name:     "rejects a lamp of another user",
+			deviceID: uuid.NullUUID{UUID: deviceID, Valid: true},
+			mock: func(deviceRepo *pg_mocks.MockDeviceRepo) {
+				deviceRepo.EXPECT().GetDevice(gomock.Any(), deviceID).Return(&domain.Device{ID: deviceID, UserID: userID + 1}, nil)
+			},
+			expectedErr: usecase.ErrUnknownDevice,
+		},
+		{
+			name:     "rejects a removed lamp",
+			deviceID: uuid.NullUUID{UUID: deviceID, Valid: true},
+			mock: func(deviceRepo *pg_mocks.MockDeviceRepo) {
+				deviceRepo.EXPECT().GetDevice(gomock.Any(), deviceID).Return(nil, domain.ErrNotFound)
+			},
+			expectedErr: usecase.ErrUnknownDevice,
+		},
+		{
+			name:     "returns the error of the store",
+			deviceID: uuid.NullUUID{UUID: deviceID, Valid: true},
+			mock: func(deviceRepo *pg_mocks.MockDeviceRepo) {
+				deviceRepo.EXPECT().GetDevice(gomock.Any(), deviceID).Return(nil, errStore)
+			},
+			expectedErr: errStore,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			deviceRepo := pg_mocks.NewMockDeviceRepo(mockCtrl)
+			testcase.mock(deviceRepo)
+
+			deviceUsecase := usecase.NewDevice(
+				deviceRepo,
+				nil,
+				clock_mocks.NewMockClock(mockCtrl),
+				logger_mocks.NewMockLogger(mockCtrl),
+			)
+
+			err := deviceUsecase.CheckDeviceOwner(context.Background(), &domain.Reminder{
+				UserID:   userID,
+				DeviceID: testcase.deviceID,
+			})
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/device"
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/repository/pg"
+	"github.com/almostinf/glow-reminder/internal/repository/redis"
+	"github.com/almostinf/glow-reminder/pkg/clock"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// FiredReminderUsecase handles the owner reaction to a fired reminder.
+type FiredReminderUsecase interface {
+	// SnoozeReminder fires the reminder once again after the given duration.
+	SnoozeReminder(ctx context.Context, userID int64, id uuid.UUID, duration time.Duration) (time.Time, error)
+	// AcknowledgeReminder records that the owner has seen the reminder and then tries to turn the lamp off.
+	AcknowledgeReminder(ctx context.Context, userID int64, id uuid.UUID) error
+}
+
+type firedReminderUsecase struct {
+	firedReminderRepo redis.FiredReminderRepo
+	reminderTaskRepo  redis.ReminderTaskRepo
+	reminderAckRepo   pg.ReminderAckRepo
+	deliveryRepo      pg.DeliveryRepo
+	deviceRegistry    device.Registry
+	clock             clock.Clock
+	logger            logger.Logger
+}
+
+func NewFiredReminder(
+	firedReminderRepo redis.FiredReminderRepo,
+	reminderTaskRepo redis.ReminderTaskRepo,
+	reminderAckRepo pg.ReminderAckRepo,
+	deliveryRepo pg.DeliveryRepo,
+	deviceRegistry device.Registry,
+	clock clock.Clock,
+	logger logger.Logger,
+) *firedReminderUsecase {
+	return &firedReminderUsecase{
+		firedReminderRepo: firedReminderRepo,
+		reminderTaskRepo:  reminderTaskRepo,
+		reminderAckRepo:   reminderAckRepo,
+		deliveryRepo:      deliveryRepo,
+		deviceRegistry:    deviceRegistry,
+		clock:             clock,
+		logger:            logger,
+	}
+}
+
+func (usecase *firedReminderUsecase) SnoozeReminder(
+	ctx context.Context,
+	userID int64,
+	id uuid.UUID,
+	duration time.Duration,
+) (time.Time, error) {
+	if _, err := usecase.getFiredReminder(ctx, userID, id); err != nil {
+		return time.Time{}, err
+	}
+
+	scheduledAt := usecase.clock.NowUTC().Add(duration)
+
+	// The snoozed task goes to the queue directly: it is not a change of the reminder itself.
+	if err := usecase.reminderTaskRepo.AddReminderTask(ctx, &domain.ReminderTask{
+		ID:          id,
+		ScheduledAt: scheduledAt,
+		Snoozed:     true,
+	}); err != nil {
+		return time.Time{}, fmt.Errorf("failed to AddReminderTask: %w", err)
+	}
+
+	return scheduledAt, nil
+}
+
+func (usecase *firedReminderUsecase) AcknowledgeReminder(ctx context.Context, userID int64, id uuid.UUID) error {
+	reminder, err := usecase.getFiredReminder(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	now := usecase.clock.NowUTC()
+
+	if err = usecase.reminderAckRepo.CreateAck(ctx, domain.ReminderAck{
+		ReminderID:  reminder.ID,
+		UserID:      userID,
+		ScheduledAt: reminder.ScheduledAt,
+		AckedAt:     now,
+	}); err != nil {
+		return fmt.Errorf("failed to CreateAck: %w", err)
+	}
+
+	if err = usecase.deliveryRepo.CompleteDeliveries(ctx, reminder.ID, now); err != nil {
+		return fmt.Errorf("failed to CompleteDeliveries: %w", err)
+	}
+
+	// The buttons of an acknowledged reminder stop working.
+	if err = usecase.firedReminderRepo.DeleteFiredReminder(ctx, id); err != nil {
+		return fmt.Errorf("failed to DeleteFiredReminder: %w", err)
+	}
+
+	// A lamp that does not respond must not keep the reminder unacknowledged.
+	if reminder.Delivery.HasLight() {
+		if err = usecase.stopGlow(ctx, reminder); err != nil {
+			usecase.logger.Error("failed to stop glow of acknowledged reminder", map[string]interface{}{
+				"reminder_id": reminder.ID.String(),
+				"err":         err.Error(),
+			})
+		}
+	}
+
+	return nil
+}
+
+// getFiredReminder returns ErrNotFound for a reminder of another user too.
+func (usecase *firedReminderUsecase) getFiredReminder(
+	ctx context.Context,
+	userID int64,
+	id uuid.UUID,
+) (*domain.Reminder, error) {
+	reminder, err := usecase.firedReminderRepo.GetFiredReminder(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to GetFiredReminder: %w", err)
+	}
+
+	if reminder.UserID != userID {
+		return nil, fmt.Errorf("failed to GetFiredReminder: %w", domain.ErrNotFound)
+	}
+
+	return reminder, nil
+}
+
+// stopGlow turns off all the lamps lit by the reminder.
+func (usecase *firedReminderUsecase) stopGlow(ctx context.Context, reminder *domain.Reminder) error {
+	devices, err := usecase.deviceRegistry.ReminderDevices(ctx, reminder)
+	if err != nil {
+		return fmt.Errorf("failed to get ReminderDevices: %w", err)
+	}
+
+	var errs []error
+	for _, device := range devices {
+		if err = usecase.deviceRegistry.StopGlow(device); err != nil {
+			errs = append(errs, fmt.Errorf("device %q: %w", device.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
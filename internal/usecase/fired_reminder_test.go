@@ -0,0 +1,111 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	device_mocks "github.com/almostinf/glow-reminder/internal/device/mocks"
+	"github.com/almostinf/glow-reminder/internal/domain"
+	pg_mocks "github.com/almostinf/glow-reminder/internal/repository/pg/mocks"
+	redis_mocks "github.com/almostinf/glow-reminder/internal/repository/redis/mocks"
+	"github.com/almostinf/glow-reminder/internal/usecase"
+	clock_mocks "github.com/almostinf/glow-reminder/pkg/clock/mocks"
+	logger_mocks "github.com/almostinf/glow-reminder/pkg/logger/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAcknowledgeReminder(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("5a7c9e1b-3d4f-4a6b-8c2d-1e0f9a8b7c6d")
+	now := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+	scheduledAt := now.Add(-time.Minute)
+	lamp := &domain.Device{ID: uuid.MustParse("0b1c2d3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e"), Name: "desk"}
+
+	testcases := []struct {
+		name        string
+		reminder    *domain.Reminder
+		stopGlowErr error
+		acked       bool
+		expectedErr error
+	}{
+		{
+			name:     "acknowledges a reminder and turns the lamp off",
+			reminder: &domain.Reminder{ID: id, UserID: userID, ScheduledAt: scheduledAt, Delivery: domain.LightOnly},
+			acked:    true,
+		},
+		{
+			name:        "acknowledges a reminder when the lamp is not turned off",
+			reminder:    &domain.Reminder{ID: id, UserID: userID, ScheduledAt: scheduledAt, Delivery: domain.LightOnly},
+			stopGlowErr: errors.New("lamp is offline"),
+			acked:       true,
+		},
+		{
+			name:     "acknowledges a message reminder without the lamp",
+			reminder: &domain.Reminder{ID: id, UserID: userID, ScheduledAt: scheduledAt, Delivery: domain.MessageOnly},
+			acked:    true,
+		},
+		{
+			name:        "hides a reminder of another user",
+			reminder:    &domain.Reminder{ID: id, UserID: userID + 1, Delivery: domain.LightOnly},
+			expectedErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			firedReminderRepo := redis_mocks.NewMockFiredReminderRepo(mockCtrl)
+			reminderAckRepo := pg_mocks.NewMockReminderAckRepo(mockCtrl)
+			deliveryRepo := pg_mocks.NewMockDeliveryRepo(mockCtrl)
+			deviceRegistry := device_mocks.NewMockRegistry(mockCtrl)
+			clk := clock_mocks.NewMockClock(mockCtrl)
+			log := logger_mocks.NewMockLogger(mockCtrl)
+
+			firedReminderRepo.EXPECT().GetFiredReminder(gomock.Any(), id).Return(testcase.reminder, nil)
+			if testcase.acked {
+				clk.EXPECT().NowUTC().Return(now)
+				ack := reminderAckRepo.EXPECT().CreateAck(gomock.Any(), domain.ReminderAck{
+					ReminderID:  id,
+					UserID:      userID,
+					ScheduledAt: scheduledAt,
+					AckedAt:     now,
+				}).Return(nil)
+				complete := deliveryRepo.EXPECT().CompleteDeliveries(gomock.Any(), id, now).Return(nil).After(ack)
+				deleted := firedReminderRepo.EXPECT().DeleteFiredReminder(gomock.Any(), id).Return(nil).After(complete)
+
+				if testcase.reminder.Delivery.HasLight() {
+					deviceRegistry.EXPECT().ReminderDevices(gomock.Any(), testcase.reminder).
+						Return([]*domain.Device{lamp}, nil).After(deleted)
+					deviceRegistry.EXPECT().StopGlow(lamp).Return(testcase.stopGlowErr)
+				}
+				if testcase.stopGlowErr != nil {
+					log.EXPECT().Error(gomock.Any(), gomock.Any())
+				}
+			}
+
+			firedReminderUsecase := usecase.NewFiredReminder(
+				firedReminderRepo,
+				redis_mocks.NewMockReminderTaskRepo(mockCtrl),
+				reminderAckRepo,
+				deliveryRepo,
+				deviceRegistry,
+				clk,
+				log,
+			)
+
+			err := firedReminderUsecase.AcknowledgeReminder(context.Background(), userID, id)
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
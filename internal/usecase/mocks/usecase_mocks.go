@@ -0,0 +1,403 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/almostinf/glow-reminder/internal/usecase (interfaces: ReminderUsecase,DeviceUsecase,TokenUsecase,DeliveryUsecase)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/usecase_mocks.go github.com/almostinf/glow-reminder/internal/usecase ReminderUsecase,DeviceUsecase,TokenUsecase,DeliveryUsecase
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/almostinf/glow-reminder/internal/domain"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReminderUsecase is a mock of ReminderUsecase interface.
+type MockReminderUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderUsecaseMockRecorder
+}
+
+// MockReminderUsecaseMockRecorder is the mock recorder for MockReminderUsecase.
+type MockReminderUsecaseMockRecorder struct {
+	mock *MockReminderUsecase
+}
+
+// NewMockReminderUsecase creates a new mock instance.
+func NewMockReminderUsecase(ctrl *gomock.Controller) *MockReminderUsecase {
+	mock := &MockReminderUsecase{ctrl: ctrl}
+	mock.recorder = &MockReminderUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderUsecase) EXPECT() *MockReminderUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateReminder mocks base method.
+func (m *MockReminderUsecase) CreateReminder(arg0 context.Context, arg1 domain.Reminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReminder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReminder indicates an expected call of CreateReminder.
+func (mr *MockReminderUsecaseMockRecorder) CreateReminder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReminder", reflect.TypeOf((*MockReminderUsecase)(nil).CreateReminder), arg0, arg1)
+}
+
+// DeleteReminder mocks base method.
+func (m *MockReminderUsecase) DeleteReminder(arg0 context.Context, arg1 int64, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReminder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReminder indicates an expected call of DeleteReminder.
+func (mr *MockReminderUsecaseMockRecorder) DeleteReminder(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReminder", reflect.TypeOf((*MockReminderUsecase)(nil).DeleteReminder), arg0, arg1, arg2)
+}
+
+// GetOwnReminder mocks base method.
+func (m *MockReminderUsecase) GetOwnReminder(arg0 context.Context, arg1 int64, arg2 uuid.UUID) (*domain.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnReminder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnReminder indicates an expected call of GetOwnReminder.
+func (mr *MockReminderUsecaseMockRecorder) GetOwnReminder(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnReminder", reflect.TypeOf((*MockReminderUsecase)(nil).GetOwnReminder), arg0, arg1, arg2)
+}
+
+// GetReminder mocks base method.
+func (m *MockReminderUsecase) GetReminder(arg0 context.Context, arg1 uuid.UUID) (*domain.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReminder", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReminder indicates an expected call of GetReminder.
+func (mr *MockReminderUsecaseMockRecorder) GetReminder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminder", reflect.TypeOf((*MockReminderUsecase)(nil).GetReminder), arg0, arg1)
+}
+
+// GetReminders mocks base method.
+func (m *MockReminderUsecase) GetReminders(arg0 context.Context, arg1 domain.GetRemindersParams) ([]*domain.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReminders", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReminders indicates an expected call of GetReminders.
+func (mr *MockReminderUsecaseMockRecorder) GetReminders(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminders", reflect.TypeOf((*MockReminderUsecase)(nil).GetReminders), arg0, arg1)
+}
+
+// NewDefaultReminder mocks base method.
+func (m *MockReminderUsecase) NewDefaultReminder(arg0 int64) domain.Reminder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDefaultReminder", arg0)
+	ret0, _ := ret[0].(domain.Reminder)
+	return ret0
+}
+
+// NewDefaultReminder indicates an expected call of NewDefaultReminder.
+func (mr *MockReminderUsecaseMockRecorder) NewDefaultReminder(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDefaultReminder", reflect.TypeOf((*MockReminderUsecase)(nil).NewDefaultReminder), arg0)
+}
+
+// UpdateReminder mocks base method.
+func (m *MockReminderUsecase) UpdateReminder(arg0 context.Context, arg1 domain.Reminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReminder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReminder indicates an expected call of UpdateReminder.
+func (mr *MockReminderUsecaseMockRecorder) UpdateReminder(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReminder", reflect.TypeOf((*MockReminderUsecase)(nil).UpdateReminder), arg0, arg1)
+}
+
+// MockDeviceUsecase is a mock of DeviceUsecase interface.
+type MockDeviceUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeviceUsecaseMockRecorder
+}
+
+// MockDeviceUsecaseMockRecorder is the mock recorder for MockDeviceUsecase.
+type MockDeviceUsecaseMockRecorder struct {
+	mock *MockDeviceUsecase
+}
+
+// NewMockDeviceUsecase creates a new mock instance.
+func NewMockDeviceUsecase(ctrl *gomock.Controller) *MockDeviceUsecase {
+	mock := &MockDeviceUsecase{ctrl: ctrl}
+	mock.recorder = &MockDeviceUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeviceUsecase) EXPECT() *MockDeviceUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CheckDeviceOwner mocks base method.
+func (m *MockDeviceUsecase) CheckDeviceOwner(arg0 context.Context, arg1 *domain.Reminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDeviceOwner", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckDeviceOwner indicates an expected call of CheckDeviceOwner.
+func (mr *MockDeviceUsecaseMockRecorder) CheckDeviceOwner(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDeviceOwner", reflect.TypeOf((*MockDeviceUsecase)(nil).CheckDeviceOwner), arg0, arg1)
+}
+
+// CreateDevice mocks base method.
+func (m *MockDeviceUsecase) CreateDevice(arg0 context.Context, arg1 domain.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDevice", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDevice indicates an expected call of CreateDevice.
+func (mr *MockDeviceUsecaseMockRecorder) CreateDevice(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDevice", reflect.TypeOf((*MockDeviceUsecase)(nil).CreateDevice), arg0, arg1)
+}
+
+// DeleteDevice mocks base method.
+func (m *MockDeviceUsecase) DeleteDevice(arg0 context.Context, arg1 int64, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDevice", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDevice indicates an expected call of DeleteDevice.
+func (mr *MockDeviceUsecaseMockRecorder) DeleteDevice(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevice", reflect.TypeOf((*MockDeviceUsecase)(nil).DeleteDevice), arg0, arg1, arg2)
+}
+
+// GetDevice mocks base method.
+func (m *MockDeviceUsecase) GetDevice(arg0 context.Context, arg1 uuid.UUID) (*domain.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevice", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevice indicates an expected call of GetDevice.
+func (mr *MockDeviceUsecaseMockRecorder) GetDevice(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevice", reflect.TypeOf((*MockDeviceUsecase)(nil).GetDevice), arg0, arg1)
+}
+
+// GetDeviceStatuses mocks base method.
+func (m *MockDeviceUsecase) GetDeviceStatuses(arg0 context.Context, arg1 []uuid.UUID) (map[uuid.UUID]*domain.DeviceStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceStatuses", arg0, arg1)
+	ret0, _ := ret[0].(map[uuid.UUID]*domain.DeviceStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceStatuses indicates an expected call of GetDeviceStatuses.
+func (mr *MockDeviceUsecaseMockRecorder) GetDeviceStatuses(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceStatuses", reflect.TypeOf((*MockDeviceUsecase)(nil).GetDeviceStatuses), arg0, arg1)
+}
+
+// GetDevices mocks base method.
+func (m *MockDeviceUsecase) GetDevices(arg0 context.Context, arg1 int64) ([]*domain.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevices", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevices indicates an expected call of GetDevices.
+func (mr *MockDeviceUsecaseMockRecorder) GetDevices(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevices", reflect.TypeOf((*MockDeviceUsecase)(nil).GetDevices), arg0, arg1)
+}
+
+// RenameDevice mocks base method.
+func (m *MockDeviceUsecase) RenameDevice(arg0 context.Context, arg1 int64, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameDevice", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameDevice indicates an expected call of RenameDevice.
+func (mr *MockDeviceUsecaseMockRecorder) RenameDevice(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameDevice", reflect.TypeOf((*MockDeviceUsecase)(nil).RenameDevice), arg0, arg1, arg2, arg3)
+}
+
+// MockTokenUsecase is a mock of TokenUsecase interface.
+type MockTokenUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenUsecaseMockRecorder
+}
+
+// MockTokenUsecaseMockRecorder is the mock recorder for MockTokenUsecase.
+type MockTokenUsecaseMockRecorder struct {
+	mock *MockTokenUsecase
+}
+
+// NewMockTokenUsecase creates a new mock instance.
+func NewMockTokenUsecase(ctrl *gomock.Controller) *MockTokenUsecase {
+	mock := &MockTokenUsecase{ctrl: ctrl}
+	mock.recorder = &MockTokenUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenUsecase) EXPECT() *MockTokenUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockTokenUsecase) Authenticate(arg0 context.Context, arg1 string) (*domain.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockTokenUsecaseMockRecorder) Authenticate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockTokenUsecase)(nil).Authenticate), arg0, arg1)
+}
+
+// GetTokens mocks base method.
+func (m *MockTokenUsecase) GetTokens(arg0 context.Context, arg1 int64) ([]*domain.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokens", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokens indicates an expected call of GetTokens.
+func (mr *MockTokenUsecaseMockRecorder) GetTokens(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokens", reflect.TypeOf((*MockTokenUsecase)(nil).GetTokens), arg0, arg1)
+}
+
+// IssueToken mocks base method.
+func (m *MockTokenUsecase) IssueToken(arg0 context.Context, arg1 int64, arg2 string, arg3 domain.TokenScopes, arg4 time.Duration) (*domain.Token, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueToken", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*domain.Token)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// IssueToken indicates an expected call of IssueToken.
+func (mr *MockTokenUsecaseMockRecorder) IssueToken(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueToken", reflect.TypeOf((*MockTokenUsecase)(nil).IssueToken), arg0, arg1, arg2, arg3, arg4)
+}
+
+// RevokeToken mocks base method.
+func (m *MockTokenUsecase) RevokeToken(arg0 context.Context, arg1 int64, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockTokenUsecaseMockRecorder) RevokeToken(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockTokenUsecase)(nil).RevokeToken), arg0, arg1, arg2)
+}
+
+// MockDeliveryUsecase is a mock of DeliveryUsecase interface.
+type MockDeliveryUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeliveryUsecaseMockRecorder
+}
+
+// MockDeliveryUsecaseMockRecorder is the mock recorder for MockDeliveryUsecase.
+type MockDeliveryUsecaseMockRecorder struct {
+	mock *MockDeliveryUsecase
+}
+
+// NewMockDeliveryUsecase creates a new mock instance.
+func NewMockDeliveryUsecase(ctrl *gomock.Controller) *MockDeliveryUsecase {
+	mock := &MockDeliveryUsecase{ctrl: ctrl}
+	mock.recorder = &MockDeliveryUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeliveryUsecase) EXPECT() *MockDeliveryUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AcknowledgeDelivery mocks base method.
+func (m *MockDeliveryUsecase) AcknowledgeDelivery(arg0 context.Context, arg1 uuid.UUID, arg2 bool, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcknowledgeDelivery", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcknowledgeDelivery indicates an expected call of AcknowledgeDelivery.
+func (mr *MockDeliveryUsecaseMockRecorder) AcknowledgeDelivery(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcknowledgeDelivery", reflect.TypeOf((*MockDeliveryUsecase)(nil).AcknowledgeDelivery), arg0, arg1, arg2, arg3)
+}
+
+// GetLastDeliveries mocks base method.
+func (m *MockDeliveryUsecase) GetLastDeliveries(arg0 context.Context, arg1 []uuid.UUID) (map[uuid.UUID][]*domain.LampDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastDeliveries", arg0, arg1)
+	ret0, _ := ret[0].(map[uuid.UUID][]*domain.LampDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastDeliveries indicates an expected call of GetLastDeliveries.
+func (mr *MockDeliveryUsecaseMockRecorder) GetLastDeliveries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastDeliveries", reflect.TypeOf((*MockDeliveryUsecase)(nil).GetLastDeliveries), arg0, arg1)
+}
@@ -3,31 +3,72 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/almostinf/glow-reminder/internal/domain"
 	"github.com/almostinf/glow-reminder/internal/repository/pg"
-	"github.com/almostinf/glow-reminder/internal/repository/redis"
+	"github.com/almostinf/glow-reminder/pkg/clock"
 	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
 	"github.com/google/uuid"
 )
 
+//go:generate mockgen -package mocks -destination mocks/usecase_mocks.go github.com/almostinf/glow-reminder/internal/usecase ReminderUsecase,DeviceUsecase,TokenUsecase,DeliveryUsecase
+
 type ReminderUsecase interface {
+	// NewDefaultReminder returns a new reminder of the user with the defaults offered by the bot:
+	// a static red glow at full brightness on all the lamps of the user.
+	NewDefaultReminder(userID int64) domain.Reminder
 	GetReminders(ctx context.Context, params domain.GetRemindersParams) ([]*domain.Reminder, error)
+	GetReminder(ctx context.Context, id uuid.UUID) (*domain.Reminder, error)
+	// GetOwnReminder returns domain.ErrNotFound if the reminder does not exist or belongs to another user.
+	GetOwnReminder(ctx context.Context, userID int64, id uuid.UUID) (*domain.Reminder, error)
 	CreateReminder(ctx context.Context, reminder domain.Reminder) error
-	DeleteReminder(ctx context.Context, id uuid.UUID) error
+	UpdateReminder(ctx context.Context, reminder domain.Reminder) error
+	// DeleteReminder returns domain.ErrNotFound if the reminder does not exist or belongs to another user.
+	DeleteReminder(ctx context.Context, userID int64, id uuid.UUID) error
 }
 
+// reminderUsecase writes reminders to Postgres only. Every change is accompanied by an outbox event
+// in the same transaction, and the outbox relay projects the events into the reminder tasks queue.
 type reminderUsecase struct {
-	reminderRepo     pg.ReminderRepo
-	reminderTaskRepo redis.ReminderTaskRepo
-	logger           logger.Logger
+	reminderRepo pg.ReminderRepo
+	outboxRepo   pg.OutboxRepo
+	trManager    trm.Manager
+	clock        clock.Clock
+	logger       logger.Logger
 }
 
-func NewReminder(reminderRepo pg.ReminderRepo, reminderTaskRepo redis.ReminderTaskRepo, logger logger.Logger) *reminderUsecase {
+func NewReminder(
+	reminderRepo pg.ReminderRepo,
+	outboxRepo pg.OutboxRepo,
+	trManager trm.Manager,
+	clock clock.Clock,
+	logger logger.Logger,
+) *reminderUsecase {
 	return &reminderUsecase{
-		reminderRepo:     reminderRepo,
-		reminderTaskRepo: reminderTaskRepo,
-		logger:           logger,
+		reminderRepo: reminderRepo,
+		outboxRepo:   outboxRepo,
+		trManager:    trManager,
+		clock:        clock,
+		logger:       logger,
+	}
+}
+
+func (usecase *reminderUsecase) NewDefaultReminder(userID int64) domain.Reminder {
+	now := usecase.clock.NowUTC()
+
+	return domain.Reminder{
+		ID:         uuid.New(),
+		UserID:     userID,
+		Colour:     domain.Red,
+		RGB:        domain.RedRGB,
+		Brightness: domain.MaxBrightness,
+		Mode:       domain.Static,
+		Effect:     domain.DefaultEffect(domain.StaticEffect),
+		Delivery:   domain.LightOnly,
+		CreatedAt:  now,
+		UpdatedAt:  now,
 	}
 }
 
@@ -35,17 +76,104 @@ func (usecase *reminderUsecase) GetReminders(ctx context.Context, params domain.
 	return usecase.reminderRepo.GetReminders(ctx, params)
 }
 
+func (usecase *reminderUsecase) GetReminder(ctx context.Context, id uuid.UUID) (*domain.Reminder, error) {
+	return usecase.reminderRepo.GetReminder(ctx, id)
+}
+
+func (usecase *reminderUsecase) GetOwnReminder(ctx context.Context, userID int64, id uuid.UUID) (*domain.Reminder, error) {
+	reminder, err := usecase.reminderRepo.GetReminder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if reminder.UserID != userID {
+		return nil, fmt.Errorf("reminder %v of another user: %w", id, domain.ErrNotFound)
+	}
+
+	return reminder, nil
+}
+
 func (usecase *reminderUsecase) CreateReminder(ctx context.Context, reminder domain.Reminder) error {
-	if err := usecase.reminderTaskRepo.AddReminderTask(ctx, &domain.ReminderTask{
-		ID:          reminder.ID,
-		ScheduledAt: reminder.ScheduledAt,
-	}); err != nil {
-		return fmt.Errorf("failed to AddReminderTask: %w", err)
+	if err := applyEffect(&reminder); err != nil {
+		return err
+	}
+
+	return usecase.trManager.Do(ctx, func(ctx context.Context) error {
+		if err := usecase.reminderRepo.CreateReminder(ctx, reminder); err != nil {
+			return fmt.Errorf("failed to CreateReminder: %w", err)
+		}
+
+		return usecase.createOutboxEvent(ctx, reminder.ID, domain.UpsertReminderTask, reminder.ScheduledAt)
+	})
+}
+
+// UpdateReminder updates the reminder and moves its task in the queue if the reminder was rescheduled.
+func (usecase *reminderUsecase) UpdateReminder(ctx context.Context, reminder domain.Reminder) error {
+	if err := applyEffect(&reminder); err != nil {
+		return err
+	}
+
+	return usecase.trManager.Do(ctx, func(ctx context.Context) error {
+		current, err := usecase.reminderRepo.GetReminder(ctx, reminder.ID)
+		if err != nil {
+			return fmt.Errorf("failed to GetReminder: %w", err)
+		}
+
+		if err = usecase.reminderRepo.UpdateReminder(ctx, reminder); err != nil {
+			return fmt.Errorf("failed to UpdateReminder: %w", err)
+		}
+
+		if reminder.ScheduledAt.IsZero() || reminder.ScheduledAt.Equal(current.ScheduledAt) {
+			return nil
+		}
+
+		return usecase.createOutboxEvent(ctx, reminder.ID, domain.UpsertReminderTask, reminder.ScheduledAt)
+	})
+}
+
+func (usecase *reminderUsecase) DeleteReminder(ctx context.Context, userID int64, id uuid.UUID) error {
+	return usecase.trManager.Do(ctx, func(ctx context.Context) error {
+		if _, err := usecase.GetOwnReminder(ctx, userID, id); err != nil {
+			return fmt.Errorf("failed to GetOwnReminder: %w", err)
+		}
+
+		if err := usecase.reminderRepo.DeleteReminder(ctx, id); err != nil {
+			return fmt.Errorf("failed to DeleteReminder: %w", err)
+		}
+
+		return usecase.createOutboxEvent(ctx, id, domain.DeleteReminderTask, usecase.clock.NowUTC())
+	})
+}
+
+// applyEffect validates the effect of the reminder and derives the legacy mode from it.
+func applyEffect(reminder *domain.Reminder) error {
+	if reminder.Effect.Kind == "" {
+		return nil
+	}
+
+	if err := reminder.Effect.Validate(); err != nil {
+		return fmt.Errorf("failed to validate effect: %w", err)
 	}
 
-	return usecase.reminderRepo.CreateReminder(ctx, reminder)
+	reminder.Mode = reminder.Effect.LegacyMode()
+
+	return nil
 }
 
-func (usecase *reminderUsecase) DeleteReminder(ctx context.Context, id uuid.UUID) error {
-	return usecase.reminderRepo.DeleteReminder(ctx, id)
+func (usecase *reminderUsecase) createOutboxEvent(
+	ctx context.Context,
+	reminderID uuid.UUID,
+	operation domain.OutboxOperation,
+	scheduledAt time.Time,
+) error {
+	if err := usecase.outboxRepo.CreateEvent(ctx, domain.OutboxEvent{
+		ReminderID:  reminderID,
+		Operation:   operation,
+		ScheduledAt: scheduledAt,
+		CreatedAt:   usecase.clock.NowUTC(),
+	}); err != nil {
+		return fmt.Errorf("failed to CreateEvent: %w", err)
+	}
+
+	return nil
 }
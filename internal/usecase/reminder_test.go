@@ -0,0 +1,192 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	pg_mocks "github.com/almostinf/glow-reminder/internal/repository/pg/mocks"
+	"github.com/almostinf/glow-reminder/internal/usecase"
+	clock_mocks "github.com/almostinf/glow-reminder/pkg/clock/mocks"
+	logger_mocks "github.com/almostinf/glow-reminder/pkg/logger/mocks"
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const userID = int64(42)
+
+// trManager runs the transactions of the usecases without a database.
+type trManager struct{}
+
+func (trManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (trManager) DoWithSettings(ctx context.Context, _ trm.Settings, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestNewDefaultReminder(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	mockCtrl := gomock.NewController(t)
+
+	clk := clock_mocks.NewMockClock(mockCtrl)
+	clk.EXPECT().NowUTC().Return(now)
+
+	reminderUsecase := usecase.NewReminder(
+		pg_mocks.NewMockReminderRepo(mockCtrl),
+		pg_mocks.NewMockOutboxRepo(mockCtrl),
+		nil,
+		clk,
+		logger_mocks.NewMockLogger(mockCtrl),
+	)
+
+	reminder := reminderUsecase.NewDefaultReminder(userID)
+
+	assert.NotEqual(t, uuid.Nil, reminder.ID)
+	assert.Equal(t, domain.Reminder{
+		ID:         reminder.ID,
+		UserID:     userID,
+		Colour:     domain.Red,
+		RGB:        domain.RedRGB,
+		Brightness: domain.MaxBrightness,
+		Mode:       domain.Static,
+		Effect:     domain.DefaultEffect(domain.StaticEffect),
+		Delivery:   domain.LightOnly,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, reminder)
+}
+
+func TestGetOwnReminder(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("5a7c9e1b-3d4f-4a6b-8c2d-1e0f9a8b7c6d")
+
+	testcases := []struct {
+		name        string
+		reminder    *domain.Reminder
+		getErr      error
+		expectedErr error
+	}{
+		{
+			name:     "returns a reminder of the user",
+			reminder: &domain.Reminder{ID: id, UserID: userID},
+		},
+		{
+			name:        "hides a reminder of another user",
+			reminder:    &domain.Reminder{ID: id, UserID: userID + 1},
+			expectedErr: domain.ErrNotFound,
+		},
+		{
+			name:        "returns a missing reminder as not found",
+			getErr:      domain.ErrNotFound,
+			expectedErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			reminderRepo := pg_mocks.NewMockReminderRepo(mockCtrl)
+			reminderRepo.EXPECT().GetReminder(gomock.Any(), id).Return(testcase.reminder, testcase.getErr)
+
+			reminderUsecase := usecase.NewReminder(
+				reminderRepo,
+				pg_mocks.NewMockOutboxRepo(mockCtrl),
+				nil,
+				clock_mocks.NewMockClock(mockCtrl),
+				logger_mocks.NewMockLogger(mockCtrl),
+			)
+
+			reminder, err := reminderUsecase.GetOwnReminder(context.Background(), userID, id)
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+			if testcase.expectedErr == nil {
+				assert.Equal(t, testcase.reminder, reminder)
+			} else {
+				assert.Nil(t, reminder)
+			}
+		})
+	}
+}
+
+func TestDeleteReminder(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("5a7c9e1b-3d4f-4a6b-8c2d-1e0f9a8b7c6d")
+	now := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name        string
+		reminder    *domain.Reminder
+		getErr      error
+		deleted     bool
+		expectedErr error
+	}{
+		{
+			name:     "deletes a reminder of the user",
+			reminder: &domain.Reminder{ID: id, UserID: userID},
+			deleted:  true,
+		},
+		{
+			name:        "keeps a reminder of another user",
+			reminder:    &domain.Reminder{ID: id, UserID: userID + 1},
+			expectedErr: domain.ErrNotFound,
+		},
+		{
+			name:        "returns a missing reminder as not found",
+			getErr:      domain.ErrNotFound,
+			expectedErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			reminderRepo := pg_mocks.NewMockReminderRepo(mockCtrl)
+			outboxRepo := pg_mocks.NewMockOutboxRepo(mockCtrl)
+			clk := clock_mocks.NewMockClock(mockCtrl)
+
+			reminderRepo.EXPECT().GetReminder(gomock.Any(), id).Return(testcase.reminder, testcase.getErr)
+			if testcase.deleted {
+				clk.EXPECT().NowUTC().Return(now).AnyTimes()
+				reminderRepo.EXPECT().DeleteReminder(gomock.Any(), id).Return(nil)
+				outboxRepo.EXPECT().CreateEvent(gomock.Any(), domain.OutboxEvent{
+					ReminderID:  id,
+					Operation:   domain.DeleteReminderTask,
+					ScheduledAt: now,
+					CreatedAt:   now,
+				}).Return(nil)
+			}
+
+			reminderUsecase := usecase.NewReminder(
+				reminderRepo,
+				outboxRepo,
+				trManager{},
+				clk,
+				logger_mocks.NewMockLogger(mockCtrl),
+			)
+
+			err := reminderUsecase.DeleteReminder(context.Background(), userID, id)
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/repository/pg"
+	"github.com/almostinf/glow-reminder/pkg/clock"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultTokenTTL = 90 * 24 * time.Hour
+	MaxTokenTTL     = 365 * 24 * time.Hour
+
+	// tokenBytes is the number of random bytes of a token.
+	tokenBytes = 32
+)
+
+var ErrInvalidTokenTTL = errors.New("invalid token ttl")
+
+// TokenUsecase manages the personal access tokens of the REST API. The bot refers to the tokens by their names.
+type TokenUsecase interface {
+	// IssueToken creates a token and returns it along with its secret, which is not stored anywhere.
+	IssueToken(ctx context.Context, userID int64, name string, scopes domain.TokenScopes, ttl time.Duration) (*domain.Token, string, error)
+	GetTokens(ctx context.Context, userID int64) ([]*domain.Token, error)
+	RevokeToken(ctx context.Context, userID int64, name string) error
+	// Authenticate returns the token with the given secret or domain.ErrInvalidToken
+	// if it does not exist, is revoked or has expired.
+	Authenticate(ctx context.Context, secret string) (*domain.Token, error)
+}
+
+type tokenUsecase struct {
+	tokenRepo pg.TokenRepo
+	clock     clock.Clock
+	logger    logger.Logger
+}
+
+func NewToken(tokenRepo pg.TokenRepo, clock clock.Clock, logger logger.Logger) *tokenUsecase {
+	return &tokenUsecase{
+		tokenRepo: tokenRepo,
+		clock:     clock,
+		logger:    logger,
+	}
+}
+
+func (usecase *tokenUsecase) IssueToken(
+	ctx context.Context,
+	userID int64,
+	name string,
+	scopes domain.TokenScopes,
+	ttl time.Duration,
+) (*domain.Token, string, error) {
+	if err := domain.ValidateTokenName(name); err != nil {
+		return nil, "", err
+	}
+
+	if scopes == 0 || !domain.AllScopes.Has(scopes) {
+		return nil, "", fmt.Errorf("%w: %d", domain.ErrInvalidTokenScopes, scopes)
+	}
+
+	if ttl <= 0 || ttl > MaxTokenTTL {
+		return nil, "", fmt.Errorf("%w: %s, it must be up to %d days", ErrInvalidTokenTTL, ttl, MaxTokenTTL/(24*time.Hour))
+	}
+
+	secret, err := generateTokenSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := usecase.clock.NowUTC()
+
+	token := domain.Token{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		Hash:      hashTokenSecret(secret),
+		Scopes:    scopes,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}
+
+	if err = usecase.tokenRepo.CreateToken(ctx, token); err != nil {
+		return nil, "", fmt.Errorf("failed to CreateToken: %w", err)
+	}
+
+	usecase.logger.Info("Issued token", map[string]interface{}{
+		"user_id": userID,
+		"name":    name,
+		"scopes":  scopes.String(),
+	})
+
+	return &token, secret, nil
+}
+
+func (usecase *tokenUsecase) GetTokens(ctx context.Context, userID int64) ([]*domain.Token, error) {
+	return usecase.tokenRepo.GetTokens(ctx, userID)
+}
+
+func (usecase *tokenUsecase) RevokeToken(ctx context.Context, userID int64, name string) error {
+	tokens, err := usecase.tokenRepo.GetTokens(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to GetTokens: %w", err)
+	}
+
+	for _, token := range tokens {
+		if token.Name != name {
+			continue
+		}
+
+		if err = usecase.tokenRepo.RevokeToken(ctx, token.ID, usecase.clock.NowUTC()); err != nil {
+			return fmt.Errorf("failed to RevokeToken: %w", err)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("token %q: %w", name, domain.ErrNotFound)
+}
+
+func (usecase *tokenUsecase) Authenticate(ctx context.Context, secret string) (*domain.Token, error) {
+	if !strings.HasPrefix(secret, domain.TokenPrefix) {
+		return nil, domain.ErrInvalidToken
+	}
+
+	token, err := usecase.tokenRepo.GetTokenByHash(ctx, hashTokenSecret(secret))
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, domain.ErrInvalidToken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to GetTokenByHash: %w", err)
+	}
+
+	if !token.Active(usecase.clock.NowUTC()) {
+		return nil, domain.ErrInvalidToken
+	}
+
+	return token, nil
+}
+
+func generateTokenSecret() (string, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	return domain.TokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashTokenSecret hashes the token with SHA-256. The tokens are random, so a salt or a slow hash is not needed.
+func hashTokenSecret(secret string) []byte {
+	hash := sha256.Sum256([]byte(secret))
+	return hash[:]
+}
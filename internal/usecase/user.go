@@ -0,0 +1,297 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/almostinf/glow-reminder/internal/domain"
+	"github.com/almostinf/glow-reminder/internal/repository/pg"
+	"github.com/almostinf/glow-reminder/pkg/clock"
+	"github.com/almostinf/glow-reminder/pkg/logger"
+	"github.com/almostinf/glow-reminder/pkg/tzfinder"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidTimeZone = errors.New("invalid time zone")
+	ErrInvalidDND      = errors.New("invalid do not disturb duration")
+)
+
+type UserUsecase interface {
+	GetUser(ctx context.Context, id int64) (*domain.User, error)
+	SetTimeZone(ctx context.Context, id int64, timeZone string) error
+	SetTimeZoneByCoordinates(ctx context.Context, id int64, lat, lng float64) (string, error)
+	// SetQuietHours sets the daily quiet hours in the "HH:MM-HH:MM" format, an empty window turns them off.
+	SetQuietHours(ctx context.Context, id int64, quietHours string) error
+	SetQuietPolicy(ctx context.Context, id int64, policy domain.QuietPolicy) error
+	// SetDND keeps the lamps quiet for the duration, a zero duration turns do not disturb off.
+	SetDND(ctx context.Context, id int64, duration time.Duration) (*domain.User, error)
+	// GetUserSettings returns the settings of the user or empty ones if the user has not set them.
+	GetUserSettings(ctx context.Context, id int64) (*domain.UserSettings, error)
+	// SetDefaults sets the colour and the effect kind of the new reminders, NoRGB and an empty kind unset them.
+	SetDefaults(ctx context.Context, id int64, rgb domain.RGB, kind domain.EffectKind) (*domain.UserSettings, error)
+	// SetDefaultDevice sets the lamp or the group of lamps the new reminders light, both empty mean all the lamps.
+	SetDefaultDevice(ctx context.Context, id int64, deviceID uuid.NullUUID, deviceGroup string) error
+	// ResetDefaultDevice makes the bot ask for the lamp of every new reminder.
+	ResetDefaultDevice(ctx context.Context, id int64) error
+	// SetTimeLocale sets how the bot writes the times: the examples of the times to enter and the format of the dates.
+	SetTimeLocale(ctx context.Context, id int64, timeLocale domain.TimeLocale) error
+	SetPageSize(ctx context.Context, id int64, pageSize int) error
+}
+
+type userUsecase struct {
+	userRepo         pg.UserRepo
+	userSettingsRepo pg.UserSettingsRepo
+	finder           tzfinder.Finder
+	clock            clock.Clock
+	logger           logger.Logger
+}
+
+func NewUser(
+	userRepo pg.UserRepo,
+	userSettingsRepo pg.UserSettingsRepo,
+	finder tzfinder.Finder,
+	clock clock.Clock,
+	logger logger.Logger,
+) *userUsecase {
+	return &userUsecase{
+		userRepo:         userRepo,
+		userSettingsRepo: userSettingsRepo,
+		finder:           finder,
+		clock:            clock,
+		logger:           logger,
+	}
+}
+
+// GetUser returns the user profile or a default one if the user has not set it up yet.
+func (usecase *userUsecase) GetUser(ctx context.Context, id int64) (*domain.User, error) {
+	user, err := usecase.userRepo.GetUser(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		return &domain.User{
+			ID:          id,
+			TimeZone:    domain.DefaultTimeZone,
+			QuietPolicy: domain.DefaultQuietPolicy,
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to GetUser: %w", err)
+	}
+
+	return user, nil
+}
+
+func (usecase *userUsecase) SetTimeZone(ctx context.Context, id int64, timeZone string) error {
+	// time.LoadLocation treats an empty name and "Local" as valid ones, but they are not IANA zones.
+	if timeZone == "" || timeZone == "Local" {
+		return fmt.Errorf("%w: %q", ErrInvalidTimeZone, timeZone)
+	}
+
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return fmt.Errorf("%w: %q", ErrInvalidTimeZone, timeZone)
+	}
+
+	_, err := usecase.updateUser(ctx, id, func(user *domain.User) {
+		user.TimeZone = timeZone
+	})
+
+	return err
+}
+
+func (usecase *userUsecase) SetTimeZoneByCoordinates(ctx context.Context, id int64, lat, lng float64) (string, error) {
+	timeZone, err := usecase.finder.TimeZone(lat, lng)
+	if err != nil {
+		return "", fmt.Errorf("failed to find time zone: %w", err)
+	}
+
+	if err = usecase.SetTimeZone(ctx, id, timeZone); err != nil {
+		return "", err
+	}
+
+	return timeZone, nil
+}
+
+func (usecase *userUsecase) SetQuietHours(ctx context.Context, id int64, quietHours string) error {
+	if quietHours != "" {
+		parsed, err := domain.ParseQuietHours(quietHours)
+		if err != nil {
+			return err
+		}
+		quietHours = parsed.String()
+	}
+
+	_, err := usecase.updateUser(ctx, id, func(user *domain.User) {
+		user.QuietHours = quietHours
+	})
+
+	return err
+}
+
+func (usecase *userUsecase) SetQuietPolicy(ctx context.Context, id int64, policy domain.QuietPolicy) error {
+	if _, err := domain.ParseQuietPolicy(string(policy)); err != nil {
+		return err
+	}
+
+	_, err := usecase.updateUser(ctx, id, func(user *domain.User) {
+		user.QuietPolicy = policy
+	})
+
+	return err
+}
+
+func (usecase *userUsecase) SetDND(ctx context.Context, id int64, duration time.Duration) (*domain.User, error) {
+	if duration < 0 {
+		return nil, fmt.Errorf("%w: negative duration %s", ErrInvalidDND, duration)
+	}
+
+	return usecase.updateUser(ctx, id, func(user *domain.User) {
+		user.DNDUntil = nil
+		if duration > 0 {
+			until := usecase.clock.NowUTC().Add(duration)
+			user.DNDUntil = &until
+		}
+	})
+}
+
+func (usecase *userUsecase) GetUserSettings(ctx context.Context, id int64) (*domain.UserSettings, error) {
+	settings, err := usecase.userSettingsRepo.GetUserSettings(ctx, id)
+	if errors.Is(err, domain.ErrNotFound) {
+		return &domain.UserSettings{
+			UserID:     id,
+			TimeLocale: domain.DefaultTimeLocale,
+			PageSize:   domain.DefaultPageSize,
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to GetUserSettings: %w", err)
+	}
+
+	return settings, nil
+}
+
+func (usecase *userUsecase) SetDefaults(
+	ctx context.Context,
+	id int64,
+	rgb domain.RGB,
+	kind domain.EffectKind,
+) (*domain.UserSettings, error) {
+	if rgb != domain.NoRGB {
+		if _, err := domain.ParseRGB(rgb.Hex()); err != nil {
+			return nil, err
+		}
+	}
+
+	// The default effect is played with the default parameters, so a kind without them like keyframes is rejected.
+	if kind != "" {
+		if err := domain.DefaultEffect(kind).Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return usecase.updateUserSettings(ctx, id, func(settings *domain.UserSettings) {
+		settings.DefaultRGB = rgb
+		settings.DefaultEffect = kind
+	})
+}
+
+func (usecase *userUsecase) SetDefaultDevice(
+	ctx context.Context,
+	id int64,
+	deviceID uuid.NullUUID,
+	deviceGroup string,
+) error {
+	_, err := usecase.updateUserSettings(ctx, id, func(settings *domain.UserSettings) {
+		settings.HasDefaultDevice = true
+		settings.DefaultDeviceID = deviceID
+		settings.DefaultDeviceGroup = deviceGroup
+	})
+
+	return err
+}
+
+func (usecase *userUsecase) ResetDefaultDevice(ctx context.Context, id int64) error {
+	_, err := usecase.updateUserSettings(ctx, id, func(settings *domain.UserSettings) {
+		settings.HasDefaultDevice = false
+		settings.DefaultDeviceID = uuid.NullUUID{}
+		settings.DefaultDeviceGroup = ""
+	})
+
+	return err
+}
+
+func (usecase *userUsecase) SetTimeLocale(ctx context.Context, id int64, timeLocale domain.TimeLocale) error {
+	if _, err := domain.ParseTimeLocale(string(timeLocale)); err != nil {
+		return err
+	}
+
+	_, err := usecase.updateUserSettings(ctx, id, func(settings *domain.UserSettings) {
+		settings.TimeLocale = timeLocale
+	})
+
+	return err
+}
+
+func (usecase *userUsecase) SetPageSize(ctx context.Context, id int64, pageSize int) error {
+	if err := domain.ValidatePageSize(pageSize); err != nil {
+		return err
+	}
+
+	_, err := usecase.updateUserSettings(ctx, id, func(settings *domain.UserSettings) {
+		settings.PageSize = pageSize
+	})
+
+	return err
+}
+
+// updateUser applies the change to the user profile, creating the profile with the defaults if there is none.
+func (usecase *userUsecase) updateUser(
+	ctx context.Context,
+	id int64,
+	change func(user *domain.User),
+) (*domain.User, error) {
+	user, err := usecase.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	change(user)
+
+	now := usecase.clock.NowUTC()
+	if user.CreatedAt.IsZero() {
+		user.CreatedAt = now
+	}
+	user.UpdatedAt = now
+
+	if err = usecase.userRepo.UpsertUser(ctx, *user); err != nil {
+		return nil, fmt.Errorf("failed to UpsertUser: %w", err)
+	}
+
+	return user, nil
+}
+
+// updateUserSettings applies the change to the user settings, creating them if there are none.
+func (usecase *userUsecase) updateUserSettings(
+	ctx context.Context,
+	id int64,
+	change func(settings *domain.UserSettings),
+) (*domain.UserSettings, error) {
+	settings, err := usecase.GetUserSettings(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	change(settings)
+
+	now := usecase.clock.NowUTC()
+	if settings.CreatedAt.IsZero() {
+		settings.CreatedAt = now
+	}
+	settings.UpdatedAt = now
+
+	if err = usecase.userSettingsRepo.UpsertUserSettings(ctx, *settings); err != nil {
+		return nil, fmt.Errorf("failed to UpsertUserSettings: %w", err)
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS reminder_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    reminder_id UUID NOT NULL,
+    operation SMALLINT NOT NULL,
+    scheduled_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reminder_outbox;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS reminder_dead_letters (
+    id BIGSERIAL PRIMARY KEY,
+    reminder_id UUID NOT NULL,
+    user_id BIGINT NOT NULL,
+    msg TEXT NOT NULL,
+    colour SMALLINT NOT NULL,
+    mode SMALLINT NOT NULL,
+    scheduled_at TIMESTAMP NOT NULL,
+    attempts INTEGER NOT NULL,
+    last_error TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS reminder_dead_letters_user_id_idx ON reminder_dead_letters (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reminder_dead_letters;
+-- +goose StatementEnd
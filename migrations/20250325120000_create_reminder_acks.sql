@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS reminder_acks (
+    id BIGSERIAL PRIMARY KEY,
+    reminder_id UUID NOT NULL,
+    user_id BIGINT NOT NULL,
+    scheduled_at TIMESTAMP NOT NULL,
+    acked_at TIMESTAMP NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reminder_acks;
+-- +goose StatementEnd
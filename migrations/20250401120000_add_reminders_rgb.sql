@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS rgb INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS brightness SMALLINT NOT NULL DEFAULT 100;
+
+UPDATE reminders
+SET rgb = CASE colour
+    WHEN 1 THEN 16711680 -- #FF0000
+    WHEN 2 THEN 65280    -- #00FF00
+    WHEN 3 THEN 255      -- #0000FF
+    ELSE 0
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reminders DROP COLUMN IF EXISTS brightness;
+ALTER TABLE reminders DROP COLUMN IF EXISTS rgb;
+-- +goose StatementEnd
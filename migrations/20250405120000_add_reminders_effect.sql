@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS effect JSONB NOT NULL DEFAULT '{}';
+
+-- Durations are stored in nanoseconds.
+UPDATE reminders
+SET effect = CASE mode
+    WHEN 2 THEN '{"kind": "blinking", "period": 1000000000, "repeat": 10}'::JSONB
+    ELSE '{"kind": "static", "duration": 10000000000}'::JSONB
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reminders DROP COLUMN IF EXISTS effect;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS devices (
+    id UUID NOT NULL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL,
+    host TEXT NOT NULL,
+    protocol TEXT NOT NULL DEFAULT 'http',
+    capabilities SMALLINT NOT NULL DEFAULT 3,
+    group_name TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    UNIQUE (user_id, name)
+);
+
+-- A reminder of a removed device lights all the lamps of the user.
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS device_id UUID REFERENCES devices (id) ON DELETE SET NULL;
+ALTER TABLE reminders ADD COLUMN IF NOT EXISTS device_group TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reminders DROP COLUMN IF EXISTS device_group;
+ALTER TABLE reminders DROP COLUMN IF EXISTS device_id;
+DROP TABLE IF EXISTS devices;
+-- +goose StatementEnd
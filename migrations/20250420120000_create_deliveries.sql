@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS deliveries (
+    id UUID NOT NULL PRIMARY KEY,
+    reminder_id UUID NOT NULL,
+    user_id BIGINT NOT NULL,
+    -- The default lamp from the config has no id. The history outlives the removed lamps.
+    device_id UUID,
+    device_name TEXT NOT NULL,
+    fired_at TIMESTAMP NOT NULL,
+    status TEXT NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS deliveries_reminder_id_fired_at_idx ON deliveries (reminder_id, fired_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS deliveries;
+-- +goose StatementEnd
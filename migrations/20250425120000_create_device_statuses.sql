@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS device_statuses (
+    device_id UUID NOT NULL PRIMARY KEY REFERENCES devices (id) ON DELETE CASCADE,
+    online BOOLEAN NOT NULL,
+    failures INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    last_seen TIMESTAMP,
+    checked_at TIMESTAMP NOT NULL,
+    changed_at TIMESTAMP NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS device_statuses;
+-- +goose StatementEnd
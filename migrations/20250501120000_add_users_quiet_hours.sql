@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN IF NOT EXISTS quiet_hours TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS quiet_policy TEXT NOT NULL DEFAULT 'defer';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS dnd_until TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS dnd_until;
+ALTER TABLE users DROP COLUMN IF EXISTS quiet_policy;
+ALTER TABLE users DROP COLUMN IF EXISTS quiet_hours;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The default of a removed device lights all the lamps of the user, as its reminders do.
+ALTER TABLE user_settings ADD COLUMN IF NOT EXISTS has_default_device BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE user_settings ADD COLUMN IF NOT EXISTS default_device_id UUID REFERENCES devices (id) ON DELETE SET NULL;
+ALTER TABLE user_settings ADD COLUMN IF NOT EXISTS default_device_group TEXT NOT NULL DEFAULT '';
+-- The locale only chooses how the times are entered and written, the messages of the bot stay in English.
+ALTER TABLE user_settings ADD COLUMN IF NOT EXISTS time_locale TEXT NOT NULL DEFAULT 'en';
+ALTER TABLE user_settings ADD COLUMN IF NOT EXISTS page_size INTEGER NOT NULL DEFAULT 5;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_settings DROP COLUMN IF EXISTS page_size;
+ALTER TABLE user_settings DROP COLUMN IF EXISTS time_locale;
+ALTER TABLE user_settings DROP COLUMN IF EXISTS default_device_group;
+ALTER TABLE user_settings DROP COLUMN IF EXISTS default_device_id;
+ALTER TABLE user_settings DROP COLUMN IF EXISTS has_default_device;
+-- +goose StatementEnd
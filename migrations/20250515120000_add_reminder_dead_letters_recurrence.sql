@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reminder_dead_letters ADD COLUMN IF NOT EXISTS recurrence TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reminder_dead_letters DROP COLUMN IF EXISTS recurrence;
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+package backoff
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// Backoff computes exponentially growing delays between retries.
+type Backoff struct {
+	// Initial is the delay before the first retry.
+	Initial time.Duration
+	// Max caps the delay before jitter is applied.
+	Max time.Duration
+	// Multiplier is the growth factor of the delay between two consecutive retries.
+	Multiplier float64
+	// Jitter is the fraction in [0, 1] by which the delay is randomly shortened or extended,
+	// so that retries of many tasks failed at once are spread over time.
+	Jitter float64
+	// Rand returns a pseudo-random number in [0, 1). It defaults to rand.Float64.
+	Rand func() float64
+}
+
+// Delay returns the delay before the given retry attempt, starting from 1.
+func (b Backoff) Delay(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	delay := float64(b.Initial) * math.Pow(b.Multiplier, float64(attempt-1))
+	if b.Max > 0 && delay > float64(b.Max) {
+		delay = float64(b.Max)
+	}
+
+	if b.Jitter > 0 {
+		random := rand.Float64
+		if b.Rand != nil {
+			random = b.Rand
+		}
+		delay *= 1 + b.Jitter*(2*random()-1)
+	}
+
+	return time.Duration(delay)
+}
@@ -0,0 +1,82 @@
+package backoff_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/almostinf/glow-reminder/pkg/backoff"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelay(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name          string
+		backoff       backoff.Backoff
+		attempt       int
+		expectedDelay time.Duration
+	}{
+		{
+			name:          "first attempt",
+			backoff:       backoff.Backoff{Initial: time.Second, Max: time.Minute, Multiplier: 2},
+			attempt:       1,
+			expectedDelay: time.Second,
+		},
+		{
+			name:          "zero attempt is treated as first",
+			backoff:       backoff.Backoff{Initial: time.Second, Max: time.Minute, Multiplier: 2},
+			attempt:       0,
+			expectedDelay: time.Second,
+		},
+		{
+			name:          "exponential growth",
+			backoff:       backoff.Backoff{Initial: time.Second, Max: time.Minute, Multiplier: 2},
+			attempt:       4,
+			expectedDelay: 8 * time.Second,
+		},
+		{
+			name:          "capped by max",
+			backoff:       backoff.Backoff{Initial: time.Second, Max: time.Minute, Multiplier: 2},
+			attempt:       10,
+			expectedDelay: time.Minute,
+		},
+		{
+			name: "lowest jitter",
+			backoff: backoff.Backoff{
+				Initial: 10 * time.Second, Max: time.Minute, Multiplier: 2, Jitter: 0.2,
+				Rand: func() float64 { return 0 },
+			},
+			attempt:       1,
+			expectedDelay: 8 * time.Second,
+		},
+		{
+			name: "middle jitter",
+			backoff: backoff.Backoff{
+				Initial: 10 * time.Second, Max: time.Minute, Multiplier: 2, Jitter: 0.2,
+				Rand: func() float64 { return 0.5 },
+			},
+			attempt:       2,
+			expectedDelay: 20 * time.Second,
+		},
+		{
+			name: "jitter is applied after cap",
+			backoff: backoff.Backoff{
+				Initial: 10 * time.Second, Max: time.Minute, Multiplier: 2, Jitter: 0.5,
+				Rand: func() float64 { return 0.75 },
+			},
+			attempt:       5,
+			expectedDelay: 75 * time.Second,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expectedDelay, testcase.backoff.Delay(testcase.attempt))
+		})
+	}
+}
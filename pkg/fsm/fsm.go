@@ -0,0 +1,255 @@
+package fsm
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnknownState      = errors.New("unknown state")
+	ErrNoHandler         = errors.New("no handler for the input")
+	ErrInvalidTransition = errors.New("invalid transition")
+	ErrTransitionLoop    = errors.New("transition loop")
+)
+
+// Input is the kind of the input handled in a state.
+type Input int
+
+const (
+	Text Input = iota
+	Callback
+)
+
+func (input Input) String() string {
+	switch input {
+	case Text:
+		return "text"
+	case Callback:
+		return "callback"
+	default:
+		return fmt.Sprintf("input(%d)", int(input))
+	}
+}
+
+// Handler handles an input or the entering of a state with the context c and returns the state to move to.
+// Returning the current state keeps it. On error the update is aborted and the state is not changed.
+type Handler[S comparable, C any] func(c C) (S, error)
+
+// State declares the handlers of a state and the states it may move to.
+type State[S comparable, C any] struct {
+	enter    Handler[S, C]
+	handlers map[Input]Handler[S, C]
+	to       map[S]bool
+	back     S
+	hasBack  bool
+}
+
+// Enter sets the handler called when the state is entered, e.g. to send its prompt.
+// The handler may return another state to skip this one, the skipped state is skipped going back as well.
+func (st *State[S, C]) Enter(handler Handler[S, C]) *State[S, C] {
+	st.enter = handler
+	return st
+}
+
+func (st *State[S, C]) OnText(handler Handler[S, C]) *State[S, C] {
+	st.handlers[Text] = handler
+	return st
+}
+
+func (st *State[S, C]) OnCallback(handler Handler[S, C]) *State[S, C] {
+	st.handlers[Callback] = handler
+	return st
+}
+
+// To allows the handlers of the state to move to the given states.
+// Keeping the state and cancelling to the initial state are always allowed.
+func (st *State[S, C]) To(states ...S) *State[S, C] {
+	for _, s := range states {
+		st.to[s] = true
+	}
+	return st
+}
+
+// Back sets the state the back transition returns to.
+func (st *State[S, C]) Back(s S) *State[S, C] {
+	st.back = s
+	st.hasBack = true
+	return st
+}
+
+// Machine is a finite-state machine of a conversation. The states are declared with their handlers
+// and transitions, so the machine validates every move instead of each handler checking the state.
+type Machine[S comparable, C any] struct {
+	initial S
+	states  map[S]*State[S, C]
+}
+
+// New creates a machine with the initial state. The initial state is where a cancelled or broken flow returns.
+func New[S comparable, C any](initial S) *Machine[S, C] {
+	m := &Machine[S, C]{
+		initial: initial,
+		states:  make(map[S]*State[S, C]),
+	}
+	m.State(initial)
+
+	return m
+}
+
+// State returns the declaration of the state, declaring it on the first call.
+func (m *Machine[S, C]) State(s S) *State[S, C] {
+	st, ok := m.states[s]
+	if !ok {
+		st = &State[S, C]{
+			handlers: make(map[Input]Handler[S, C]),
+			to:       make(map[S]bool),
+		}
+		m.states[s] = st
+	}
+
+	return st
+}
+
+func (m *Machine[S, C]) Initial() S {
+	return m.initial
+}
+
+// Validate checks that every transition and back transition leads to a declared state.
+func (m *Machine[S, C]) Validate() error {
+	for s, st := range m.states {
+		for to := range st.to {
+			if _, ok := m.states[to]; !ok {
+				return fmt.Errorf("%w %v in the transition from %v", ErrUnknownState, to, s)
+			}
+		}
+
+		if _, ok := m.states[st.back]; st.hasBack && !ok {
+			return fmt.Errorf("%w %v in the back transition from %v", ErrUnknownState, st.back, s)
+		}
+	}
+
+	return nil
+}
+
+// Allows reports whether the machine may move from one state to another.
+func (m *Machine[S, C]) Allows(from, to S) bool {
+	st, ok := m.states[from]
+	if !ok {
+		return false
+	}
+	if _, ok = m.states[to]; !ok {
+		return false
+	}
+
+	return to == from || to == m.initial || st.to[to]
+}
+
+// BackOf returns the state the back transition from s returns to and whether there is one.
+func (m *Machine[S, C]) BackOf(s S) (S, bool) {
+	st, ok := m.states[s]
+	if !ok || !st.hasBack {
+		var zero S
+		return zero, false
+	}
+
+	return st.back, true
+}
+
+// Handle passes the input to the handler of the current state and moves to the state it returns.
+func (m *Machine[S, C]) Handle(c C, current S, input Input) (S, error) {
+	st, ok := m.states[current]
+	if !ok {
+		return current, fmt.Errorf("%w %v", ErrUnknownState, current)
+	}
+
+	handler, ok := st.handlers[input]
+	if !ok {
+		return current, fmt.Errorf("%w %v in the state %v", ErrNoHandler, input, current)
+	}
+
+	next, err := handler(c)
+	if err != nil {
+		return current, err
+	}
+
+	return m.Transit(c, current, next)
+}
+
+// Transit moves from one state to another, entering it and the states it skips to.
+func (m *Machine[S, C]) Transit(c C, from, to S) (S, error) {
+	current := from
+
+	for steps := 0; to != current; steps++ {
+		if steps > len(m.states) {
+			return from, fmt.Errorf("%w from %v", ErrTransitionLoop, from)
+		}
+
+		if !m.Allows(current, to) {
+			return from, fmt.Errorf("%w from %v to %v", ErrInvalidTransition, current, to)
+		}
+
+		next, err := m.enter(c, to)
+		if err != nil {
+			return from, err
+		}
+
+		current, to = to, next
+	}
+
+	return current, nil
+}
+
+// Back returns to the back state of the current one. The states that are skipped on entering are skipped too,
+// while a state that returns to the initial state on entering cancels the flow.
+func (m *Machine[S, C]) Back(c C, current S) (S, error) {
+	if _, ok := m.states[current]; !ok {
+		return current, fmt.Errorf("%w %v", ErrUnknownState, current)
+	}
+
+	back := current
+	for steps := 0; ; steps++ {
+		if steps > len(m.states) {
+			return current, fmt.Errorf("%w back from %v", ErrTransitionLoop, current)
+		}
+
+		var ok bool
+		back, ok = m.BackOf(back)
+		if !ok {
+			return current, fmt.Errorf("%w: no back transition from %v", ErrInvalidTransition, current)
+		}
+
+		next, err := m.enter(c, back)
+		if err != nil {
+			return current, err
+		}
+
+		switch next {
+		case back:
+			return back, nil
+		case m.initial:
+			return m.Transit(c, back, next)
+		}
+	}
+}
+
+// Cancel moves from the current state to the initial one, even if the current state is unknown.
+func (m *Machine[S, C]) Cancel(c C, current S) (S, error) {
+	next, err := m.enter(c, m.initial)
+	if err != nil {
+		return current, err
+	}
+
+	return m.Transit(c, m.initial, next)
+}
+
+func (m *Machine[S, C]) enter(c C, s S) (S, error) {
+	st, ok := m.states[s]
+	if !ok {
+		return s, fmt.Errorf("%w %v", ErrUnknownState, s)
+	}
+
+	if st.enter == nil {
+		return s, nil
+	}
+
+	return st.enter(c)
+}
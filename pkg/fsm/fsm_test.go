@@ -0,0 +1,381 @@
+package fsm_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/almostinf/glow-reminder/pkg/fsm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errSend = errors.New("failed to send")
+
+// recorder is the context of the test machine, it records the entered states.
+type recorder struct {
+	// next is the state the input handlers return.
+	next string
+	err  error
+	// redirects are the states returned on entering the states, e.g. to skip them.
+	redirects map[string]string
+	enterErr  error
+	entered   []string
+}
+
+// newMachine declares menu -> first -> second <-> third with the back transitions third -> second -> first.
+func newMachine() *fsm.Machine[string, *recorder] {
+	handle := func(r *recorder) (string, error) {
+		return r.next, r.err
+	}
+	enter := func(s string) fsm.Handler[string, *recorder] {
+		return func(r *recorder) (string, error) {
+			r.entered = append(r.entered, s)
+			if r.enterErr != nil {
+				return s, r.enterErr
+			}
+			if redirect, ok := r.redirects[s]; ok {
+				return redirect, nil
+			}
+			return s, nil
+		}
+	}
+
+	m := fsm.New[string, *recorder]("menu")
+
+	m.State("menu").
+		To("first")
+
+	m.State("first").
+		Enter(enter("first")).
+		OnText(handle).
+		To("second")
+
+	m.State("second").
+		Enter(enter("second")).
+		OnText(handle).
+		OnCallback(handle).
+		To("third").
+		Back("first")
+
+	m.State("third").
+		Enter(enter("third")).
+		OnCallback(handle).
+		To("second").
+		Back("second")
+
+	return m
+}
+
+func TestAllows(t *testing.T) {
+	t.Parallel()
+
+	states := []string{"menu", "first", "second", "third", "unknown"}
+	allowed := map[[2]string]bool{
+		{"menu", "menu"}:     true,
+		{"menu", "first"}:    true,
+		{"first", "first"}:   true,
+		{"first", "second"}:  true,
+		{"first", "menu"}:    true,
+		{"second", "second"}: true,
+		{"second", "third"}:  true,
+		{"second", "menu"}:   true,
+		{"third", "third"}:   true,
+		{"third", "second"}:  true,
+		{"third", "menu"}:    true,
+	}
+
+	m := newMachine()
+	require.NoError(t, m.Validate())
+
+	for _, from := range states {
+		for _, to := range states {
+			assert.Equal(t, allowed[[2]string{from, to}], m.Allows(from, to), "%s -> %s", from, to)
+		}
+	}
+}
+
+func TestHandle(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name            string
+		current         string
+		input           fsm.Input
+		recorder        recorder
+		expectedState   string
+		expectedEntered []string
+		expectedErr     error
+	}{
+		{
+			name:            "moves to the returned state",
+			current:         "first",
+			input:           fsm.Text,
+			recorder:        recorder{next: "second"},
+			expectedState:   "second",
+			expectedEntered: []string{"second"},
+		},
+		{
+			name:          "keeps the state without entering it",
+			current:       "second",
+			input:         fsm.Callback,
+			recorder:      recorder{next: "second"},
+			expectedState: "second",
+		},
+		{
+			name:            "skips the state on entering",
+			current:         "first",
+			input:           fsm.Text,
+			recorder:        recorder{next: "second", redirects: map[string]string{"second": "third"}},
+			expectedState:   "third",
+			expectedEntered: []string{"second", "third"},
+		},
+		{
+			name:          "cancels to the initial state",
+			current:       "third",
+			input:         fsm.Callback,
+			recorder:      recorder{next: "menu"},
+			expectedState: "menu",
+		},
+		{
+			name:            "returns to the initial state on entering",
+			current:         "first",
+			input:           fsm.Text,
+			recorder:        recorder{next: "second", redirects: map[string]string{"second": "menu"}},
+			expectedState:   "menu",
+			expectedEntered: []string{"second"},
+		},
+		{
+			name:          "unknown state",
+			current:       "unknown",
+			input:         fsm.Text,
+			recorder:      recorder{next: "first"},
+			expectedState: "unknown",
+			expectedErr:   fsm.ErrUnknownState,
+		},
+		{
+			name:          "no handler of the input",
+			current:       "first",
+			input:         fsm.Callback,
+			recorder:      recorder{next: "second"},
+			expectedState: "first",
+			expectedErr:   fsm.ErrNoHandler,
+		},
+		{
+			name:          "no handler in the initial state",
+			current:       "menu",
+			input:         fsm.Text,
+			expectedState: "menu",
+			expectedErr:   fsm.ErrNoHandler,
+		},
+		{
+			name:          "undeclared transition",
+			current:       "first",
+			input:         fsm.Text,
+			recorder:      recorder{next: "third"},
+			expectedState: "first",
+			expectedErr:   fsm.ErrInvalidTransition,
+		},
+		{
+			name:            "undeclared skip",
+			current:         "first",
+			input:           fsm.Text,
+			recorder:        recorder{next: "second", redirects: map[string]string{"second": "first"}},
+			expectedState:   "first",
+			expectedEntered: []string{"second"},
+			expectedErr:     fsm.ErrInvalidTransition,
+		},
+		{
+			name:          "transition to an unknown state",
+			current:       "second",
+			input:         fsm.Text,
+			recorder:      recorder{next: "unknown"},
+			expectedState: "second",
+			expectedErr:   fsm.ErrInvalidTransition,
+		},
+		{
+			name:    "skip loop",
+			current: "first",
+			input:   fsm.Text,
+			recorder: recorder{
+				next:      "second",
+				redirects: map[string]string{"second": "third", "third": "second"},
+			},
+			expectedState:   "first",
+			expectedEntered: []string{"second", "third", "second", "third", "second"},
+			expectedErr:     fsm.ErrTransitionLoop,
+		},
+		{
+			name:          "handler error keeps the state",
+			current:       "first",
+			input:         fsm.Text,
+			recorder:      recorder{next: "second", err: errSend},
+			expectedState: "first",
+			expectedErr:   errSend,
+		},
+		{
+			name:            "enter error keeps the state",
+			current:         "first",
+			input:           fsm.Text,
+			recorder:        recorder{next: "second", enterErr: errSend},
+			expectedState:   "first",
+			expectedEntered: []string{"second"},
+			expectedErr:     errSend,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := testcase.recorder
+
+			s, err := newMachine().Handle(&r, testcase.current, testcase.input)
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+			assert.Equal(t, testcase.expectedState, s)
+			assert.Equal(t, testcase.expectedEntered, r.entered)
+		})
+	}
+}
+
+func TestBack(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name            string
+		current         string
+		recorder        recorder
+		expectedState   string
+		expectedEntered []string
+		expectedErr     error
+	}{
+		{
+			name:            "returns to the back state",
+			current:         "third",
+			expectedState:   "second",
+			expectedEntered: []string{"second"},
+		},
+		{
+			name:            "skips the back state skipped on entering",
+			current:         "third",
+			recorder:        recorder{redirects: map[string]string{"second": "third"}},
+			expectedState:   "first",
+			expectedEntered: []string{"second", "first"},
+		},
+		{
+			name:            "cancels if the back state returns to the initial state",
+			current:         "third",
+			recorder:        recorder{redirects: map[string]string{"second": "menu"}},
+			expectedState:   "menu",
+			expectedEntered: []string{"second"},
+		},
+		{
+			name:          "no back transition",
+			current:       "first",
+			expectedState: "first",
+			expectedErr:   fsm.ErrInvalidTransition,
+		},
+		{
+			name:            "no back transition from the skipped state",
+			current:         "second",
+			recorder:        recorder{redirects: map[string]string{"first": "second"}},
+			expectedState:   "second",
+			expectedEntered: []string{"first"},
+			expectedErr:     fsm.ErrInvalidTransition,
+		},
+		{
+			name:          "unknown state",
+			current:       "unknown",
+			expectedState: "unknown",
+			expectedErr:   fsm.ErrUnknownState,
+		},
+		{
+			name:            "enter error keeps the state",
+			current:         "third",
+			recorder:        recorder{enterErr: errSend},
+			expectedState:   "third",
+			expectedEntered: []string{"second"},
+			expectedErr:     errSend,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := testcase.recorder
+
+			s, err := newMachine().Back(&r, testcase.current)
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+			assert.Equal(t, testcase.expectedState, s)
+			assert.Equal(t, testcase.expectedEntered, r.entered)
+		})
+	}
+}
+
+func TestCancel(t *testing.T) {
+	t.Parallel()
+
+	for _, current := range []string{"menu", "first", "second", "third", "unknown"} {
+		current := current
+
+		t.Run(current, func(t *testing.T) {
+			t.Parallel()
+
+			r := recorder{}
+
+			s, err := newMachine().Cancel(&r, current)
+
+			require.NoError(t, err)
+			assert.Equal(t, "menu", s)
+			assert.Empty(t, r.entered)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		declare     func(m *fsm.Machine[string, *recorder])
+		expectedErr error
+	}{
+		{
+			name:    "declared states",
+			declare: func(m *fsm.Machine[string, *recorder]) {},
+		},
+		{
+			name: "transition to an undeclared state",
+			declare: func(m *fsm.Machine[string, *recorder]) {
+				m.State("third").To("fourth")
+			},
+			expectedErr: fsm.ErrUnknownState,
+		},
+		{
+			name: "back transition to an undeclared state",
+			declare: func(m *fsm.Machine[string, *recorder]) {
+				m.State("first").Back("zeroth")
+			},
+			expectedErr: fsm.ErrUnknownState,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := newMachine()
+			testcase.declare(m)
+
+			assert.ErrorIs(t, m.Validate(), testcase.expectedErr)
+		})
+	}
+}
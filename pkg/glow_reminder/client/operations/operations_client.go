@@ -58,6 +58,12 @@ type ClientOption func(*runtime.ClientOperation)
 type ClientService interface {
 	GlowReminder(params *GlowReminderParams, opts ...ClientOption) (*GlowReminderOK, error)
 
+	GlowReminderV2(params *GlowReminderV2Params, opts ...ClientOption) (*GlowReminderV2OK, error)
+
+	StopGlow(params *StopGlowParams, opts ...ClientOption) (*StopGlowOK, error)
+
+	Health(params *HealthParams, opts ...ClientOption) (*HealthOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -99,6 +105,120 @@ func (a *Client) GlowReminder(params *GlowReminderParams, opts ...ClientOption)
 	panic(msg)
 }
 
+/*
+GlowReminderV2 adds a glow reminder with an RGB colour and brightness
+*/
+func (a *Client) GlowReminderV2(params *GlowReminderV2Params, opts ...ClientOption) (*GlowReminderV2OK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGlowReminderV2Params()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "glow_reminder_v2",
+		Method:             "POST",
+		PathPattern:        "/glow_reminder/v2",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GlowReminderV2Reader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GlowReminderV2OK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for glow_reminder_v2: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+StopGlow stops the glowing reminder
+*/
+func (a *Client) StopGlow(params *StopGlowParams, opts ...ClientOption) (*StopGlowOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewStopGlowParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "stop_glow",
+		Method:             "POST",
+		PathPattern:        "/stop",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &StopGlowReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*StopGlowOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for stop_glow: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+Health checks that the lamp is up
+*/
+func (a *Client) Health(params *HealthParams, opts ...ClientOption) (*HealthOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewHealthParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "health",
+		Method:             "GET",
+		PathPattern:        "/health",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &HealthReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*HealthOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for health: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: glowreminder/v1/glow_reminder.proto
+
+package glowreminderv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ReminderService_ListReminders_FullMethodName  = "/glowreminder.v1.ReminderService/ListReminders"
+	ReminderService_GetReminder_FullMethodName    = "/glowreminder.v1.ReminderService/GetReminder"
+	ReminderService_CreateReminder_FullMethodName = "/glowreminder.v1.ReminderService/CreateReminder"
+	ReminderService_UpdateReminder_FullMethodName = "/glowreminder.v1.ReminderService/UpdateReminder"
+	ReminderService_DeleteReminder_FullMethodName = "/glowreminder.v1.ReminderService/DeleteReminder"
+	ReminderService_WatchReminders_FullMethodName = "/glowreminder.v1.ReminderService/WatchReminders"
+)
+
+// ReminderServiceClient is the client API for ReminderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReminderServiceClient interface {
+	// ListReminders returns the reminders ordered by the scheduled time.
+	ListReminders(ctx context.Context, in *ListRemindersRequest, opts ...grpc.CallOption) (*ListRemindersResponse, error)
+	GetReminder(ctx context.Context, in *GetReminderRequest, opts ...grpc.CallOption) (*Reminder, error)
+	CreateReminder(ctx context.Context, in *CreateReminderRequest, opts ...grpc.CallOption) (*Reminder, error)
+	// UpdateReminder changes the fields present in the request.
+	UpdateReminder(ctx context.Context, in *UpdateReminderRequest, opts ...grpc.CallOption) (*Reminder, error)
+	DeleteReminder(ctx context.Context, in *DeleteReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// WatchReminders streams the reminders of the user as they fire until the call is cancelled.
+	WatchReminders(ctx context.Context, in *WatchRemindersRequest, opts ...grpc.CallOption) (ReminderService_WatchRemindersClient, error)
+}
+
+type reminderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReminderServiceClient(cc grpc.ClientConnInterface) ReminderServiceClient {
+	return &reminderServiceClient{cc}
+}
+
+func (c *reminderServiceClient) ListReminders(ctx context.Context, in *ListRemindersRequest, opts ...grpc.CallOption) (*ListRemindersResponse, error) {
+	out := new(ListRemindersResponse)
+	err := c.cc.Invoke(ctx, ReminderService_ListReminders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) GetReminder(ctx context.Context, in *GetReminderRequest, opts ...grpc.CallOption) (*Reminder, error) {
+	out := new(Reminder)
+	err := c.cc.Invoke(ctx, ReminderService_GetReminder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) CreateReminder(ctx context.Context, in *CreateReminderRequest, opts ...grpc.CallOption) (*Reminder, error) {
+	out := new(Reminder)
+	err := c.cc.Invoke(ctx, ReminderService_CreateReminder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) UpdateReminder(ctx context.Context, in *UpdateReminderRequest, opts ...grpc.CallOption) (*Reminder, error) {
+	out := new(Reminder)
+	err := c.cc.Invoke(ctx, ReminderService_UpdateReminder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) DeleteReminder(ctx context.Context, in *DeleteReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ReminderService_DeleteReminder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) WatchReminders(ctx context.Context, in *WatchRemindersRequest, opts ...grpc.CallOption) (ReminderService_WatchRemindersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ReminderService_ServiceDesc.Streams[0], ReminderService_WatchReminders_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &reminderServiceWatchRemindersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ReminderService_WatchRemindersClient interface {
+	Recv() (*FireEvent, error)
+	grpc.ClientStream
+}
+
+type reminderServiceWatchRemindersClient struct {
+	grpc.ClientStream
+}
+
+func (x *reminderServiceWatchRemindersClient) Recv() (*FireEvent, error) {
+	m := new(FireEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ReminderServiceServer is the server API for ReminderService service.
+// All implementations must embed UnimplementedReminderServiceServer
+// for forward compatibility
+type ReminderServiceServer interface {
+	// ListReminders returns the reminders ordered by the scheduled time.
+	ListReminders(context.Context, *ListRemindersRequest) (*ListRemindersResponse, error)
+	GetReminder(context.Context, *GetReminderRequest) (*Reminder, error)
+	CreateReminder(context.Context, *CreateReminderRequest) (*Reminder, error)
+	// UpdateReminder changes the fields present in the request.
+	UpdateReminder(context.Context, *UpdateReminderRequest) (*Reminder, error)
+	DeleteReminder(context.Context, *DeleteReminderRequest) (*emptypb.Empty, error)
+	// WatchReminders streams the reminders of the user as they fire until the call is cancelled.
+	WatchReminders(*WatchRemindersRequest, ReminderService_WatchRemindersServer) error
+	mustEmbedUnimplementedReminderServiceServer()
+}
+
+// UnimplementedReminderServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedReminderServiceServer struct {
+}
+
+func (UnimplementedReminderServiceServer) ListReminders(context.Context, *ListRemindersRequest) (*ListRemindersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReminders not implemented")
+}
+func (UnimplementedReminderServiceServer) GetReminder(context.Context, *GetReminderRequest) (*Reminder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) CreateReminder(context.Context, *CreateReminderRequest) (*Reminder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) UpdateReminder(context.Context, *UpdateReminderRequest) (*Reminder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) DeleteReminder(context.Context, *DeleteReminderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) WatchReminders(*WatchRemindersRequest, ReminderService_WatchRemindersServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchReminders not implemented")
+}
+func (UnimplementedReminderServiceServer) mustEmbedUnimplementedReminderServiceServer() {}
+
+// UnsafeReminderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReminderServiceServer will
+// result in compilation errors.
+type UnsafeReminderServiceServer interface {
+	mustEmbedUnimplementedReminderServiceServer()
+}
+
+func RegisterReminderServiceServer(s grpc.ServiceRegistrar, srv ReminderServiceServer) {
+	s.RegisterService(&ReminderService_ServiceDesc, srv)
+}
+
+func _ReminderService_ListReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRemindersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).ListReminders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_ListReminders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).ListReminders(ctx, req.(*ListRemindersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_GetReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).GetReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_GetReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).GetReminder(ctx, req.(*GetReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_CreateReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).CreateReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_CreateReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).CreateReminder(ctx, req.(*CreateReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_UpdateReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).UpdateReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_UpdateReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).UpdateReminder(ctx, req.(*UpdateReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_DeleteReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).DeleteReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_DeleteReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).DeleteReminder(ctx, req.(*DeleteReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_WatchReminders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRemindersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReminderServiceServer).WatchReminders(m, &reminderServiceWatchRemindersServer{stream})
+}
+
+type ReminderService_WatchRemindersServer interface {
+	Send(*FireEvent) error
+	grpc.ServerStream
+}
+
+type reminderServiceWatchRemindersServer struct {
+	grpc.ServerStream
+}
+
+func (x *reminderServiceWatchRemindersServer) Send(m *FireEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// ReminderService_ServiceDesc is the grpc.ServiceDesc for ReminderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReminderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "glowreminder.v1.ReminderService",
+	HandlerType: (*ReminderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListReminders",
+			Handler:    _ReminderService_ListReminders_Handler,
+		},
+		{
+			MethodName: "GetReminder",
+			Handler:    _ReminderService_GetReminder_Handler,
+		},
+		{
+			MethodName: "CreateReminder",
+			Handler:    _ReminderService_CreateReminder_Handler,
+		},
+		{
+			MethodName: "UpdateReminder",
+			Handler:    _ReminderService_UpdateReminder_Handler,
+		},
+		{
+			MethodName: "DeleteReminder",
+			Handler:    _ReminderService_DeleteReminder_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchReminders",
+			Handler:       _ReminderService_WatchReminders_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "glowreminder/v1/glow_reminder.proto",
+}
+
+const (
+	DeviceService_ListDevices_FullMethodName = "/glowreminder.v1.DeviceService/ListDevices"
+	DeviceService_Glow_FullMethodName        = "/glowreminder.v1.DeviceService/Glow"
+)
+
+// DeviceServiceClient is the client API for DeviceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeviceServiceClient interface {
+	ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)
+	// Glow lights the device, the devices of the group or all the lamps of the user with a test reminder.
+	Glow(ctx context.Context, in *GlowRequest, opts ...grpc.CallOption) (*GlowResponse, error)
+}
+
+type deviceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeviceServiceClient(cc grpc.ClientConnInterface) DeviceServiceClient {
+	return &deviceServiceClient{cc}
+}
+
+func (c *deviceServiceClient) ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error) {
+	out := new(ListDevicesResponse)
+	err := c.cc.Invoke(ctx, DeviceService_ListDevices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) Glow(ctx context.Context, in *GlowRequest, opts ...grpc.CallOption) (*GlowResponse, error) {
+	out := new(GlowResponse)
+	err := c.cc.Invoke(ctx, DeviceService_Glow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceServiceServer is the server API for DeviceService service.
+// All implementations must embed UnimplementedDeviceServiceServer
+// for forward compatibility
+type DeviceServiceServer interface {
+	ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error)
+	// Glow lights the device, the devices of the group or all the lamps of the user with a test reminder.
+	Glow(context.Context, *GlowRequest) (*GlowResponse, error)
+	mustEmbedUnimplementedDeviceServiceServer()
+}
+
+// UnimplementedDeviceServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDeviceServiceServer struct {
+}
+
+func (UnimplementedDeviceServiceServer) ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDevices not implemented")
+}
+func (UnimplementedDeviceServiceServer) Glow(context.Context, *GlowRequest) (*GlowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Glow not implemented")
+}
+func (UnimplementedDeviceServiceServer) mustEmbedUnimplementedDeviceServiceServer() {}
+
+// UnsafeDeviceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeviceServiceServer will
+// result in compilation errors.
+type UnsafeDeviceServiceServer interface {
+	mustEmbedUnimplementedDeviceServiceServer()
+}
+
+func RegisterDeviceServiceServer(s grpc.ServiceRegistrar, srv DeviceServiceServer) {
+	s.RegisterService(&DeviceService_ServiceDesc, srv)
+}
+
+func _DeviceService_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceService_ListDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).ListDevices(ctx, req.(*ListDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_Glow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).Glow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceService_Glow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).Glow(ctx, req.(*GlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeviceService_ServiceDesc is the grpc.ServiceDesc for DeviceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeviceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "glowreminder.v1.DeviceService",
+	HandlerType: (*DeviceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDevices",
+			Handler:    _DeviceService_ListDevices_Handler,
+		},
+		{
+			MethodName: "Glow",
+			Handler:    _DeviceService_Glow_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "glowreminder/v1/glow_reminder.proto",
+}
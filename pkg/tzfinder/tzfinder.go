@@ -0,0 +1,42 @@
+package tzfinder
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ringsaturn/tzf"
+)
+
+var ErrTimeZoneNotFound = errors.New("time zone not found")
+
+// Finder defines the interface for resolving time zones by coordinates.
+type Finder interface {
+	TimeZone(lat, lng float64) (string, error)
+}
+
+type finder struct {
+	f tzf.F
+}
+
+// New creates a new instance of Finder backed by the embedded time zone boundary dataset,
+// so no network access is required to resolve a time zone.
+func New() (Finder, error) {
+	f, err := tzf.NewDefaultFinder()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create time zone finder: %w", err)
+	}
+
+	return &finder{
+		f: f,
+	}, nil
+}
+
+// TimeZone returns the IANA time zone name of the given coordinates.
+func (finder *finder) TimeZone(lat, lng float64) (string, error) {
+	name := finder.f.GetTimezoneName(lng, lat)
+	if name == "" {
+		return "", fmt.Errorf("%w: lat %f, lng %f", ErrTimeZoneNotFound, lat, lng)
+	}
+
+	return name, nil
+}